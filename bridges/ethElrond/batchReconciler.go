@@ -0,0 +1,137 @@
+package ethElrond
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+const minPendingBatchesToScan = 1
+
+// ArgsBatchReconciler is the arguments DTO struct used in the NewBatchReconciler constructor function
+type ArgsBatchReconciler struct {
+	ElrondClient      ElrondClient
+	EthereumClient    EthereumClient
+	MaxPendingBatches uint64
+}
+
+// ReconcileReport holds the result of comparing the Ethereum batches against the ones executed on Elrond
+type ReconcileReport struct {
+	LastExecutedBatchID   uint64
+	LatestEthereumBatchID uint64
+	PendingBatchIDs       []uint64
+	Divergences           []string
+	Truncated             bool
+	Summary               string
+}
+
+// batchReconciler is a read-only coordinator that compares the Ethereum to Elrond half-bridge state on both chains
+type batchReconciler struct {
+	elrondClient      ElrondClient
+	ethereumClient    EthereumClient
+	maxPendingBatches uint64
+}
+
+// NewBatchReconciler creates a new batch reconciler instance
+func NewBatchReconciler(args ArgsBatchReconciler) (*batchReconciler, error) {
+	if check.IfNil(args.ElrondClient) {
+		return nil, ErrNilElrondClient
+	}
+	if check.IfNil(args.EthereumClient) {
+		return nil, ErrNilEthereumClient
+	}
+	if args.MaxPendingBatches < minPendingBatchesToScan {
+		return nil, fmt.Errorf("%w for args.MaxPendingBatches, got: %d, minimum: %d",
+			clients.ErrInvalidValue, args.MaxPendingBatches, minPendingBatchesToScan)
+	}
+
+	return &batchReconciler{
+		elrondClient:      args.ElrondClient,
+		ethereumClient:    args.EthereumClient,
+		maxPendingBatches: args.MaxPendingBatches,
+	}, nil
+}
+
+// Reconcile compares the last Ethereum batch executed on Elrond against the batches available on Ethereum. The
+// Ethereum batches following the last executed one are reported as pending, scanning at most maxPendingBatches
+// batches. Only queries are issued so it is safe to be called on a running bridge
+func (reconciler *batchReconciler) Reconcile(ctx context.Context) (ReconcileReport, error) {
+	report := ReconcileReport{
+		PendingBatchIDs: make([]uint64, 0),
+		Divergences:     make([]string, 0),
+	}
+
+	lastExecutedBatchID, err := reconciler.elrondClient.GetLastExecutedEthBatchID(ctx)
+	if err != nil {
+		return ReconcileReport{}, err
+	}
+	report.LastExecutedBatchID = lastExecutedBatchID
+
+	if lastExecutedBatchID > 0 {
+		found, errGet := reconciler.batchExistsOnEthereum(ctx, lastExecutedBatchID)
+		if errGet != nil {
+			return ReconcileReport{}, errGet
+		}
+		if found {
+			report.LatestEthereumBatchID = lastExecutedBatchID
+		} else {
+			report.Divergences = append(report.Divergences,
+				fmt.Sprintf("last executed batch %d on Elrond was not found on Ethereum", lastExecutedBatchID))
+		}
+	}
+
+	for batchID := lastExecutedBatchID + 1; ; batchID++ {
+		if uint64(len(report.PendingBatchIDs)) >= reconciler.maxPendingBatches {
+			report.Truncated = true
+			break
+		}
+
+		found, errGet := reconciler.batchExistsOnEthereum(ctx, batchID)
+		if errGet != nil {
+			return ReconcileReport{}, errGet
+		}
+		if !found {
+			break
+		}
+
+		report.PendingBatchIDs = append(report.PendingBatchIDs, batchID)
+		report.LatestEthereumBatchID = batchID
+	}
+
+	report.Summary = createReconcileSummary(report)
+
+	return report, nil
+}
+
+func (reconciler *batchReconciler) batchExistsOnEthereum(ctx context.Context, batchID uint64) (bool, error) {
+	batch, err := reconciler.ethereumClient.GetBatch(core.ContextWithBatchID(ctx, batchID), batchID)
+	if err != nil {
+		return false, err
+	}
+
+	return batch != nil && batch.ID == batchID && len(batch.Deposits) > 0, nil
+}
+
+func createReconcileSummary(report ReconcileReport) string {
+	summary := fmt.Sprintf("last executed batch on Elrond: %d, latest batch on Ethereum: %d, pending batches: %d",
+		report.LastExecutedBatchID, report.LatestEthereumBatchID, len(report.PendingBatchIDs))
+	if report.Truncated {
+		summary += " (scan truncated, there might be more)"
+	}
+	if len(report.PendingBatchIDs) > 0 {
+		summary += fmt.Sprintf(", pending batch IDs: %v", report.PendingBatchIDs)
+	}
+	for _, divergence := range report.Divergences {
+		summary += "\ndivergence: " + divergence
+	}
+
+	return summary
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (reconciler *batchReconciler) IsInterfaceNil() bool {
+	return reconciler == nil
+}
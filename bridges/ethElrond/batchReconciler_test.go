@@ -0,0 +1,182 @@
+package ethElrond
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsBatchReconciler(lastExecutedBatchID uint64, ethereumBatchIDs ...uint64) ArgsBatchReconciler {
+	existingBatches := make(map[uint64]struct{})
+	for _, batchID := range ethereumBatchIDs {
+		existingBatches[batchID] = struct{}{}
+	}
+
+	return ArgsBatchReconciler{
+		ElrondClient: &bridgeTests.ElrondClientStub{
+			GetLastExecutedEthBatchIDCalled: func(ctx context.Context) (uint64, error) {
+				return lastExecutedBatchID, nil
+			},
+		},
+		EthereumClient: &bridgeTests.EthereumClientStub{
+			GetBatchCalled: func(ctx context.Context, nonce uint64) (*clients.TransferBatch, error) {
+				_, found := existingBatches[nonce]
+				if !found {
+					return &clients.TransferBatch{}, nil
+				}
+
+				return &clients.TransferBatch{
+					ID:       nonce,
+					Deposits: []*clients.DepositTransfer{{Nonce: nonce}},
+				}, nil
+			},
+		},
+		MaxPendingBatches: 10,
+	}
+}
+
+func TestNewBatchReconciler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil Elrond client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBatchReconciler(0)
+		args.ElrondClient = nil
+
+		reconciler, err := NewBatchReconciler(args)
+		assert.True(t, check.IfNil(reconciler))
+		assert.Equal(t, ErrNilElrondClient, err)
+	})
+	t.Run("nil Ethereum client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBatchReconciler(0)
+		args.EthereumClient = nil
+
+		reconciler, err := NewBatchReconciler(args)
+		assert.True(t, check.IfNil(reconciler))
+		assert.Equal(t, ErrNilEthereumClient, err)
+	})
+	t.Run("invalid MaxPendingBatches should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBatchReconciler(0)
+		args.MaxPendingBatches = 0
+
+		reconciler, err := NewBatchReconciler(args)
+		assert.True(t, check.IfNil(reconciler))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "args.MaxPendingBatches"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		reconciler, err := NewBatchReconciler(createMockArgsBatchReconciler(0))
+		assert.False(t, check.IfNil(reconciler))
+		assert.Nil(t, err)
+	})
+}
+
+func TestBatchReconciler_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Elrond client error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBatchReconciler(0)
+		args.ElrondClient = &bridgeTests.ElrondClientStub{
+			GetLastExecutedEthBatchIDCalled: func(ctx context.Context) (uint64, error) {
+				return 0, expectedErr
+			},
+		}
+		reconciler, _ := NewBatchReconciler(args)
+
+		_, err := reconciler.Reconcile(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("Ethereum client error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBatchReconciler(3)
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GetBatchCalled: func(ctx context.Context, nonce uint64) (*clients.TransferBatch, error) {
+				return nil, expectedErr
+			},
+		}
+		reconciler, _ := NewBatchReconciler(args)
+
+		_, err := reconciler.Reconcile(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("in sync chains should not report pending batches", func(t *testing.T) {
+		t.Parallel()
+
+		reconciler, _ := NewBatchReconciler(createMockArgsBatchReconciler(3, 1, 2, 3))
+
+		report, err := reconciler.Reconcile(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(3), report.LastExecutedBatchID)
+		assert.Equal(t, uint64(3), report.LatestEthereumBatchID)
+		assert.Empty(t, report.PendingBatchIDs)
+		assert.Empty(t, report.Divergences)
+		assert.False(t, report.Truncated)
+		assert.Equal(t, "last executed batch on Elrond: 3, latest batch on Ethereum: 3, pending batches: 0", report.Summary)
+	})
+	t.Run("lagging Elrond should report the pending batches", func(t *testing.T) {
+		t.Parallel()
+
+		reconciler, _ := NewBatchReconciler(createMockArgsBatchReconciler(1, 1, 2, 3))
+
+		report, err := reconciler.Reconcile(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1), report.LastExecutedBatchID)
+		assert.Equal(t, uint64(3), report.LatestEthereumBatchID)
+		assert.Equal(t, []uint64{2, 3}, report.PendingBatchIDs)
+		assert.Empty(t, report.Divergences)
+		assert.Equal(t, "last executed batch on Elrond: 1, latest batch on Ethereum: 3, pending batches: 2, pending batch IDs: [2 3]", report.Summary)
+	})
+	t.Run("nothing executed should report all the batches as pending", func(t *testing.T) {
+		t.Parallel()
+
+		reconciler, _ := NewBatchReconciler(createMockArgsBatchReconciler(0, 1, 2))
+
+		report, err := reconciler.Reconcile(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []uint64{1, 2}, report.PendingBatchIDs)
+		assert.Equal(t, uint64(2), report.LatestEthereumBatchID)
+		assert.Empty(t, report.Divergences)
+	})
+	t.Run("too many pending batches should truncate the scan", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBatchReconciler(0, 1, 2, 3, 4)
+		args.MaxPendingBatches = 2
+		reconciler, _ := NewBatchReconciler(args)
+
+		report, err := reconciler.Reconcile(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []uint64{1, 2}, report.PendingBatchIDs)
+		assert.True(t, report.Truncated)
+		assert.True(t, strings.Contains(report.Summary, "scan truncated"))
+	})
+	t.Run("executed batch missing on Ethereum should report a divergence", func(t *testing.T) {
+		t.Parallel()
+
+		reconciler, _ := NewBatchReconciler(createMockArgsBatchReconciler(5, 1, 2, 3))
+
+		report, err := reconciler.Reconcile(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(5), report.LastExecutedBatchID)
+		assert.Equal(t, uint64(0), report.LatestEthereumBatchID)
+		assert.Empty(t, report.PendingBatchIDs)
+		assert.Equal(t, []string{"last executed batch 5 on Elrond was not found on Ethereum"}, report.Divergences)
+		assert.True(t, strings.Contains(report.Summary, "divergence: last executed batch 5 on Elrond was not found on Ethereum"))
+	})
+}
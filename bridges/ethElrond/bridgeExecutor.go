@@ -2,6 +2,8 @@ package ethElrond
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"time"
 
@@ -16,8 +18,15 @@ import (
 // we wait for the transfer confirmation on Ethereum
 const splits = 10
 
+// splitsWeight - represents the sum of all the weights (1 + 2 + ... + splits) used when computing the
+// increasing wait intervals
+const splitsWeight = splits * (splits + 1) / 2
+
 const minRetries = 1
 
+// correlationIDLength represents the number of random bytes used when generating a batch correlation ID
+const correlationIDLength = 8
+
 // ArgsBridgeExecutor is the arguments DTO struct used in both bridges
 type ArgsBridgeExecutor struct {
 	Log                        logger.Logger
@@ -31,6 +40,10 @@ type ArgsBridgeExecutor struct {
 	MaxQuorumRetriesOnEthereum uint64
 	MaxQuorumRetriesOnElrond   uint64
 	MaxRestriesOnWasProposed   uint64
+	TransferMetrics            core.TransferMetrics
+	Timer                      core.Timer
+	ResetNonceOnStuckTransfer  bool
+	ReadOnly                   bool
 }
 
 type bridgeExecutor struct {
@@ -45,13 +58,21 @@ type bridgeExecutor struct {
 	maxQuorumRetriesOnEthereum uint64
 	maxQuorumRetriesOnElrond   uint64
 	maxRetriesOnWasProposed    uint64
+	transferMetrics            core.TransferMetrics
+	timer                      core.Timer
+	resetNonceOnStuckTransfer  bool
+	readOnly                   bool
 
 	batch                   *clients.TransferBatch
+	batchDetectionTime      time.Time
+	actionIsTransfer        bool
 	actionID                uint64
 	msgHash                 common.Hash
 	quorumRetriesOnEthereum uint64
 	quorumRetriesOnElrond   uint64
 	retriesOnWasProposed    uint64
+	lastTransferTxHash      string
+	lastTransferTimestamp   int64
 }
 
 // NewBridgeExecutor creates a bridge executor, which can be used for both half-bridges
@@ -102,6 +123,12 @@ func checkArgs(args ArgsBridgeExecutor) error {
 		return fmt.Errorf("%w for args.MaxRestriesOnWasProposed, got: %d, minimum: %d",
 			clients.ErrInvalidValue, args.MaxRestriesOnWasProposed, minRetries)
 	}
+	if check.IfNil(args.TransferMetrics) {
+		return ErrNilTransferMetrics
+	}
+	if check.IfNil(args.Timer) {
+		return ErrNilTimer
+	}
 	return nil
 }
 
@@ -118,11 +145,18 @@ func createBridgeExecutor(args ArgsBridgeExecutor) *bridgeExecutor {
 		maxQuorumRetriesOnEthereum: args.MaxQuorumRetriesOnEthereum,
 		maxQuorumRetriesOnElrond:   args.MaxQuorumRetriesOnElrond,
 		maxRetriesOnWasProposed:    args.MaxRestriesOnWasProposed,
+		transferMetrics:            args.TransferMetrics,
+		timer:                      args.Timer,
+		resetNonceOnStuckTransfer:  args.ResetNonceOnStuckTransfer,
+		readOnly:                   args.ReadOnly,
 	}
 }
 
 // PrintInfo will print the provided data through the inner logger instance
 func (executor *bridgeExecutor) PrintInfo(logLevel logger.LogLevel, message string, extras ...interface{}) {
+	if executor.batch != nil && len(executor.batch.CorrelationID) > 0 {
+		extras = append(extras, "correlation ID", executor.batch.CorrelationID)
+	}
 	executor.log.Log(logLevel, message, extras...)
 
 	switch logLevel {
@@ -145,6 +179,23 @@ func (executor *bridgeExecutor) MyTurnAsLeader() bool {
 	return executor.topologyProvider.MyTurnAsLeader()
 }
 
+// TimeUntilMyTurnAsLeader returns the time left until the current relayer node becomes the leader
+func (executor *bridgeExecutor) TimeUntilMyTurnAsLeader() time.Duration {
+	return executor.topologyProvider.TimeUntilMyTurn()
+}
+
+// PrintNotMyTurnAsLeader will print, at debug level, that the current relayer node is not the leader. As the time
+// left until its turn requires computing the leaders of the upcoming rounds, it is computed only if the debug
+// level is enabled
+func (executor *bridgeExecutor) PrintNotMyTurnAsLeader() {
+	if executor.log.GetLevel() > logger.LogDebug {
+		return
+	}
+
+	executor.PrintInfo(logger.LogDebug, "not my turn as leader in this round",
+		"time until my turn", executor.TimeUntilMyTurnAsLeader())
+}
+
 // GetBatchFromElrond fetches the pending batch from Elrond
 func (executor *bridgeExecutor) GetBatchFromElrond(ctx context.Context) (*clients.TransferBatch, error) {
 	batch, err := executor.elrondClient.GetPending(ctx)
@@ -160,10 +211,50 @@ func (executor *bridgeExecutor) StoreBatchFromElrond(batch *clients.TransferBatc
 		return ErrNilBatch
 	}
 
-	executor.batch = batch
+	executor.storeBatch(batch)
+	executor.lastTransferTxHash = ""
 	return nil
 }
 
+// storeBatch saves the provided batch. A batch entering the state machine receives a new correlation ID while a
+// refetched batch keeps the correlation ID of the stored one
+func (executor *bridgeExecutor) storeBatch(batch *clients.TransferBatch) {
+	isNewBatch := executor.batch == nil || executor.batch.ID != batch.ID
+	if len(batch.CorrelationID) == 0 {
+		if isNewBatch {
+			batch.CorrelationID = generateCorrelationID()
+		} else {
+			batch.CorrelationID = executor.batch.CorrelationID
+		}
+	}
+	if isNewBatch {
+		executor.clearTransferSignatures()
+		executor.batchDetectionTime = time.Now()
+		executor.log.Debug("new batch stored", "batch ID", batch.ID, "correlation ID", batch.CorrelationID)
+	}
+
+	executor.batch = batch
+	executor.statusHandler.SetStringMetric(core.MetricCurrentBatchCorrelationID, batch.CorrelationID)
+}
+
+func generateCorrelationID() string {
+	buff := make([]byte, correlationIDLength)
+	_, err := rand.Read(buff)
+	if err != nil {
+		return fmt.Sprintf("%x", time.Now().UnixNano())
+	}
+
+	return hex.EncodeToString(buff)
+}
+
+func (executor *bridgeExecutor) observeBatchExecutionDuration() {
+	if executor.batchDetectionTime.IsZero() {
+		return
+	}
+
+	executor.transferMetrics.ObserveBatchExecutionDuration(time.Since(executor.batchDetectionTime))
+}
+
 // GetStoredBatch returns the stored batch
 func (executor *bridgeExecutor) GetStoredBatch() *clients.TransferBatch {
 	return executor.batch
@@ -217,6 +308,7 @@ func (executor *bridgeExecutor) GetAndStoreActionIDForProposeTransferOnElrond(ct
 	}
 
 	executor.actionID = actionID
+	executor.actionIsTransfer = true
 
 	return actionID, nil
 }
@@ -233,6 +325,7 @@ func (executor *bridgeExecutor) GetAndStoreActionIDForProposeSetStatusFromElrond
 	}
 
 	executor.actionID = actionID
+	executor.actionIsTransfer = false
 
 	return actionID, nil
 }
@@ -248,7 +341,7 @@ func (executor *bridgeExecutor) WasTransferProposedOnElrond(ctx context.Context)
 		return false, ErrNilBatch
 	}
 
-	return executor.elrondClient.WasProposedTransfer(ctx, executor.batch)
+	return executor.elrondClient.WasProposedTransfer(executor.contextWithBatchID(ctx), executor.batch)
 }
 
 // ProposeTransferOnElrond propose the transfer on Elrond
@@ -256,14 +349,20 @@ func (executor *bridgeExecutor) ProposeTransferOnElrond(ctx context.Context) err
 	if executor.batch == nil {
 		return ErrNilBatch
 	}
+	if executor.readOnly {
+		executor.log.Info("read only mode: would propose transfer",
+			"batch ID", executor.batch.ID, "correlation ID", executor.batch.CorrelationID, "action ID", executor.actionID, "num deposits", len(executor.batch.Deposits))
+		return nil
+	}
 
-	hash, err := executor.elrondClient.ProposeTransfer(ctx, executor.batch)
+	hash, err := executor.elrondClient.ProposeTransfer(executor.contextWithBatchID(ctx), executor.batch)
 	if err != nil {
 		return err
 	}
 
 	executor.log.Info("proposed transfer", "hash", hash,
-		"batch ID", executor.batch.ID, "action ID", executor.actionID)
+		"batch ID", executor.batch.ID, "correlation ID", executor.batch.CorrelationID, "action ID", executor.actionID)
+	executor.transferMetrics.IncTransfersProposed()
 
 	return nil
 }
@@ -289,7 +388,7 @@ func (executor *bridgeExecutor) WasSetStatusProposedOnElrond(ctx context.Context
 		return false, ErrNilBatch
 	}
 
-	return executor.elrondClient.WasProposedSetStatus(ctx, executor.batch)
+	return executor.elrondClient.WasProposedSetStatus(executor.contextWithBatchID(ctx), executor.batch)
 }
 
 // ProposeSetStatusOnElrond propose set status on Elrond
@@ -297,14 +396,19 @@ func (executor *bridgeExecutor) ProposeSetStatusOnElrond(ctx context.Context) er
 	if executor.batch == nil {
 		return ErrNilBatch
 	}
+	if executor.readOnly {
+		executor.log.Info("read only mode: would propose set status",
+			"batch ID", executor.batch.ID, "correlation ID", executor.batch.CorrelationID, "statuses", executor.batch.Statuses)
+		return nil
+	}
 
-	hash, err := executor.elrondClient.ProposeSetStatus(ctx, executor.batch)
+	hash, err := executor.elrondClient.ProposeSetStatus(executor.contextWithBatchID(ctx), executor.batch)
 	if err != nil {
 		return err
 	}
 
 	executor.log.Info("proposed set status", "hash", hash,
-		"batch ID", executor.batch.ID)
+		"batch ID", executor.batch.ID, "correlation ID", executor.batch.CorrelationID)
 
 	return nil
 }
@@ -316,12 +420,18 @@ func (executor *bridgeExecutor) WasActionSignedOnElrond(ctx context.Context) (bo
 
 // SignActionOnElrond calls the Elrond client to generate and send the signature
 func (executor *bridgeExecutor) SignActionOnElrond(ctx context.Context) error {
-	hash, err := executor.elrondClient.Sign(ctx, executor.actionID)
+	if executor.readOnly {
+		executor.log.Info("read only mode: would sign action", "action ID", executor.actionID)
+		return nil
+	}
+
+	hash, err := executor.elrondClient.Sign(executor.contextWithBatchID(ctx), executor.actionID)
 	if err != nil {
 		return err
 	}
 
 	executor.log.Info("signed proposed transfer", "hash", hash, "action ID", executor.actionID)
+	executor.transferMetrics.IncTransfersSigned()
 
 	return nil
 }
@@ -331,20 +441,109 @@ func (executor *bridgeExecutor) ProcessQuorumReachedOnElrond(ctx context.Context
 	return executor.elrondClient.QuorumReached(ctx, executor.actionID)
 }
 
-// WaitForTransferConfirmation waits for the confirmation of a transfer
-func (executor *bridgeExecutor) WaitForTransferConfirmation(ctx context.Context) {
-	wasPerformed := false
-	for i := 0; i < splits && !wasPerformed; i++ {
-		if executor.waitWithContextSucceeded(ctx) {
-			wasPerformed, _ = executor.WasTransferPerformedOnEthereum(ctx)
+// WaitForTransferConfirmation waits for the confirmation of a transfer. It returns ErrTransferReverted if the
+// receipt of the last transfer transaction sent by this relayer signals a failed execution. If the transfer was
+// not confirmed in the wait interval, the last transfer transaction is checked for being stuck
+func (executor *bridgeExecutor) WaitForTransferConfirmation(ctx context.Context) error {
+	for i := 0; i < splits; i++ {
+		if !executor.waitWithContextSucceeded(ctx, i) {
+			return nil
+		}
+
+		wasPerformed, _ := executor.WasTransferPerformedOnEthereum(ctx)
+		if wasPerformed {
+			return nil
+		}
+
+		err := executor.checkLastTransferReceipt(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	executor.handleStuckTransfer(ctx)
+
+	return nil
+}
+
+func (executor *bridgeExecutor) checkLastTransferReceipt(ctx context.Context) error {
+	if len(executor.lastTransferTxHash) == 0 {
+		return nil
+	}
+
+	isReverted, err := executor.ethereumClient.IsTransactionReverted(ctx, executor.lastTransferTxHash)
+	if err != nil {
+		executor.log.Debug("got message while fetching the transfer receipt",
+			"hash", executor.lastTransferTxHash, "message", err)
+		return nil
+	}
+	if isReverted {
+		return fmt.Errorf("%w, hash: %s", ErrTransferReverted, executor.lastTransferTxHash)
+	}
+
+	return nil
+}
+
+// isTransferStuck returns true if the provided transfer transaction was sent at least timeForWaitOnEthereum ago
+// and it still has no receipt
+func (executor *bridgeExecutor) isTransferStuck(ctx context.Context, txHash string, sentTimestamp int64) bool {
+	if len(txHash) == 0 {
+		return false
+	}
+
+	elapsed := time.Duration(executor.timer.NowUnix()-sentTimestamp) * time.Second
+	if elapsed < executor.timeForWaitOnEthereum {
+		return false
+	}
+
+	wasMined, err := executor.ethereumClient.WasTransactionMined(ctx, txHash)
+	if err != nil {
+		executor.log.Debug("got message while fetching the transfer receipt",
+			"hash", txHash, "message", err)
+		return false
+	}
+
+	return !wasMined
+}
+
+// handleStuckTransfer reports the last transfer transaction if it is stuck and re-synchronizes the account nonce with
+// the chain pending nonce, so a dropped transfer does not leave a nonce gap. When enabled, the account nonce is instead
+// re-synchronized with the last mined nonce so the next transfer reuses the stuck nonce, replacing the pending
+// transaction with one sent at the current gas price
+func (executor *bridgeExecutor) handleStuckTransfer(ctx context.Context) {
+	if !executor.isTransferStuck(ctx, executor.lastTransferTxHash, executor.lastTransferTimestamp) {
+		return
+	}
+
+	executor.log.Warn("transfer transaction is stuck", "hash", executor.lastTransferTxHash,
+		"batch ID", executor.batch.ID, "correlation ID", executor.batch.CorrelationID,
+		"waited", time.Duration(executor.timer.NowUnix()-executor.lastTransferTimestamp)*time.Second)
+	executor.statusHandler.AddIntMetric(core.MetricNumStuckTransfers, 1)
+
+	if !executor.resetNonceOnStuckTransfer {
+		err := executor.ethereumClient.ResetNonce(ctx)
+		if err != nil {
+			executor.log.Error("error re-synchronizing the nonce after a stuck transfer",
+				"hash", executor.lastTransferTxHash, "error", err)
 		}
+		return
 	}
+
+	err := executor.ethereumClient.ResetNonceToMined(ctx)
+	if err != nil {
+		executor.log.Error("error resetting the nonce after a stuck transfer",
+			"hash", executor.lastTransferTxHash, "error", err)
+		return
+	}
+
+	executor.log.Info("nonce was reset, the stuck transfer transaction will be replaced", "hash", executor.lastTransferTxHash)
+	executor.lastTransferTxHash = ""
 }
 
 // WaitAndReturnFinalBatchStatuses waits for the statuses to be final
 func (executor *bridgeExecutor) WaitAndReturnFinalBatchStatuses(ctx context.Context) []byte {
 	for i := 0; i < splits; i++ {
-		if !executor.waitWithContextSucceeded(ctx) {
+		if !executor.waitWithContextSucceeded(ctx, i) {
 			return nil
 		}
 
@@ -358,15 +557,15 @@ func (executor *bridgeExecutor) WaitAndReturnFinalBatchStatuses(ctx context.Cont
 			continue
 		}
 
-		executor.log.Debug("bridgeExecutor.WaitAndReturnFinalBatchStatuses", "statuses", statuses)
+		executor.log.Debug("bridgeExecutor.WaitAndReturnFinalBatchStatuses", "statuses", clients.DepositStatusesFromBytes(statuses))
 		return statuses
 	}
 
 	return nil
 }
 
-func (executor *bridgeExecutor) waitWithContextSucceeded(ctx context.Context) bool {
-	timer := time.NewTimer(executor.timeForWaitOnEthereum / splits)
+func (executor *bridgeExecutor) waitWithContextSucceeded(ctx context.Context, pollIndex int) bool {
+	timer := time.NewTimer(executor.waitIntervalForPoll(pollIndex))
 	defer timer.Stop()
 
 	select {
@@ -378,13 +577,32 @@ func (executor *bridgeExecutor) waitWithContextSucceeded(ctx context.Context) bo
 	}
 }
 
+// waitIntervalForPoll returns the time to wait before the provided poll. The intervals grow linearly so a fast
+// confirmation is noticed quickly while a slow one is not polled too often. All the intervals add up to timeForWaitOnEthereum
+func (executor *bridgeExecutor) waitIntervalForPoll(pollIndex int) time.Duration {
+	if pollIndex < 0 {
+		pollIndex = 0
+	}
+	if pollIndex >= splits {
+		pollIndex = splits - 1
+	}
+
+	return executor.cumulatedWaitTime(pollIndex+1) - executor.cumulatedWaitTime(pollIndex)
+}
+
+func (executor *bridgeExecutor) cumulatedWaitTime(numPolls int) time.Duration {
+	weight := int64(numPolls * (numPolls + 1) / 2)
+
+	return time.Duration(int64(executor.timeForWaitOnEthereum) * weight / splitsWeight)
+}
+
 // GetBatchStatusesFromEthereum gets statuses for the batch
 func (executor *bridgeExecutor) GetBatchStatusesFromEthereum(ctx context.Context) ([]byte, error) {
 	if executor.batch == nil {
 		return nil, ErrNilBatch
 	}
 
-	statuses, err := executor.ethereumClient.GetTransactionsStatuses(ctx, executor.batch.ID)
+	statuses, err := executor.ethereumClient.GetTransactionsStatuses(executor.contextWithBatchID(ctx), executor.batch.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -402,14 +620,26 @@ func (executor *bridgeExecutor) PerformActionOnElrond(ctx context.Context) error
 	if executor.batch == nil {
 		return ErrNilBatch
 	}
+	if executor.readOnly {
+		executor.log.Info("read only mode: would perform action",
+			"batch ID", executor.batch.ID, "correlation ID", executor.batch.CorrelationID, "action ID", executor.actionID)
+		return nil
+	}
 
-	hash, err := executor.elrondClient.PerformAction(ctx, executor.actionID, executor.batch)
+	hash, err := executor.elrondClient.PerformAction(executor.contextWithBatchID(ctx), executor.actionID, executor.batch)
 	if err != nil {
+		if executor.actionIsTransfer {
+			executor.transferMetrics.IncTransfersFailed()
+		}
 		return err
 	}
 
 	executor.log.Info("sent perform action transaction", "hash", hash,
-		"batch ID", executor.batch.ID, "action ID", executor.actionID)
+		"batch ID", executor.batch.ID, "correlation ID", executor.batch.CorrelationID, "action ID", executor.actionID)
+	if executor.actionIsTransfer {
+		executor.transferMetrics.IncTransfersSent()
+		executor.observeBatchExecutionDuration()
+	}
 
 	return nil
 }
@@ -436,7 +666,7 @@ func (executor *bridgeExecutor) ResetRetriesCountOnElrond() {
 
 // GetAndStoreBatchFromEthereum fetches and stores the batch from the ethereum client
 func (executor *bridgeExecutor) GetAndStoreBatchFromEthereum(ctx context.Context, nonce uint64) error {
-	batch, err := executor.ethereumClient.GetBatch(ctx, nonce)
+	batch, err := executor.ethereumClient.GetBatch(core.ContextWithBatchID(ctx, nonce), nonce)
 	if err != nil {
 		return err
 	}
@@ -447,7 +677,7 @@ func (executor *bridgeExecutor) GetAndStoreBatchFromEthereum(ctx context.Context
 			ErrBatchNotFound, nonce, batch.ID, len(batch.Deposits))
 	}
 
-	executor.batch = batch
+	executor.storeBatch(batch)
 
 	return nil
 }
@@ -458,25 +688,57 @@ func (executor *bridgeExecutor) WasTransferPerformedOnEthereum(ctx context.Conte
 		return false, ErrNilBatch
 	}
 
-	return executor.ethereumClient.WasExecuted(ctx, executor.batch.ID)
+	wasExecuted, err := executor.ethereumClient.WasExecuted(executor.contextWithBatchID(ctx), executor.batch.ID)
+	if err != nil {
+		return false, err
+	}
+	if wasExecuted {
+		// the signatures are kept until the transfer is executed as a stuck transfer is resent with the same signatures
+		executor.clearTransferSignatures()
+	}
+
+	return wasExecuted, nil
+}
+
+// clearTransferSignatures drops the signatures gathered for the message hash of the stored batch, if any
+func (executor *bridgeExecutor) clearTransferSignatures() {
+	if executor.msgHash == (common.Hash{}) {
+		return
+	}
+
+	executor.sigsHolder.ClearSignaturesForHash(executor.msgHash.Bytes())
+	executor.msgHash = common.Hash{}
 }
 
 // SignTransferOnEthereum generates the message hash for batch and broadcast the signature
-func (executor *bridgeExecutor) SignTransferOnEthereum() error {
+func (executor *bridgeExecutor) SignTransferOnEthereum(ctx context.Context) error {
 	if executor.batch == nil {
 		return ErrNilBatch
 	}
 
-	hash, err := executor.ethereumClient.GenerateMessageHash(executor.batch)
+	hash, err := executor.ethereumClient.GenerateMessageHash(executor.contextWithBatchID(ctx), executor.batch)
 	if err != nil {
 		return err
 	}
 
 	executor.log.Info("generated message hash on Ethereum", "hash", hash,
-		"batch ID", executor.batch.ID)
+		"batch ID", executor.batch.ID, "correlation ID", executor.batch.CorrelationID)
 
 	executor.msgHash = hash
-	executor.ethereumClient.BroadcastSignatureForMessageHash(hash)
+
+	if executor.readOnly {
+		executor.log.Info("read only mode: would broadcast signature",
+			"hash", hash, "batch ID", executor.batch.ID, "correlation ID", executor.batch.CorrelationID)
+		return nil
+	}
+
+	err = executor.ethereumClient.BroadcastSignatureForMessageHash(hash)
+	if err != nil {
+		return err
+	}
+
+	executor.transferMetrics.IncTransfersSigned()
+
 	return nil
 }
 
@@ -485,6 +747,7 @@ func (executor *bridgeExecutor) PerformTransferOnEthereum(ctx context.Context) e
 	if executor.batch == nil {
 		return ErrNilBatch
 	}
+	executor.lastTransferTxHash = ""
 
 	quorumSize, err := executor.ethereumClient.GetQuorumSize(ctx)
 	if err != nil {
@@ -493,13 +756,22 @@ func (executor *bridgeExecutor) PerformTransferOnEthereum(ctx context.Context) e
 
 	executor.log.Debug("fetched quorum size", "quorum", quorumSize.Int64())
 
-	hash, err := executor.ethereumClient.ExecuteTransfer(ctx, executor.msgHash, executor.batch, int(quorumSize.Int64()))
+	if executor.readOnly {
+		executor.log.Info("read only mode: would execute transfer", "message hash", executor.msgHash,
+			"batch ID", executor.batch.ID, "correlation ID", executor.batch.CorrelationID, "quorum", quorumSize.Int64())
+		return nil
+	}
+
+	hash, err := executor.ethereumClient.ExecuteTransfer(executor.contextWithBatchID(ctx), executor.msgHash, executor.batch, int(quorumSize.Int64()))
 	if err != nil {
 		return err
 	}
 
 	executor.log.Info("sent execute transfer", "hash", hash,
-		"batch ID", executor.batch.ID)
+		"batch ID", executor.batch.ID, "correlation ID", executor.batch.CorrelationID)
+	executor.lastTransferTxHash = hash
+	executor.lastTransferTimestamp = executor.timer.NowUnix()
+	executor.observeBatchExecutionDuration()
 
 	return nil
 }
@@ -532,9 +804,23 @@ func (executor *bridgeExecutor) ClearStoredP2PSignaturesForEthereum() {
 
 // ValidateBatch returns true if the given batch is validated on microservice side
 func (executor *bridgeExecutor) ValidateBatch(ctx context.Context, batch *clients.TransferBatch) (bool, error) {
+	if batch != nil {
+		ctx = core.ContextWithBatchID(ctx, batch.ID)
+	}
+
 	return executor.batchValidator.ValidateBatch(ctx, batch)
 }
 
+func (executor *bridgeExecutor) contextWithBatchID(ctx context.Context) context.Context {
+	if executor.batch == nil {
+		return ctx
+	}
+
+	ctx = core.ContextWithBatchID(ctx, executor.batch.ID)
+
+	return core.ContextWithCorrelationID(ctx, executor.batch.CorrelationID)
+}
+
 // CheckElrondClientAvailability trigger a self availability check for the elrond client
 func (executor *bridgeExecutor) CheckElrondClientAvailability(ctx context.Context) error {
 	return executor.elrondClient.CheckClientAvailability(ctx)
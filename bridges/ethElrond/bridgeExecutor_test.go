@@ -36,6 +36,8 @@ func createMockExecutorArgs() ArgsBridgeExecutor {
 		MaxQuorumRetriesOnEthereum: minRetries,
 		MaxQuorumRetriesOnElrond:   minRetries,
 		MaxRestriesOnWasProposed:   minRetries,
+		TransferMetrics:            &testsCommon.TransferMetricsStub{},
+		Timer:                      testsCommon.NewTimerStub(),
 	}
 }
 
@@ -155,6 +157,26 @@ func TestNewBridgeExecutor(t *testing.T) {
 		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
 		assert.True(t, strings.Contains(err.Error(), "for args.MaxRestriesOnWasProposed"))
 	})
+	t.Run("nil transfer metrics", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.TransferMetrics = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilTransferMetrics, err)
+	})
+	t.Run("nil timer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.Timer = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilTimer, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -220,6 +242,72 @@ func TestEthToElrondBridgeExecutor_MyTurnAsLeader(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestBridgeExecutor_TimeUntilMyTurnAsLeader(t *testing.T) {
+	t.Parallel()
+
+	args := createMockExecutorArgs()
+	args.TopologyProvider = &bridgeTests.TopologyProviderStub{
+		TimeUntilMyTurnCalled: func() time.Duration {
+			return time.Second * 7
+		},
+	}
+
+	executor, _ := NewBridgeExecutor(args)
+	assert.Equal(t, time.Second*7, executor.TimeUntilMyTurnAsLeader())
+}
+
+func TestBridgeExecutor_PrintNotMyTurnAsLeader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("debug level disabled should not compute the time until my turn", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.TopologyProvider = &bridgeTests.TopologyProviderStub{
+			TimeUntilMyTurnCalled: func() time.Duration {
+				assert.Fail(t, "should have not called TimeUntilMyTurn")
+				return 0
+			},
+		}
+		args.Log = &testsCommon.LoggerStub{
+			GetLevelCalled: func() logger.LogLevel {
+				return logger.LogInfo
+			},
+			LogCalled: func(logLevel logger.LogLevel, message string, args ...interface{}) {
+				assert.Fail(t, "should have not logged")
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.PrintNotMyTurnAsLeader()
+	})
+	t.Run("debug level enabled should print the time until my turn", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.TopologyProvider = &bridgeTests.TopologyProviderStub{
+			TimeUntilMyTurnCalled: func() time.Duration {
+				return time.Second * 7
+			},
+		}
+		wasCalled := false
+		args.Log = &testsCommon.LoggerStub{
+			GetLevelCalled: func() logger.LogLevel {
+				return logger.LogDebug
+			},
+			LogCalled: func(logLevel logger.LogLevel, message string, args ...interface{}) {
+				wasCalled = true
+				assert.Equal(t, logger.LogDebug, logLevel)
+				assert.Equal(t, []interface{}{"time until my turn", time.Second * 7}, args)
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.PrintNotMyTurnAsLeader()
+		assert.True(t, wasCalled)
+	})
+}
+
 func TestEthToElrondBridgeExecutor_GetAndStoreActionIDForProposeTransferOnElrond(t *testing.T) {
 	t.Parallel()
 
@@ -581,6 +669,9 @@ func TestEthToElrondBridgeExecutor_ProposeTransferOnElrond(t *testing.T) {
 		args.ElrondClient = &bridgeTests.ElrondClientStub{
 			ProposeTransferCalled: func(ctx context.Context, batch *clients.TransferBatch) (string, error) {
 				assert.True(t, providedBatch == batch)
+				batchID, found := core.BatchIDFromContext(ctx)
+				assert.True(t, found)
+				assert.Equal(t, providedBatch.ID, batchID)
 				wasCalled = true
 
 				return "", nil
@@ -1064,6 +1155,116 @@ func TestElrondToEthBridgeExecutor_WasTransferPerformedOnEthereum(t *testing.T)
 		assert.Nil(t, err)
 		assert.True(t, wasCalled)
 	})
+	t.Run("executed transfer should clear the signatures", func(t *testing.T) {
+		t.Parallel()
+
+		providedHash := common.HexToHash("0x1234")
+		args := createMockExecutorArgs()
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			WasExecutedCalled: func(ctx context.Context, batchID uint64) (bool, error) {
+				return true, nil
+			},
+		}
+		var clearedHash []byte
+		args.SignaturesHolder = &testsCommon.SignaturesHolderStub{
+			ClearSignaturesForHashCalled: func(messageHash []byte) {
+				clearedHash = messageHash
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = &clients.TransferBatch{ID: 1}
+		executor.msgHash = providedHash
+
+		wasPerformed, err := executor.WasTransferPerformedOnEthereum(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, wasPerformed)
+		assert.Equal(t, providedHash.Bytes(), clearedHash)
+		assert.Equal(t, common.Hash{}, executor.msgHash)
+	})
+	t.Run("not executed transfer should keep the signatures", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			WasExecutedCalled: func(ctx context.Context, batchID uint64) (bool, error) {
+				return false, nil
+			},
+		}
+		args.SignaturesHolder = &testsCommon.SignaturesHolderStub{
+			ClearSignaturesForHashCalled: func(messageHash []byte) {
+				assert.Fail(t, "should have not cleared the signatures")
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = &clients.TransferBatch{ID: 1}
+		executor.msgHash = common.HexToHash("0x1234")
+
+		wasPerformed, err := executor.WasTransferPerformedOnEthereum(context.Background())
+		assert.Nil(t, err)
+		assert.False(t, wasPerformed)
+	})
+	t.Run("retry after a stuck transfer should keep the signatures", func(t *testing.T) {
+		t.Parallel()
+
+		messageHash := common.BytesToHash(testMessageHash)
+		sigsHolder, _ := NewSignatureHolder(createMockArgsSignaturesHolder())
+		ethMsg0, _ := generateEthMessage(t, messageHash.Bytes())
+		ethMsg1, _ := generateEthMessage(t, messageHash.Bytes())
+		sigsHolder.ProcessNewMessage(generateSignedMessage(0), ethMsg0)
+		sigsHolder.ProcessNewMessage(generateSignedMessage(1), ethMsg1)
+
+		args := createMockExecutorArgs()
+		args.ResetNonceOnStuckTransfer = true
+		args.SignaturesHolder = sigsHolder
+		timer := testsCommon.NewTimerStub()
+		timer.NowUnixCalled = func() int64 {
+			return 1000
+		}
+		args.Timer = timer
+		numSignaturesOnExecute := make([]int, 0)
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			WasExecutedCalled: func(ctx context.Context, batchID uint64) (bool, error) {
+				return false, nil
+			},
+			IsTransactionRevertedCalled: func(ctx context.Context, txHash string) (bool, error) {
+				return false, nil
+			},
+			WasTransactionMinedCalled: func(ctx context.Context, txHash string) (bool, error) {
+				return false, nil
+			},
+			ResetNonceToMinedCalled: func(ctx context.Context) error {
+				return nil
+			},
+			GetQuorumSizeCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(2), nil
+			},
+			ExecuteTransferCalled: func(ctx context.Context, msgHash common.Hash, batch *clients.TransferBatch, quorum int) (string, error) {
+				numSignaturesOnExecute = append(numSignaturesOnExecute, len(sigsHolder.Signatures(msgHash.Bytes())))
+				return "0xtxhash", nil
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = &clients.TransferBatch{ID: 1}
+		executor.msgHash = messageHash
+
+		err := executor.PerformTransferOnEthereum(context.Background())
+		assert.Nil(t, err)
+		executor.lastTransferTimestamp = 999
+		err = executor.WaitForTransferConfirmation(context.Background())
+		assert.Nil(t, err)
+		assert.Empty(t, executor.lastTransferTxHash)
+
+		wasPerformed, err := executor.WasTransferPerformedOnEthereum(context.Background())
+		assert.Nil(t, err)
+		assert.False(t, wasPerformed)
+		err = executor.PerformTransferOnEthereum(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []int{2, 2}, numSignaturesOnExecute)
+		assert.Equal(t, 2, len(sigsHolder.Signatures(messageHash.Bytes())))
+	})
 }
 
 func TestElrondToEthBridgeExecutor_SignTransferOnEthereum(t *testing.T) {
@@ -1075,7 +1276,7 @@ func TestElrondToEthBridgeExecutor_SignTransferOnEthereum(t *testing.T) {
 		args := createMockExecutorArgs()
 		executor, _ := NewBridgeExecutor(args)
 
-		err := executor.SignTransferOnEthereum()
+		err := executor.SignTransferOnEthereum(context.Background())
 		assert.Equal(t, ErrNilBatch, err)
 	})
 	t.Run("GenerateMessageHash fails", func(t *testing.T) {
@@ -1083,14 +1284,14 @@ func TestElrondToEthBridgeExecutor_SignTransferOnEthereum(t *testing.T) {
 
 		args := createMockExecutorArgs()
 		args.EthereumClient = &bridgeTests.EthereumClientStub{
-			GenerateMessageHashCalled: func(batch *clients.TransferBatch) (common.Hash, error) {
+			GenerateMessageHashCalled: func(ctx context.Context, batch *clients.TransferBatch) (common.Hash, error) {
 				return common.Hash{}, expectedErr
 			},
 		}
 
 		executor, _ := NewBridgeExecutor(args)
 		executor.batch = providedBatch
-		err := executor.SignTransferOnEthereum()
+		err := executor.SignTransferOnEthereum(context.Background())
 		assert.Equal(t, expectedErr, err)
 	})
 	t.Run("should work", func(t *testing.T) {
@@ -1100,22 +1301,41 @@ func TestElrondToEthBridgeExecutor_SignTransferOnEthereum(t *testing.T) {
 		wasCalledBroadcastSignatureForMessageHashCalled := false
 		args := createMockExecutorArgs()
 		args.EthereumClient = &bridgeTests.EthereumClientStub{
-			GenerateMessageHashCalled: func(batch *clients.TransferBatch) (common.Hash, error) {
+			GenerateMessageHashCalled: func(ctx context.Context, batch *clients.TransferBatch) (common.Hash, error) {
 				wasCalledGenerateMessageHashCalled = true
 				return common.Hash{}, nil
 			},
-			BroadcastSignatureForMessageHashCalled: func(msgHash common.Hash) {
+			BroadcastSignatureForMessageHashCalled: func(msgHash common.Hash) error {
 				wasCalledBroadcastSignatureForMessageHashCalled = true
+				return nil
 			},
 		}
 
 		executor, _ := NewBridgeExecutor(args)
 		executor.batch = providedBatch
-		err := executor.SignTransferOnEthereum()
+		err := executor.SignTransferOnEthereum(context.Background())
 		assert.Nil(t, err)
 		assert.True(t, wasCalledGenerateMessageHashCalled)
 		assert.True(t, wasCalledBroadcastSignatureForMessageHashCalled)
 	})
+	t.Run("BroadcastSignatureForMessageHash fails", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GenerateMessageHashCalled: func(ctx context.Context, batch *clients.TransferBatch) (common.Hash, error) {
+				return common.Hash{}, nil
+			},
+			BroadcastSignatureForMessageHashCalled: func(msgHash common.Hash) error {
+				return expectedErr
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
+		err := executor.SignTransferOnEthereum(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
 }
 
 func TestElrondToEthBridgeExecutor_PerformTransferOnEthereum(t *testing.T) {
@@ -1157,6 +1377,11 @@ func TestElrondToEthBridgeExecutor_PerformTransferOnEthereum(t *testing.T) {
 				return "", expectedErr
 			},
 		}
+		args.SignaturesHolder = &testsCommon.SignaturesHolderStub{
+			ClearSignaturesForHashCalled: func(messageHash []byte) {
+				assert.Fail(t, "should have not cleared the signatures")
+			},
+		}
 
 		executor, _ := NewBridgeExecutor(args)
 		executor.batch = providedBatch
@@ -1182,7 +1407,12 @@ func TestElrondToEthBridgeExecutor_PerformTransferOnEthereum(t *testing.T) {
 				assert.True(t, providedQuorum == quorum)
 
 				wasCalledExecuteTransferCalled = true
-				return "", nil
+				return "0xtxhash", nil
+			},
+		}
+		args.SignaturesHolder = &testsCommon.SignaturesHolderStub{
+			ClearSignaturesForHashCalled: func(messageHash []byte) {
+				assert.Fail(t, "should have not cleared the signatures before the transfer was executed")
 			},
 		}
 
@@ -1193,6 +1423,7 @@ func TestElrondToEthBridgeExecutor_PerformTransferOnEthereum(t *testing.T) {
 		assert.Nil(t, err)
 		assert.True(t, wasCalledGetQuorumSizeCalled)
 		assert.True(t, wasCalledExecuteTransferCalled)
+		assert.Equal(t, "0xtxhash", executor.lastTransferTxHash)
 	})
 }
 
@@ -1336,6 +1567,219 @@ func TestWaitForTransferConfirmation(t *testing.T) {
 		assert.True(t, elapsed < args.TimeForWaitOnEthereum)
 		assert.Equal(t, 5, counter)
 	})
+	t.Run("reverted transfer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.TimeForWaitOnEthereum = 10 * time.Second
+		counter := 0
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			WasExecutedCalled: func(ctx context.Context, batchID uint64) (bool, error) {
+				return false, nil
+			},
+			IsTransactionRevertedCalled: func(ctx context.Context, txHash string) (bool, error) {
+				assert.Equal(t, "0xtxhash", txHash)
+				counter++
+				return counter >= 2, nil
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = &clients.TransferBatch{}
+		executor.lastTransferTxHash = "0xtxhash"
+
+		start := time.Now()
+		err := executor.WaitForTransferConfirmation(context.Background())
+		elapsed := time.Since(start)
+
+		assert.True(t, errors.Is(err, ErrTransferReverted))
+		assert.True(t, strings.Contains(err.Error(), "0xtxhash"))
+		assert.True(t, elapsed < args.TimeForWaitOnEthereum)
+		assert.Equal(t, 2, counter)
+	})
+	t.Run("receipt errors should keep waiting", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.TimeForWaitOnEthereum = 2 * time.Second
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			WasExecutedCalled: func(ctx context.Context, batchID uint64) (bool, error) {
+				return false, nil
+			},
+			IsTransactionRevertedCalled: func(ctx context.Context, txHash string) (bool, error) {
+				return false, errors.New("expected error")
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = &clients.TransferBatch{}
+		executor.lastTransferTxHash = "0xtxhash"
+
+		err := executor.WaitForTransferConfirmation(context.Background())
+		assert.Nil(t, err)
+	})
+	t.Run("stuck transfer should reset the nonce", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.ResetNonceOnStuckTransfer = true
+		timer := testsCommon.NewTimerStub()
+		timer.NowUnixCalled = func() int64 {
+			return 1000
+		}
+		args.Timer = timer
+		resetNonceCalled := false
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			WasExecutedCalled: func(ctx context.Context, batchID uint64) (bool, error) {
+				return false, nil
+			},
+			IsTransactionRevertedCalled: func(ctx context.Context, txHash string) (bool, error) {
+				return false, nil
+			},
+			WasTransactionMinedCalled: func(ctx context.Context, txHash string) (bool, error) {
+				return false, nil
+			},
+			ResetNonceToMinedCalled: func(ctx context.Context) error {
+				resetNonceCalled = true
+				return nil
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = &clients.TransferBatch{}
+		executor.lastTransferTxHash = "0xtxhash"
+		executor.lastTransferTimestamp = 999
+
+		err := executor.WaitForTransferConfirmation(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, resetNonceCalled)
+		assert.Empty(t, executor.lastTransferTxHash)
+	})
+}
+
+func TestBridgeExecutor_StuckTransfer(t *testing.T) {
+	t.Parallel()
+
+	sentTimestamp := int64(1000)
+	createExecutor := func(currentTimestamp int64, wasMined bool, errMined error) (*bridgeExecutor, *testsCommon.StatusHandlerMock, *int, *int) {
+		args := createMockExecutorArgs()
+		args.TimeForWaitOnEthereum = 10 * time.Second
+		timer := testsCommon.NewTimerStub()
+		timer.NowUnixCalled = func() int64 {
+			return currentTimestamp
+		}
+		args.Timer = timer
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		numResetNonceCalls, numResetNonceToMinedCalls := 0, 0
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			WasTransactionMinedCalled: func(ctx context.Context, txHash string) (bool, error) {
+				assert.Equal(t, "0xtxhash", txHash)
+				return wasMined, errMined
+			},
+			ResetNonceCalled: func(ctx context.Context) error {
+				numResetNonceCalls++
+				return nil
+			},
+			ResetNonceToMinedCalled: func(ctx context.Context) error {
+				numResetNonceToMinedCalls++
+				return nil
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = &clients.TransferBatch{ID: 37, CorrelationID: "correlation"}
+		executor.lastTransferTxHash = "0xtxhash"
+		executor.lastTransferTimestamp = sentTimestamp
+
+		return executor, statusHandler, &numResetNonceCalls, &numResetNonceToMinedCalls
+	}
+
+	t.Run("no transfer sent should not be stuck", func(t *testing.T) {
+		t.Parallel()
+
+		executor, _, _, _ := createExecutor(sentTimestamp+100, false, nil)
+		assert.False(t, executor.isTransferStuck(context.Background(), "", sentTimestamp))
+	})
+	t.Run("wait interval not elapsed should not be stuck", func(t *testing.T) {
+		t.Parallel()
+
+		executor, _, _, _ := createExecutor(sentTimestamp+9, false, nil)
+		assert.False(t, executor.isTransferStuck(context.Background(), "0xtxhash", sentTimestamp))
+	})
+	t.Run("receipt error should not be stuck", func(t *testing.T) {
+		t.Parallel()
+
+		executor, _, _, _ := createExecutor(sentTimestamp+10, false, expectedErr)
+		assert.False(t, executor.isTransferStuck(context.Background(), "0xtxhash", sentTimestamp))
+	})
+	t.Run("mined transaction should not be stuck", func(t *testing.T) {
+		t.Parallel()
+
+		executor, _, _, _ := createExecutor(sentTimestamp+10, true, nil)
+		assert.False(t, executor.isTransferStuck(context.Background(), "0xtxhash", sentTimestamp))
+	})
+	t.Run("transaction without receipt after the wait interval should be stuck", func(t *testing.T) {
+		t.Parallel()
+
+		executor, _, _, _ := createExecutor(sentTimestamp+10, false, nil)
+		assert.True(t, executor.isTransferStuck(context.Background(), "0xtxhash", sentTimestamp))
+	})
+	t.Run("stuck transfer should only re-synchronize the nonce if the nonce reset is disabled", func(t *testing.T) {
+		t.Parallel()
+
+		executor, statusHandler, numResetNonceCalls, numResetNonceToMinedCalls := createExecutor(sentTimestamp+10, false, nil)
+		executor.handleStuckTransfer(context.Background())
+
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumStuckTransfers))
+		assert.Equal(t, 1, *numResetNonceCalls)
+		assert.Equal(t, 0, *numResetNonceToMinedCalls)
+		assert.Equal(t, "0xtxhash", executor.lastTransferTxHash)
+	})
+	t.Run("stuck transfer should reset the nonce to the mined nonce if enabled", func(t *testing.T) {
+		t.Parallel()
+
+		executor, statusHandler, numResetNonceCalls, numResetNonceToMinedCalls := createExecutor(sentTimestamp+10, false, nil)
+		executor.resetNonceOnStuckTransfer = true
+		executor.handleStuckTransfer(context.Background())
+
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumStuckTransfers))
+		assert.Equal(t, 0, *numResetNonceCalls)
+		assert.Equal(t, 1, *numResetNonceToMinedCalls)
+		assert.Empty(t, executor.lastTransferTxHash)
+	})
+	t.Run("not stuck transfer should not be reported", func(t *testing.T) {
+		t.Parallel()
+
+		executor, statusHandler, numResetNonceCalls, numResetNonceToMinedCalls := createExecutor(sentTimestamp+10, true, nil)
+		executor.resetNonceOnStuckTransfer = true
+		executor.handleStuckTransfer(context.Background())
+
+		assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricNumStuckTransfers))
+		assert.Equal(t, 0, *numResetNonceCalls)
+		assert.Equal(t, 0, *numResetNonceToMinedCalls)
+		assert.Equal(t, "0xtxhash", executor.lastTransferTxHash)
+	})
+}
+
+func TestBridgeExecutor_waitIntervalForPoll(t *testing.T) {
+	t.Parallel()
+
+	args := createMockExecutorArgs()
+	args.TimeForWaitOnEthereum = 10 * time.Second
+	executor, _ := NewBridgeExecutor(args)
+
+	total := time.Duration(0)
+	previous := time.Duration(0)
+	for i := 0; i < splits; i++ {
+		interval := executor.waitIntervalForPoll(i)
+		assert.True(t, interval > previous, "interval for poll %d should be greater than %v, got %v", i, previous, interval)
+
+		previous = interval
+		total += interval
+	}
+
+	assert.Equal(t, args.TimeForWaitOnEthereum, total)
+	assert.True(t, executor.waitIntervalForPoll(0) < args.TimeForWaitOnEthereum/splits)
+	assert.True(t, executor.waitIntervalForPoll(splits-1) > args.TimeForWaitOnEthereum/splits)
+	assert.Equal(t, executor.waitIntervalForPoll(0), executor.waitIntervalForPoll(-1))
+	assert.Equal(t, executor.waitIntervalForPoll(splits-1), executor.waitIntervalForPoll(splits))
 }
 
 func TestGetBatchStatusesFromEthereum(t *testing.T) {
@@ -1655,3 +2099,361 @@ func TestBridgeExecutor_ValidateBatch(t *testing.T) {
 	assert.True(t, result)
 	assert.True(t, validateBatchCalled)
 }
+
+func TestBridgeExecutor_TransferMetrics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("propose and sign should increment the counters", func(t *testing.T) {
+		t.Parallel()
+
+		numProposed := 0
+		numSigned := 0
+		args := createMockExecutorArgs()
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GenerateMessageHashCalled: func(ctx context.Context, batch *clients.TransferBatch) (common.Hash, error) {
+				return common.Hash{}, nil
+			},
+			BroadcastSignatureForMessageHashCalled: func(msgHash common.Hash) error {
+				return nil
+			},
+		}
+		args.TransferMetrics = &testsCommon.TransferMetricsStub{
+			IncTransfersProposedCalled: func() {
+				numProposed++
+			},
+			IncTransfersSignedCalled: func() {
+				numSigned++
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
+
+		err := executor.ProposeTransferOnElrond(context.Background())
+		assert.Nil(t, err)
+		err = executor.SignActionOnElrond(context.Background())
+		assert.Nil(t, err)
+		err = executor.SignTransferOnEthereum(context.Background())
+		assert.Nil(t, err)
+
+		assert.Equal(t, 1, numProposed)
+		assert.Equal(t, 2, numSigned)
+	})
+	t.Run("failed sign should not increment the counter", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.ElrondClient = &bridgeTests.ElrondClientStub{
+			SignCalled: func(ctx context.Context, actionID uint64) (string, error) {
+				return "", expectedErr
+			},
+		}
+		args.TransferMetrics = &testsCommon.TransferMetricsStub{
+			IncTransfersSignedCalled: func() {
+				assert.Fail(t, "should have not been called")
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
+
+		err := executor.SignActionOnElrond(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("perform transfer action on Elrond should count the execution and observe the duration", func(t *testing.T) {
+		t.Parallel()
+
+		numExecuted := 0
+		var observedDuration time.Duration
+		args := createMockExecutorArgs()
+		args.ElrondClient = &bridgeTests.ElrondClientStub{
+			GetActionIDForProposeTransferCalled: func(ctx context.Context, batch *clients.TransferBatch) (uint64, error) {
+				return 1, nil
+			},
+		}
+		args.TransferMetrics = &testsCommon.TransferMetricsStub{
+			IncTransfersSentCalled: func() {
+				numExecuted++
+			},
+			ObserveBatchExecutionDurationCalled: func(duration time.Duration) {
+				observedDuration = duration
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		err := executor.StoreBatchFromElrond(providedBatch)
+		assert.Nil(t, err)
+		executor.batchDetectionTime = time.Now().Add(-time.Minute)
+
+		_, err = executor.GetAndStoreActionIDForProposeTransferOnElrond(context.Background())
+		assert.Nil(t, err)
+		err = executor.PerformActionOnElrond(context.Background())
+		assert.Nil(t, err)
+
+		assert.Equal(t, 1, numExecuted)
+		assert.True(t, observedDuration >= time.Minute)
+	})
+	t.Run("failed transfer action on Elrond should count the failure", func(t *testing.T) {
+		t.Parallel()
+
+		numFailed := 0
+		args := createMockExecutorArgs()
+		args.ElrondClient = &bridgeTests.ElrondClientStub{
+			GetActionIDForProposeTransferCalled: func(ctx context.Context, batch *clients.TransferBatch) (uint64, error) {
+				return 1, nil
+			},
+			PerformActionCalled: func(ctx context.Context, actionID uint64, batch *clients.TransferBatch) (string, error) {
+				return "", expectedErr
+			},
+		}
+		args.TransferMetrics = &testsCommon.TransferMetricsStub{
+			IncTransfersFailedCalled: func() {
+				numFailed++
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
+
+		_, err := executor.GetAndStoreActionIDForProposeTransferOnElrond(context.Background())
+		assert.Nil(t, err)
+		err = executor.PerformActionOnElrond(context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, numFailed)
+	})
+	t.Run("perform set status action on Elrond should not count as a transfer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.ElrondClient = &bridgeTests.ElrondClientStub{
+			GetActionIDForSetStatusOnPendingTransferCalled: func(ctx context.Context, batch *clients.TransferBatch) (uint64, error) {
+				return 1, nil
+			},
+		}
+		args.TransferMetrics = &testsCommon.TransferMetricsStub{
+			IncTransfersSentCalled: func() {
+				assert.Fail(t, "should have not been called")
+			},
+			ObserveBatchExecutionDurationCalled: func(duration time.Duration) {
+				assert.Fail(t, "should have not been called")
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
+
+		_, err := executor.GetAndStoreActionIDForProposeSetStatusFromElrond(context.Background())
+		assert.Nil(t, err)
+		err = executor.PerformActionOnElrond(context.Background())
+		assert.Nil(t, err)
+	})
+	t.Run("perform transfer on Ethereum should observe the duration", func(t *testing.T) {
+		t.Parallel()
+
+		numObservations := 0
+		args := createMockExecutorArgs()
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GetQuorumSizeCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(1), nil
+			},
+			ExecuteTransferCalled: func(ctx context.Context, msgHash common.Hash, batch *clients.TransferBatch, quorum int) (string, error) {
+				return "0xtxhash", nil
+			},
+		}
+		args.TransferMetrics = &testsCommon.TransferMetricsStub{
+			ObserveBatchExecutionDurationCalled: func(duration time.Duration) {
+				numObservations++
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		err := executor.StoreBatchFromElrond(providedBatch)
+		assert.Nil(t, err)
+
+		err = executor.PerformTransferOnEthereum(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numObservations)
+	})
+	t.Run("storing the same batch should keep the detection time", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		executor, _ := NewBridgeExecutor(args)
+
+		batch := &clients.TransferBatch{ID: 1}
+		err := executor.StoreBatchFromElrond(batch)
+		assert.Nil(t, err)
+		detectionTime := time.Now().Add(-time.Hour)
+		executor.batchDetectionTime = detectionTime
+
+		err = executor.StoreBatchFromElrond(&clients.TransferBatch{ID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, detectionTime, executor.batchDetectionTime)
+
+		err = executor.StoreBatchFromElrond(&clients.TransferBatch{ID: 2})
+		assert.Nil(t, err)
+		assert.True(t, executor.batchDetectionTime.After(detectionTime))
+	})
+}
+
+func TestBridgeExecutor_ReadOnly(t *testing.T) {
+	t.Parallel()
+
+	providedHash := common.HexToHash("0x1234")
+	args := createMockExecutorArgs()
+	args.ReadOnly = true
+	args.ElrondClient = &bridgeTests.ElrondClientStub{
+		ProposeTransferCalled: func(ctx context.Context, batch *clients.TransferBatch) (string, error) {
+			assert.Fail(t, "should have not been called")
+			return "", nil
+		},
+		ProposeSetStatusCalled: func(ctx context.Context, batch *clients.TransferBatch) (string, error) {
+			assert.Fail(t, "should have not been called")
+			return "", nil
+		},
+		SignCalled: func(ctx context.Context, actionID uint64) (string, error) {
+			assert.Fail(t, "should have not been called")
+			return "", nil
+		},
+		PerformActionCalled: func(ctx context.Context, actionID uint64, batch *clients.TransferBatch) (string, error) {
+			assert.Fail(t, "should have not been called")
+			return "", nil
+		},
+	}
+	args.EthereumClient = &bridgeTests.EthereumClientStub{
+		GenerateMessageHashCalled: func(ctx context.Context, batch *clients.TransferBatch) (common.Hash, error) {
+			return providedHash, nil
+		},
+		BroadcastSignatureForMessageHashCalled: func(msgHash common.Hash) error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		},
+		GetQuorumSizeCalled: func(ctx context.Context) (*big.Int, error) {
+			return big.NewInt(3), nil
+		},
+		ExecuteTransferCalled: func(ctx context.Context, msgHash common.Hash, batch *clients.TransferBatch, quorum int) (string, error) {
+			assert.Fail(t, "should have not been called")
+			return "", nil
+		},
+	}
+	args.SignaturesHolder = &testsCommon.SignaturesHolderStub{
+		ClearSignaturesForHashCalled: func(messageHash []byte) {
+			assert.Fail(t, "should have not been called")
+		},
+	}
+	args.TransferMetrics = &testsCommon.TransferMetricsStub{
+		IncTransfersProposedCalled: func() {
+			assert.Fail(t, "should have not been called")
+		},
+		IncTransfersSignedCalled: func() {
+			assert.Fail(t, "should have not been called")
+		},
+		IncTransfersSentCalled: func() {
+			assert.Fail(t, "should have not been called")
+		},
+	}
+	executor, _ := NewBridgeExecutor(args)
+	executor.batch = providedBatch
+
+	assert.Nil(t, executor.ProposeTransferOnElrond(context.Background()))
+	assert.Nil(t, executor.ProposeSetStatusOnElrond(context.Background()))
+	assert.Nil(t, executor.SignActionOnElrond(context.Background()))
+	assert.Nil(t, executor.PerformActionOnElrond(context.Background()))
+	assert.Nil(t, executor.SignTransferOnEthereum(context.Background()))
+	assert.Equal(t, providedHash, executor.msgHash)
+	assert.Nil(t, executor.PerformTransferOnEthereum(context.Background()))
+	assert.Empty(t, executor.lastTransferTxHash)
+}
+
+func TestBridgeExecutor_CorrelationID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("new batch should get a correlation ID", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		executor, _ := NewBridgeExecutor(args)
+
+		err := executor.StoreBatchFromElrond(&clients.TransferBatch{ID: 1})
+		assert.Nil(t, err)
+
+		correlationID := executor.GetStoredBatch().CorrelationID
+		assert.Equal(t, correlationIDLength*2, len(correlationID))
+		assert.Equal(t, correlationID, statusHandler.GetStringMetric(core.MetricCurrentBatchCorrelationID))
+	})
+	t.Run("refetched batch should keep the correlation ID", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		executor, _ := NewBridgeExecutor(args)
+
+		_ = executor.StoreBatchFromElrond(&clients.TransferBatch{ID: 1})
+		correlationID := executor.GetStoredBatch().CorrelationID
+
+		_ = executor.StoreBatchFromElrond(&clients.TransferBatch{ID: 1})
+		assert.Equal(t, correlationID, executor.GetStoredBatch().CorrelationID)
+
+		_ = executor.StoreBatchFromElrond(&clients.TransferBatch{ID: 2})
+		assert.NotEqual(t, correlationID, executor.GetStoredBatch().CorrelationID)
+	})
+	t.Run("batch with correlation ID should keep it", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		executor, _ := NewBridgeExecutor(args)
+
+		_ = executor.StoreBatchFromElrond(&clients.TransferBatch{ID: 1, CorrelationID: "a1b2c3"})
+		assert.Equal(t, "a1b2c3", executor.GetStoredBatch().CorrelationID)
+	})
+	t.Run("correlation ID should be propagated to the clients and the step logs", func(t *testing.T) {
+		t.Parallel()
+
+		var providedCorrelationID string
+		var loggedArgs []interface{}
+		args := createMockExecutorArgs()
+		args.ElrondClient = &bridgeTests.ElrondClientStub{
+			ProposeTransferCalled: func(ctx context.Context, batch *clients.TransferBatch) (string, error) {
+				providedCorrelationID, _ = core.CorrelationIDFromContext(ctx)
+				return "", nil
+			},
+		}
+		args.Log = &testsCommon.LoggerStub{
+			LogCalled: func(logLevel logger.LogLevel, message string, args ...interface{}) {
+				loggedArgs = args
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		_ = executor.StoreBatchFromElrond(&clients.TransferBatch{ID: 1, CorrelationID: "a1b2c3"})
+
+		err := executor.ProposeTransferOnElrond(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, "a1b2c3", providedCorrelationID)
+
+		executor.PrintInfo(logger.LogInfo, "message", "key", "value")
+		assert.Equal(t, []interface{}{"key", "value", "correlation ID", "a1b2c3"}, loggedArgs)
+	})
+}
+
+func TestBridgeExecutor_StoreBatchShouldClearThePreviousBatchSignatures(t *testing.T) {
+	t.Parallel()
+
+	providedHash := common.HexToHash("0x1234")
+	args := createMockExecutorArgs()
+	clearedHashes := make([][]byte, 0)
+	args.SignaturesHolder = &testsCommon.SignaturesHolderStub{
+		ClearSignaturesForHashCalled: func(messageHash []byte) {
+			clearedHashes = append(clearedHashes, messageHash)
+		},
+	}
+	executor, _ := NewBridgeExecutor(args)
+
+	err := executor.StoreBatchFromElrond(&clients.TransferBatch{ID: 1})
+	assert.Nil(t, err)
+	executor.msgHash = providedHash
+
+	err = executor.StoreBatchFromElrond(&clients.TransferBatch{ID: 1})
+	assert.Nil(t, err)
+	assert.Empty(t, clearedHashes)
+	assert.Equal(t, providedHash, executor.msgHash)
+
+	err = executor.StoreBatchFromElrond(&clients.TransferBatch{ID: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{providedHash.Bytes()}, clearedHashes)
+	assert.Equal(t, common.Hash{}, executor.msgHash)
+}
@@ -20,6 +20,7 @@ func TestDisabledSignaturesHolder_MethodsShouldNotPanic(t *testing.T) {
 
 	disabled := NewDisabledSignaturesHolder()
 	assert.False(t, check.IfNil(disabled))
+	disabled.ClearSignaturesForHash(nil)
 	disabled.ClearStoredSignatures()
 
 	sigs := disabled.Signatures(nil)
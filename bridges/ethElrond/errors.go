@@ -40,3 +40,12 @@ var ErrNilSignaturesHolder = errors.New("nil signatures holder")
 
 // ErrNilBatchValidator signals that a nil batch validator was provided
 var ErrNilBatchValidator = errors.New("nil batch validator")
+
+// ErrTransferReverted signals that the transfer transaction sent on Ethereum was reverted
+var ErrTransferReverted = errors.New("transfer reverted")
+
+// ErrNilTimer signals that a nil timer was provided
+var ErrNilTimer = errors.New("nil timer")
+
+// ErrNilTransferMetrics signals that a nil transfer metrics component was provided
+var ErrNilTransferMetrics = errors.New("nil transfer metrics")
@@ -3,6 +3,7 @@ package ethElrond
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ethereum/go-ethereum/common"
@@ -20,14 +21,18 @@ type ElrondClient interface {
 	GetTransactionsStatuses(ctx context.Context, batchID uint64) ([]byte, error)
 	GetActionIDForSetStatusOnPendingTransfer(ctx context.Context, batch *clients.TransferBatch) (uint64, error)
 	GetLastExecutedEthBatchID(ctx context.Context) (uint64, error)
+	GetExecutedBatchIDs(ctx context.Context, fromID uint64, toID uint64) ([]uint64, error)
 	GetLastExecutedEthTxID(ctx context.Context) (uint64, error)
 	GetCurrentNonce(ctx context.Context) (uint64, error)
+	GetQuorum(ctx context.Context) (int, error)
+	GetRelayers(ctx context.Context) ([][]byte, error)
 
 	ProposeSetStatus(ctx context.Context, batch *clients.TransferBatch) (string, error)
 	ProposeTransfer(ctx context.Context, batch *clients.TransferBatch) (string, error)
 	Sign(ctx context.Context, actionID uint64) (string, error)
 	WasSigned(ctx context.Context, actionID uint64) (bool, error)
 	PerformAction(ctx context.Context, actionID uint64, batch *clients.TransferBatch) (string, error)
+	EstimateActionGas(ctx context.Context, actionType clients.ElrondActionType, batch *clients.TransferBatch) (uint64, error)
 	CheckClientAvailability(ctx context.Context) error
 	Close() error
 	IsInterfaceNil() bool
@@ -36,27 +41,35 @@ type ElrondClient interface {
 // EthereumClient defines the behavior of the Ethereum client able to communicate with the Ethereum chain
 type EthereumClient interface {
 	GetBatch(ctx context.Context, nonce uint64) (*clients.TransferBatch, error)
+	VerifyBatchStillValid(ctx context.Context, batch *clients.TransferBatch) (bool, error)
 	WasExecuted(ctx context.Context, batchID uint64) (bool, error)
-	GenerateMessageHash(batch *clients.TransferBatch) (common.Hash, error)
+	GenerateMessageHash(ctx context.Context, batch *clients.TransferBatch) (common.Hash, error)
 
-	BroadcastSignatureForMessageHash(msgHash common.Hash)
+	BroadcastSignatureForMessageHash(msgHash common.Hash) error
 	ExecuteTransfer(ctx context.Context, msgHash common.Hash, batch *clients.TransferBatch, quorum int) (string, error)
 	GetTransactionsStatuses(ctx context.Context, batchId uint64) ([]byte, error)
 	GetQuorumSize(ctx context.Context) (*big.Int, error)
 	IsQuorumReached(ctx context.Context, msgHash common.Hash) (bool, error)
+	IsPaused(ctx context.Context) (bool, error)
+	IsTransactionReverted(ctx context.Context, txHash string) (bool, error)
+	WasTransactionMined(ctx context.Context, txHash string) (bool, error)
 	CheckClientAvailability(ctx context.Context) error
+	ResetNonce(ctx context.Context) error
+	ResetNonceToMined(ctx context.Context) error
 	IsInterfaceNil() bool
 }
 
 // TopologyProvider is able to manage the current relayers topology
 type TopologyProvider interface {
 	MyTurnAsLeader() bool
+	TimeUntilMyTurn() time.Duration
 	IsInterfaceNil() bool
 }
 
 // SignaturesHolder defines the operations for a component that can hold and manage signatures
 type SignaturesHolder interface {
 	Signatures(messageHash []byte) [][]byte
+	ClearSignaturesForHash(messageHash []byte)
 	ClearStoredSignatures()
 	IsInterfaceNil() bool
 }
@@ -1,79 +1,176 @@
 package ethElrond
 
 import (
-	"bytes"
+	"container/list"
+	"fmt"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
-type signaturesHolder struct {
-	mut            sync.RWMutex
+const minSignaturesHolderCapacity = 1
+
+// ArgsSignaturesHolder is the arguments DTO used in the NewSignatureHolder constructor function
+type ArgsSignaturesHolder struct {
+	MaxMessageHashes     int
+	MaxSignaturesPerHash int
+	StatusHandler        core.StatusHandler
+}
+
+// hashSignatures holds the signatures gathered for a single message hash, keyed by the recovered signer
+type hashSignatures struct {
 	signedMessages map[string]*core.SignedMessage
-	ethMessages    []*core.EthereumSignature
+	signatures     map[common.Address][]byte
+	element        *list.Element
+}
+
+// signaturesHolder keeps the signatures for at most maxMessageHashes message hashes. The message hashes are kept in
+// a least recently used order, a hash being used when a signature for it is stored or when its signatures are
+// fetched, so the hashes actively being worked are not evicted
+type signaturesHolder struct {
+	mut                  sync.Mutex
+	signaturesByHash     map[string]*hashSignatures
+	usageOrder           *list.List
+	maxMessageHashes     int
+	maxSignaturesPerHash int
+	statusHandler        core.StatusHandler
 }
 
 // NewSignatureHolder creates a new signatureHolder
-func NewSignatureHolder() *signaturesHolder {
-	return &signaturesHolder{
-		signedMessages: make(map[string]*core.SignedMessage),
-		ethMessages:    make([]*core.EthereumSignature, 0),
+func NewSignatureHolder(args ArgsSignaturesHolder) (*signaturesHolder, error) {
+	if args.MaxMessageHashes < minSignaturesHolderCapacity {
+		return nil, fmt.Errorf("%w for args.MaxMessageHashes, got: %d, minimum: %d",
+			clients.ErrInvalidValue, args.MaxMessageHashes, minSignaturesHolderCapacity)
+	}
+	if args.MaxSignaturesPerHash < minSignaturesHolderCapacity {
+		return nil, fmt.Errorf("%w for args.MaxSignaturesPerHash, got: %d, minimum: %d",
+			clients.ErrInvalidValue, args.MaxSignaturesPerHash, minSignaturesHolderCapacity)
+	}
+	if check.IfNil(args.StatusHandler) {
+		return nil, ErrNilStatusHandler
 	}
+
+	return &signaturesHolder{
+		signaturesByHash:     make(map[string]*hashSignatures),
+		usageOrder:           list.New(),
+		maxMessageHashes:     args.MaxMessageHashes,
+		maxSignaturesPerHash: args.MaxSignaturesPerHash,
+		statusHandler:        args.StatusHandler,
+	}, nil
 }
 
-// ProcessNewMessage will store the new messages
+// ProcessNewMessage will store the new messages. Signatures that can not be attributed to a signer, signatures
+// from a signer that already provided one for the same message hash and signatures exceeding the maximum number of
+// signatures per hash are dropped. Storing a new message hash over the maximum number of tracked hashes evicts the
+// least recently used one
 func (sh *signaturesHolder) ProcessNewMessage(msg *core.SignedMessage, ethMsg *core.EthereumSignature) {
 	if msg == nil || ethMsg == nil {
 		return
 	}
 
+	publicKey, err := crypto.SigToPub(ethMsg.MessageHash, ethMsg.Signature)
+	if err != nil {
+		return
+	}
+	signer := crypto.PubkeyToAddress(*publicKey)
+
 	sh.mut.Lock()
 	defer sh.mut.Unlock()
 
-	sh.signedMessages[msg.UniqueID()] = msg
-	sh.ethMessages = append(sh.ethMessages, ethMsg)
+	holder, found := sh.signaturesByHash[string(ethMsg.MessageHash)]
+	if !found {
+		holder = &hashSignatures{
+			signedMessages: make(map[string]*core.SignedMessage),
+			signatures:     make(map[common.Address][]byte),
+			element:        sh.usageOrder.PushFront(string(ethMsg.MessageHash)),
+		}
+		sh.signaturesByHash[string(ethMsg.MessageHash)] = holder
+		sh.evictOverCapacity()
+	} else {
+		sh.usageOrder.MoveToFront(holder.element)
+	}
+
+	_, exists := holder.signatures[signer]
+	if exists {
+		return
+	}
+	if len(holder.signatures) >= sh.maxSignaturesPerHash {
+		sh.statusHandler.AddIntMetric(core.MetricNumDroppedSignatures, 1)
+		return
+	}
+
+	holder.signatures[signer] = ethMsg.Signature
+	holder.signedMessages[msg.UniqueID()] = msg
+}
+
+func (sh *signaturesHolder) evictOverCapacity() {
+	for len(sh.signaturesByHash) > sh.maxMessageHashes {
+		oldest := sh.usageOrder.Back()
+		sh.usageOrder.Remove(oldest)
+		delete(sh.signaturesByHash, oldest.Value.(string))
+		sh.statusHandler.AddIntMetric(core.MetricNumEvictedSignatureHashes, 1)
+	}
 }
 
 // AllStoredSignatures will return the stored signatures
 func (sh *signaturesHolder) AllStoredSignatures() []*core.SignedMessage {
-	sh.mut.RLock()
-	defer sh.mut.RUnlock()
+	sh.mut.Lock()
+	defer sh.mut.Unlock()
 
-	result := make([]*core.SignedMessage, 0, len(sh.signedMessages))
-	for _, msg := range sh.signedMessages {
-		result = append(result, msg)
+	result := make([]*core.SignedMessage, 0)
+	for _, holder := range sh.signaturesByHash {
+		for _, msg := range holder.signedMessages {
+			result = append(result, msg)
+		}
 	}
 
 	return result
 }
 
-// Signatures will provide all gathered signatures for a given message hash
+// Signatures will provide all gathered signatures for a given message hash, one for each signer
 func (sh *signaturesHolder) Signatures(msgHash []byte) [][]byte {
-	sh.mut.RLock()
-	defer sh.mut.RUnlock()
+	sh.mut.Lock()
+	defer sh.mut.Unlock()
 
-	uniqueEthSigs := make(map[string]struct{})
-	for _, ethMsg := range sh.ethMessages {
-		if bytes.Equal(ethMsg.MessageHash, msgHash) {
-			uniqueEthSigs[string(ethMsg.Signature)] = struct{}{}
-		}
+	holder, found := sh.signaturesByHash[string(msgHash)]
+	if !found {
+		return make([][]byte, 0)
 	}
+	sh.usageOrder.MoveToFront(holder.element)
 
-	result := make([][]byte, 0, len(sh.signedMessages))
-	for sig := range uniqueEthSigs {
-		result = append(result, []byte(sig))
+	result := make([][]byte, 0, len(holder.signatures))
+	for _, sig := range holder.signatures {
+		result = append(result, sig)
 	}
 
 	return result
 }
 
+// ClearSignaturesForHash will clear the stored signatures for the provided message hash
+func (sh *signaturesHolder) ClearSignaturesForHash(msgHash []byte) {
+	sh.mut.Lock()
+	defer sh.mut.Unlock()
+
+	holder, found := sh.signaturesByHash[string(msgHash)]
+	if !found {
+		return
+	}
+
+	sh.usageOrder.Remove(holder.element)
+	delete(sh.signaturesByHash, string(msgHash))
+}
+
 // ClearStoredSignatures will clear any stored signatures
 func (sh *signaturesHolder) ClearStoredSignatures() {
 	sh.mut.Lock()
 	defer sh.mut.Unlock()
 
-	sh.signedMessages = make(map[string]*core.SignedMessage)
-	sh.ethMessages = make([]*core.EthereumSignature, 0)
+	sh.signaturesByHash = make(map[string]*hashSignatures)
+	sh.usageOrder.Init()
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
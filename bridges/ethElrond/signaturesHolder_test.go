@@ -2,15 +2,25 @@ package ethElrond
 
 import (
 	"bytes"
+	"crypto/ecdsa"
+	"errors"
 	"fmt"
+	"math/big"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+var testMessageHash = crypto.Keccak256([]byte("message hash"))
+
 func generateSignedMessage(index uint64) *core.SignedMessage {
 	return &core.SignedMessage{
 		Payload:        []byte(fmt.Sprintf("payload %d", index)),
@@ -20,13 +30,75 @@ func generateSignedMessage(index uint64) *core.SignedMessage {
 	}
 }
 
-func generateEthMessage(index uint64) *core.EthereumSignature {
+func generateEthMessage(tb testing.TB, messageHash []byte) (*core.EthereumSignature, *ecdsa.PrivateKey) {
+	sk, err := crypto.GenerateKey()
+	require.Nil(tb, err)
+
+	return generateEthMessageWithKey(tb, messageHash, sk), sk
+}
+
+func generateEthMessageWithKey(tb testing.TB, messageHash []byte, sk *ecdsa.PrivateKey) *core.EthereumSignature {
+	signature, err := crypto.Sign(messageHash, sk)
+	require.Nil(tb, err)
+
 	return &core.EthereumSignature{
-		Signature:   []byte(fmt.Sprintf("sig %d", index)),
-		MessageHash: []byte("message hash"),
+		Signature:   signature,
+		MessageHash: messageHash,
+	}
+}
+
+func createMockArgsSignaturesHolder() ArgsSignaturesHolder {
+	return ArgsSignaturesHolder{
+		MaxMessageHashes:     100,
+		MaxSignaturesPerHash: 10,
+		StatusHandler:        testsCommon.NewStatusHandlerMock("test"),
 	}
 }
 
+func TestNewSignatureHolder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid MaxMessageHashes should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignaturesHolder()
+		args.MaxMessageHashes = 0
+
+		sh, err := NewSignatureHolder(args)
+		assert.True(t, check.IfNil(sh))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "args.MaxMessageHashes"))
+	})
+	t.Run("invalid MaxSignaturesPerHash should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignaturesHolder()
+		args.MaxSignaturesPerHash = 0
+
+		sh, err := NewSignatureHolder(args)
+		assert.True(t, check.IfNil(sh))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "args.MaxSignaturesPerHash"))
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignaturesHolder()
+		args.StatusHandler = nil
+
+		sh, err := NewSignatureHolder(args)
+		assert.True(t, check.IfNil(sh))
+		assert.Equal(t, ErrNilStatusHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sh, err := NewSignatureHolder(createMockArgsSignaturesHolder())
+		assert.False(t, check.IfNil(sh))
+		assert.Nil(t, err)
+	})
+}
+
 func TestSignatureHolder_ProcessNewMessage(t *testing.T) {
 	t.Parallel()
 
@@ -34,43 +106,69 @@ func TestSignatureHolder_ProcessNewMessage(t *testing.T) {
 		t.Parallel()
 
 		msg := generateSignedMessage(0)
-		ethMsg := generateEthMessage(0)
+		ethMsg, _ := generateEthMessage(t, testMessageHash)
 
-		sh := NewSignatureHolder()
+		sh, _ := NewSignatureHolder(createMockArgsSignaturesHolder())
 		sh.ProcessNewMessage(nil, ethMsg)
-		assert.Equal(t, 0, len(sh.signedMessages))
-		assert.Equal(t, 0, len(sh.ethMessages))
+		assert.Equal(t, 0, len(sh.signaturesByHash))
 
 		sh.ProcessNewMessage(msg, nil)
-		assert.Equal(t, 0, len(sh.signedMessages))
-		assert.Equal(t, 0, len(sh.ethMessages))
+		assert.Equal(t, 0, len(sh.signaturesByHash))
+	})
+	t.Run("unrecoverable signature should not add", func(t *testing.T) {
+		t.Parallel()
+
+		msg := generateSignedMessage(0)
+		ethMsg := &core.EthereumSignature{
+			Signature:   []byte("sig 0"),
+			MessageHash: testMessageHash,
+		}
+
+		sh, _ := NewSignatureHolder(createMockArgsSignaturesHolder())
+		sh.ProcessNewMessage(msg, ethMsg)
+		assert.Equal(t, 0, len(sh.signaturesByHash))
+		assert.Empty(t, sh.AllStoredSignatures())
 	})
 	t.Run("first message should add", func(t *testing.T) {
 		t.Parallel()
 
 		msg := generateSignedMessage(0)
-		ethMsg := generateEthMessage(0)
+		ethMsg, _ := generateEthMessage(t, testMessageHash)
 
-		sh := NewSignatureHolder()
+		sh, _ := NewSignatureHolder(createMockArgsSignaturesHolder())
 		sh.ProcessNewMessage(msg, ethMsg)
 		assert.Equal(t, []*core.SignedMessage{msg}, sh.AllStoredSignatures())
-		assert.Equal(t, []*core.EthereumSignature{ethMsg}, sh.ethMessages)
+		assert.Equal(t, [][]byte{ethMsg.Signature}, sh.Signatures(testMessageHash))
 	})
 	t.Run("two messages should add", func(t *testing.T) {
 		t.Parallel()
 
 		msg := generateSignedMessage(0)
-		ethMsg := generateEthMessage(0)
+		ethMsg, _ := generateEthMessage(t, testMessageHash)
 
 		msg1 := generateSignedMessage(1)
-		ethMsg1 := generateEthMessage(1)
+		ethMsg1, _ := generateEthMessage(t, testMessageHash)
 
-		sh := NewSignatureHolder()
+		sh, _ := NewSignatureHolder(createMockArgsSignaturesHolder())
 		sh.ProcessNewMessage(msg, ethMsg)
 		sh.ProcessNewMessage(msg1, ethMsg1)
-		compareEthSignatureMessageLists(t, []*core.EthereumSignature{ethMsg, ethMsg1}, sh.ethMessages)
+		compareBytesSlicesLists(t, [][]byte{ethMsg.Signature, ethMsg1.Signature}, sh.Signatures(testMessageHash))
 		compareSignedMessageLists(t, []*core.SignedMessage{msg, msg1}, sh.AllStoredSignatures())
 	})
+	t.Run("re-broadcast signature from the same signer should not add", func(t *testing.T) {
+		t.Parallel()
+
+		msg := generateSignedMessage(0)
+		ethMsg, _ := generateEthMessage(t, testMessageHash)
+
+		msg1 := generateSignedMessage(1)
+
+		sh, _ := NewSignatureHolder(createMockArgsSignaturesHolder())
+		sh.ProcessNewMessage(msg, ethMsg)
+		sh.ProcessNewMessage(msg1, ethMsg)
+		assert.Equal(t, [][]byte{ethMsg.Signature}, sh.Signatures(testMessageHash))
+		assert.Equal(t, []*core.SignedMessage{msg}, sh.AllStoredSignatures())
+	})
 }
 
 func TestSignatureHolder_Signatures(t *testing.T) {
@@ -80,12 +178,12 @@ func TestSignatureHolder_Signatures(t *testing.T) {
 		t.Parallel()
 
 		msg := generateSignedMessage(0)
-		ethMsg := generateEthMessage(0)
+		ethMsg, _ := generateEthMessage(t, testMessageHash)
 
 		msg1 := generateSignedMessage(1)
-		ethMsg1 := generateEthMessage(1)
+		ethMsg1, _ := generateEthMessage(t, testMessageHash)
 
-		sh := NewSignatureHolder()
+		sh, _ := NewSignatureHolder(createMockArgsSignaturesHolder())
 		sh.ProcessNewMessage(msg, ethMsg)
 		sh.ProcessNewMessage(msg1, ethMsg1)
 
@@ -93,74 +191,75 @@ func TestSignatureHolder_Signatures(t *testing.T) {
 
 		sh.ClearStoredSignatures()
 
-		assert.Equal(t, 0, len(sh.Signatures([]byte("eth msg"))))
+		assert.Equal(t, 0, len(sh.Signatures(testMessageHash)))
+		assert.Empty(t, sh.AllStoredSignatures())
 	})
-	t.Run("same signatures should return the unique ones", func(t *testing.T) {
+	t.Run("different signatures from the same signer should return one signature", func(t *testing.T) {
 		t.Parallel()
 
 		msg := generateSignedMessage(0)
-		ethMsg := generateEthMessage(0)
+		ethMsg, sk := generateEthMessage(t, testMessageHash)
 
 		msg1 := generateSignedMessage(1)
-		ethMsg1 := generateEthMessage(1)
-
-		msg2 := generateSignedMessage(2)
-		ethMsg2 := generateEthMessage(2)
-		ethMsg2.Signature = ethMsg1.Signature
-
-		sh := NewSignatureHolder()
+		ethMsg1 := generateEthMessageWithKey(t, testMessageHash, sk)
+		// the (r, N-s) signature with the flipped recovery byte is also valid for the same signer
+		sValue := big.NewInt(0).SetBytes(ethMsg1.Signature[32:64])
+		sValue.Sub(crypto.S256().Params().N, sValue)
+		sValue.FillBytes(ethMsg1.Signature[32:64])
+		ethMsg1.Signature[64] ^= 1
+		require.NotEqual(t, ethMsg.Signature, ethMsg1.Signature)
+
+		sh, _ := NewSignatureHolder(createMockArgsSignaturesHolder())
 		sh.ProcessNewMessage(msg, ethMsg)
 		sh.ProcessNewMessage(msg1, ethMsg1)
-		sh.ProcessNewMessage(msg2, ethMsg2)
 
-		compareBytesSlicesLists(t, [][]byte{ethMsg.Signature, ethMsg1.Signature}, sh.Signatures(ethMsg.MessageHash))
+		assert.Equal(t, 1, len(sh.Signatures(testMessageHash)))
 	})
-	t.Run("same signatures should return filter by message", func(t *testing.T) {
+	t.Run("signatures should be filtered by message hash", func(t *testing.T) {
 		t.Parallel()
 
+		otherMessageHash := crypto.Keccak256([]byte("eth msg 1"))
 		msg := generateSignedMessage(0)
-		ethMsg := generateEthMessage(0)
-		ethMsg.MessageHash = []byte("eth msg 1")
+		ethMsg, _ := generateEthMessage(t, otherMessageHash)
 
 		msg1 := generateSignedMessage(1)
-		ethMsg1 := generateEthMessage(1)
+		ethMsg1, _ := generateEthMessage(t, testMessageHash)
 
 		msg2 := generateSignedMessage(2)
-		ethMsg2 := generateEthMessage(2)
+		ethMsg2, _ := generateEthMessage(t, testMessageHash)
 
-		sh := NewSignatureHolder()
+		sh, _ := NewSignatureHolder(createMockArgsSignaturesHolder())
 		sh.ProcessNewMessage(msg, ethMsg)
 		sh.ProcessNewMessage(msg1, ethMsg1)
 		sh.ProcessNewMessage(msg2, ethMsg2)
 
-		compareBytesSlicesLists(t, [][]byte{ethMsg1.Signature, ethMsg2.Signature}, sh.Signatures(ethMsg1.MessageHash))
+		compareBytesSlicesLists(t, [][]byte{ethMsg1.Signature, ethMsg2.Signature}, sh.Signatures(testMessageHash))
+		compareBytesSlicesLists(t, [][]byte{ethMsg.Signature}, sh.Signatures(otherMessageHash))
 	})
 }
 
-func compareSignedMessageLists(t *testing.T, list1 []*core.SignedMessage, list2 []*core.SignedMessage) {
-	require.Equal(t, len(list1), len(list2))
-	for _, obj1 := range list1 {
-		found := false
-		for _, obj2 := range list2 {
-			if reflect.DeepEqual(obj1, obj2) {
-				found = true
-			}
-		}
-		require.True(t, found)
-	}
+func TestSignatureHolder_ClearSignaturesForHash(t *testing.T) {
+	t.Parallel()
 
-	for _, obj2 := range list2 {
-		found := false
-		for _, obj1 := range list1 {
-			if reflect.DeepEqual(obj1, obj2) {
-				found = true
-			}
-		}
-		require.True(t, found)
-	}
+	otherMessageHash := crypto.Keccak256([]byte("eth msg 1"))
+	msg := generateSignedMessage(0)
+	ethMsg, _ := generateEthMessage(t, otherMessageHash)
+
+	msg1 := generateSignedMessage(1)
+	ethMsg1, _ := generateEthMessage(t, testMessageHash)
+
+	sh, _ := NewSignatureHolder(createMockArgsSignaturesHolder())
+	sh.ProcessNewMessage(msg, ethMsg)
+	sh.ProcessNewMessage(msg1, ethMsg1)
+
+	sh.ClearSignaturesForHash(testMessageHash)
+
+	assert.Empty(t, sh.Signatures(testMessageHash))
+	assert.Equal(t, [][]byte{ethMsg.Signature}, sh.Signatures(otherMessageHash))
+	assert.Equal(t, []*core.SignedMessage{msg}, sh.AllStoredSignatures())
 }
 
-func compareEthSignatureMessageLists(t *testing.T, list1 []*core.EthereumSignature, list2 []*core.EthereumSignature) {
+func compareSignedMessageLists(t *testing.T, list1 []*core.SignedMessage, list2 []*core.SignedMessage) {
 	require.Equal(t, len(list1), len(list2))
 	for _, obj1 := range list1 {
 		found := false
@@ -205,3 +304,100 @@ func compareBytesSlicesLists(t *testing.T, list1 [][]byte, list2 [][]byte) {
 		require.True(t, found)
 	}
 }
+
+func TestSignatureHolder_Caps(t *testing.T) {
+	t.Parallel()
+
+	messageHash := func(index int) []byte {
+		return crypto.Keccak256([]byte(fmt.Sprintf("message hash %d", index)))
+	}
+	storeSignature := func(sh *signaturesHolder, index uint64, msgHash []byte) {
+		ethMsg, _ := generateEthMessage(t, msgHash)
+		sh.ProcessNewMessage(generateSignedMessage(index), ethMsg)
+	}
+
+	t.Run("too many message hashes should evict the oldest ones", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignaturesHolder()
+		args.MaxMessageHashes = 3
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		sh, _ := NewSignatureHolder(args)
+
+		for i := 0; i < 5; i++ {
+			storeSignature(sh, uint64(i), messageHash(i))
+		}
+
+		assert.Equal(t, 3, len(sh.signaturesByHash))
+		assert.Empty(t, sh.Signatures(messageHash(0)))
+		assert.Empty(t, sh.Signatures(messageHash(1)))
+		for i := 2; i < 5; i++ {
+			assert.Equal(t, 1, len(sh.Signatures(messageHash(i))))
+		}
+		assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricNumEvictedSignatureHashes))
+	})
+	t.Run("recently used message hashes should not be evicted", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignaturesHolder()
+		args.MaxMessageHashes = 3
+		sh, _ := NewSignatureHolder(args)
+
+		storeSignature(sh, 0, messageHash(0))
+		storeSignature(sh, 1, messageHash(1))
+		storeSignature(sh, 2, messageHash(2))
+
+		// hash 0 is fetched and hash 1 receives a new signature, hash 2 becomes the least recently used
+		assert.Equal(t, 1, len(sh.Signatures(messageHash(0))))
+		storeSignature(sh, 3, messageHash(1))
+
+		storeSignature(sh, 4, messageHash(3))
+		assert.Empty(t, sh.Signatures(messageHash(2)))
+		assert.Equal(t, 1, len(sh.Signatures(messageHash(0))))
+		assert.Equal(t, 2, len(sh.Signatures(messageHash(1))))
+		assert.Equal(t, 1, len(sh.Signatures(messageHash(3))))
+
+		storeSignature(sh, 5, messageHash(4))
+		assert.Empty(t, sh.Signatures(messageHash(0)))
+	})
+	t.Run("too many signatures for a hash should drop the new ones", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignaturesHolder()
+		args.MaxSignaturesPerHash = 2
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		sh, _ := NewSignatureHolder(args)
+
+		for i := 0; i < 4; i++ {
+			storeSignature(sh, uint64(i), testMessageHash)
+		}
+
+		assert.Equal(t, 2, len(sh.Signatures(testMessageHash)))
+		assert.Equal(t, 2, len(sh.AllStoredSignatures()))
+		assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricNumDroppedSignatures))
+	})
+	t.Run("cleared message hashes should not count for the eviction", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignaturesHolder()
+		args.MaxMessageHashes = 2
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		sh, _ := NewSignatureHolder(args)
+
+		storeSignature(sh, 0, messageHash(0))
+		storeSignature(sh, 1, messageHash(1))
+		sh.ClearSignaturesForHash(messageHash(0))
+		storeSignature(sh, 2, messageHash(2))
+		assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricNumEvictedSignatureHashes))
+		assert.Equal(t, 2, sh.usageOrder.Len())
+
+		sh.ClearStoredSignatures()
+		assert.Equal(t, 0, sh.usageOrder.Len())
+		storeSignature(sh, 3, messageHash(3))
+		storeSignature(sh, 4, messageHash(4))
+		assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricNumEvictedSignatureHashes))
+	})
+}
@@ -4,6 +4,9 @@ const (
 	// GettingPendingBatchFromElrond is the step identifier for fetching the pending batch from the Elrond chain
 	GettingPendingBatchFromElrond = "get pending batch from Elrond"
 
+	// ValidatingBatch is the step identifier for validating the pending batch using the batch validator
+	ValidatingBatch = "validate batch"
+
 	// SigningProposedTransferOnEthereum is the step identifier for signing proposed transfer
 	SigningProposedTransferOnEthereum = "sign proposed transfer"
 
@@ -32,5 +35,5 @@ const (
 	PerformingSetStatus = "perform set status"
 
 	// NumSteps indicates how many steps the state machine for Elrond -> Ethereum flow has
-	NumSteps = 10
+	NumSteps = 11
 )
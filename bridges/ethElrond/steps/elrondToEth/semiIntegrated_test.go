@@ -69,7 +69,7 @@ func (eh *errorHandler) storeAndReturnError(err error) error {
 }
 
 func createStateMachine(t *testing.T, executor steps.Executor, initialStep core.StepIdentifier) *stateMachine.StateMachineMock {
-	stepsSlice, err := CreateSteps(executor)
+	stepsSlice, err := CreateSteps(executor, false)
 	require.Nil(t, err)
 
 	sm := stateMachine.NewStateMachineMock(stepsSlice, initialStep)
@@ -115,7 +115,7 @@ func createMockBridge(args argsBridgeStub) (*bridgeTests.BridgeExecutorStub, *er
 
 		return args.wasTransferPerformedOnEthereumHandler(), errHandler.storeAndReturnError(nil)
 	}
-	stub.SignTransferOnEthereumCalled = func() error {
+	stub.SignTransferOnEthereumCalled = func(ctx context.Context) error {
 		if args.failingStep == signTransferOnEthereum {
 			return errHandler.storeAndReturnError(expectedErr)
 		}
@@ -135,10 +135,11 @@ func createMockBridge(args argsBridgeStub) (*bridgeTests.BridgeExecutorStub, *er
 		}
 		return errHandler.storeAndReturnError(nil)
 	}
-	stub.WaitForTransferConfirmationCalled = func(ctx context.Context) {
+	stub.WaitForTransferConfirmationCalled = func(ctx context.Context) error {
 		stub.WasTransferPerformedOnEthereumCalled = func(ctx context.Context) (bool, error) {
 			return true, errHandler.storeAndReturnError(nil)
 		}
+		return nil
 	}
 	stub.WaitAndReturnFinalBatchStatusesCalled = func(ctx context.Context) []byte {
 		if args.failingStep == getBatchStatusesFromEthereum {
@@ -336,3 +337,55 @@ func testErrorFlow(t *testing.T, stepThatErrors core.StepIdentifier) {
 
 	require.Fail(t, fmt.Sprintf("max number of steps reached but not jumped to initial step, stepThatErrors %s", stepThatErrors))
 }
+
+func TestBatchValidationEnabled(t *testing.T) {
+	t.Parallel()
+
+	args := argsBridgeStub{
+		myTurnHandler:                         trueHandler,
+		processQuorumReachedOnEthereumHandler: falseHandler,
+		wasTransferPerformedOnEthereumHandler: falseHandler,
+		maxRetriesReachedEthereumHandler:      falseHandler,
+		maxRetriesReachedElrondHandler:        falseHandler,
+	}
+	createValidatingStateMachine := func(executor steps.Executor) *stateMachine.StateMachineMock {
+		stepsSlice, err := CreateSteps(executor, true)
+		require.Nil(t, err)
+
+		sm := stateMachine.NewStateMachineMock(stepsSlice, GettingPendingBatchFromElrond)
+		err = sm.Initialize()
+		require.Nil(t, err)
+
+		return sm
+	}
+
+	t.Run("valid batch should be signed", func(t *testing.T) {
+		t.Parallel()
+
+		executor, _ := createMockBridge(args)
+		sm := createValidatingStateMachine(executor)
+		for i := 0; i < 3; i++ {
+			err := sm.Execute(context.Background())
+			require.Nil(t, err)
+		}
+
+		assert.Equal(t, 1, executor.GetFunctionCounter(signTransferOnEthereum))
+		assert.Equal(t, core.StepIdentifier(WaitingForQuorumOnTransfer), sm.CurrentStep.Identifier())
+	})
+	t.Run("invalid batch should return to the pending batch step without signing", func(t *testing.T) {
+		t.Parallel()
+
+		executor, _ := createMockBridge(args)
+		executor.ValidateBatchCalled = func(ctx context.Context, batch *clients.TransferBatch) (bool, error) {
+			return false, nil
+		}
+		sm := createValidatingStateMachine(executor)
+		for i := 0; i < 2; i++ {
+			err := sm.Execute(context.Background())
+			require.Nil(t, err)
+		}
+
+		assert.Equal(t, 0, executor.GetFunctionCounter(signTransferOnEthereum))
+		assert.Equal(t, core.StepIdentifier(GettingPendingBatchFromElrond), sm.CurrentStep.Identifier())
+	})
+}
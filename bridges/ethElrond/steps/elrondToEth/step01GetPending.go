@@ -2,7 +2,6 @@ package elrondToEth
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond/steps"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
@@ -10,7 +9,8 @@ import (
 )
 
 type getPendingStep struct {
-	bridge steps.Executor
+	bridge                 steps.Executor
+	batchValidationEnabled bool
 }
 
 // Execute will execute this step returning the next step to be executed
@@ -42,19 +42,8 @@ func (step *getPendingStep) Execute(ctx context.Context) core.StepIdentifier {
 		return step.Identifier()
 	}
 
-	isValid, err := step.bridge.ValidateBatch(ctx, batch)
-	if err != nil {
-		body, _ := json.Marshal(batch)
-		step.bridge.PrintInfo(logger.LogError, "error validating Elrond batch", "error", err, "batch", string(body))
-		return step.Identifier()
-	}
-
-	if !isValid {
-		step.bridge.PrintInfo(logger.LogError, "batch not valid "+batch.String())
-		return step.Identifier()
-	}
-
-	step.bridge.PrintInfo(logger.LogInfo, "fetched new batch from Elrond "+batch.String())
+	step.bridge.PrintInfo(logger.LogDebug, "fetched new batch from Elrond "+batch.String())
+	step.bridge.PrintInfo(logger.LogInfo, "fetched new batch from Elrond "+batch.Summary())
 
 	wasPerformed, err := step.bridge.WasTransferPerformedOnEthereum(ctx)
 	if err != nil {
@@ -65,6 +54,9 @@ func (step *getPendingStep) Execute(ctx context.Context) core.StepIdentifier {
 		step.bridge.PrintInfo(logger.LogInfo, "transfer performed")
 		return ResolvingSetStatusOnElrond
 	}
+	if step.batchValidationEnabled {
+		return ValidatingBatch
+	}
 
 	return SigningProposedTransferOnEthereum
 }
@@ -69,38 +69,6 @@ func TestExecute_GetPending(t *testing.T) {
 		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
 	})
 
-	t.Run("error on ValidateBatch", func(t *testing.T) {
-		t.Parallel()
-		bridgeStub := createStubExecutorGetPending()
-		bridgeStub.ValidateBatchCalled = func(ctx context.Context, batch *clients.TransferBatch) (bool, error) {
-			return false, expectedError
-		}
-
-		step := getPendingStep{
-			bridge: bridgeStub,
-		}
-
-		expectedStepIdentifier := step.Identifier()
-		stepIdentifier := step.Execute(context.Background())
-		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
-	})
-
-	t.Run("batch not validated on ValidateBatch", func(t *testing.T) {
-		t.Parallel()
-		bridgeStub := createStubExecutorGetPending()
-		bridgeStub.ValidateBatchCalled = func(ctx context.Context, batch *clients.TransferBatch) (bool, error) {
-			return false, nil
-		}
-
-		step := getPendingStep{
-			bridge: bridgeStub,
-		}
-
-		expectedStepIdentifier := step.Identifier()
-		stepIdentifier := step.Execute(context.Background())
-		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
-	})
-
 	t.Run("error on WasTransferPerformedOnEthereum", func(t *testing.T) {
 		t.Parallel()
 		bridgeStub := createStubExecutorGetPending()
@@ -151,6 +119,27 @@ func TestExecute_GetPending(t *testing.T) {
 			stepIdentifier := step.Execute(context.Background())
 			assert.Equal(t, expectedStepIdentifier, stepIdentifier)
 		})
+		t.Run("if transfer was not performed and batch validation is enabled next step should be ValidatingBatch", func(t *testing.T) {
+			t.Parallel()
+			bridgeStub := createStubExecutorGetPending()
+			bridgeStub.WasTransferPerformedOnEthereumCalled = func(ctx context.Context) (bool, error) {
+				return false, nil
+			}
+
+			bridgeStub.ValidateBatchCalled = func(ctx context.Context, batch *clients.TransferBatch) (bool, error) {
+				assert.Fail(t, "should have not validated the batch")
+				return false, nil
+			}
+
+			step := getPendingStep{
+				bridge:                 bridgeStub,
+				batchValidationEnabled: true,
+			}
+
+			expectedStepIdentifier := core.StepIdentifier(ValidatingBatch)
+			stepIdentifier := step.Execute(context.Background())
+			assert.Equal(t, expectedStepIdentifier, stepIdentifier)
+		})
 	})
 }
 
@@ -162,8 +151,5 @@ func createStubExecutorGetPending() *bridgeTests.BridgeExecutorStub {
 	stub.StoreBatchFromElrondCalled = func(batch *clients.TransferBatch) error {
 		return nil
 	}
-	stub.ValidateBatchCalled = func(ctx context.Context, batch *clients.TransferBatch) (bool, error) {
-		return true, nil
-	}
 	return stub
 }
@@ -0,0 +1,47 @@
+package elrondToEth
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond/steps"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+type validateBatchStep struct {
+	bridge steps.Executor
+}
+
+// Execute will execute this step returning the next step to be executed
+func (step *validateBatchStep) Execute(ctx context.Context) core.StepIdentifier {
+	storedBatch := step.bridge.GetStoredBatch()
+	if storedBatch == nil {
+		step.bridge.PrintInfo(logger.LogDebug, "nil batch stored")
+		return GettingPendingBatchFromElrond
+	}
+
+	isValid, err := step.bridge.ValidateBatch(ctx, storedBatch)
+	if err != nil {
+		body, _ := json.Marshal(storedBatch)
+		step.bridge.PrintInfo(logger.LogError, "error validating Elrond batch", "error", err, "batch", string(body))
+		return GettingPendingBatchFromElrond
+	}
+	if !isValid {
+		step.bridge.PrintInfo(logger.LogDebug, "batch not valid, the batch validator rejected it "+storedBatch.String())
+		step.bridge.PrintInfo(logger.LogError, "batch not valid, the batch validator rejected it "+storedBatch.Summary())
+		return GettingPendingBatchFromElrond
+	}
+
+	return SigningProposedTransferOnEthereum
+}
+
+// Identifier returns the step's identifier
+func (step *validateBatchStep) Identifier() core.StepIdentifier {
+	return ValidatingBatch
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (step *validateBatchStep) IsInterfaceNil() bool {
+	return step == nil
+}
@@ -0,0 +1,93 @@
+package elrondToEth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecute_ValidateBatch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil batch on GetStoredBatch", func(t *testing.T) {
+		t.Parallel()
+		bridgeStub := createStubExecutorValidateBatch()
+		bridgeStub.GetStoredBatchCalled = func() *clients.TransferBatch {
+			return nil
+		}
+
+		step := validateBatchStep{
+			bridge: bridgeStub,
+		}
+
+		stepIdentifier := step.Execute(context.Background())
+		assert.Equal(t, initialStep, stepIdentifier)
+	})
+
+	t.Run("error on ValidateBatch", func(t *testing.T) {
+		t.Parallel()
+		bridgeStub := createStubExecutorValidateBatch()
+		bridgeStub.ValidateBatchCalled = func(ctx context.Context, batch *clients.TransferBatch) (bool, error) {
+			return false, expectedError
+		}
+
+		step := validateBatchStep{
+			bridge: bridgeStub,
+		}
+
+		stepIdentifier := step.Execute(context.Background())
+		assert.Equal(t, initialStep, stepIdentifier)
+	})
+
+	t.Run("batch not valid", func(t *testing.T) {
+		t.Parallel()
+		bridgeStub := createStubExecutorValidateBatch()
+		bridgeStub.ValidateBatchCalled = func(ctx context.Context, batch *clients.TransferBatch) (bool, error) {
+			return false, nil
+		}
+
+		step := validateBatchStep{
+			bridge: bridgeStub,
+		}
+
+		stepIdentifier := step.Execute(context.Background())
+		assert.Equal(t, initialStep, stepIdentifier)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+		bridgeStub := createStubExecutorValidateBatch()
+		wasValidated := false
+		bridgeStub.ValidateBatchCalled = func(ctx context.Context, batch *clients.TransferBatch) (bool, error) {
+			wasValidated = true
+			assert.Equal(t, testBatch, batch)
+			return true, nil
+		}
+
+		step := validateBatchStep{
+			bridge: bridgeStub,
+		}
+
+		assert.False(t, step.IsInterfaceNil())
+		assert.Equal(t, core.StepIdentifier(ValidatingBatch), step.Identifier())
+
+		stepIdentifier := step.Execute(context.Background())
+		assert.Equal(t, core.StepIdentifier(SigningProposedTransferOnEthereum), stepIdentifier)
+		assert.True(t, wasValidated)
+	})
+}
+
+func createStubExecutorValidateBatch() *bridgeTests.BridgeExecutorStub {
+	stub := bridgeTests.NewBridgeExecutorStub()
+	stub.GetStoredBatchCalled = func() *clients.TransferBatch {
+		return testBatch
+	}
+	stub.ValidateBatchCalled = func(ctx context.Context, batch *clients.TransferBatch) (bool, error) {
+		return true, nil
+	}
+	return stub
+}
@@ -13,14 +13,14 @@ type signProposedTransferStep struct {
 }
 
 // Execute will execute this step returning the next step to be executed
-func (step *signProposedTransferStep) Execute(_ context.Context) core.StepIdentifier {
+func (step *signProposedTransferStep) Execute(ctx context.Context) core.StepIdentifier {
 	storedBatch := step.bridge.GetStoredBatch()
 	if storedBatch == nil {
 		step.bridge.PrintInfo(logger.LogDebug, "nil batch stored")
 		return GettingPendingBatchFromElrond
 	}
 
-	err := step.bridge.SignTransferOnEthereum()
+	err := step.bridge.SignTransferOnEthereum(ctx)
 	if err != nil {
 		step.bridge.PrintInfo(logger.LogError, "error signing", "batch ID", storedBatch.ID, "error", err)
 		return GettingPendingBatchFromElrond
@@ -33,7 +33,7 @@ func TestExecute_SignProposedTransfer(t *testing.T) {
 	t.Run("nil batch on SignTransferOnEthereum", func(t *testing.T) {
 		t.Parallel()
 		bridgeStub := createStubExecutorSignProposedTransfer()
-		bridgeStub.SignTransferOnEthereumCalled = func() error {
+		bridgeStub.SignTransferOnEthereumCalled = func(ctx context.Context) error {
 			return expectedError
 		}
 
@@ -66,7 +66,7 @@ func createStubExecutorSignProposedTransfer() *bridgeTests.BridgeExecutorStub {
 	stub.GetStoredBatchCalled = func() *clients.TransferBatch {
 		return testBatch
 	}
-	stub.SignTransferOnEthereumCalled = func() error {
+	stub.SignTransferOnEthereumCalled = func(ctx context.Context) error {
 		return nil
 	}
 	return stub
@@ -2,9 +2,13 @@ package elrondToEth
 
 import (
 	"context"
+	"errors"
 
+	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond"
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond/steps"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
 )
 
 type waitTransferConfirmationStep struct {
@@ -13,10 +17,36 @@ type waitTransferConfirmationStep struct {
 
 // Execute will execute this step returning the next step to be executed
 func (step *waitTransferConfirmationStep) Execute(ctx context.Context) core.StepIdentifier {
-	step.bridge.WaitForTransferConfirmation(ctx)
+	err := step.bridge.WaitForTransferConfirmation(ctx)
+	if errors.Is(err, ethElrond.ErrTransferReverted) {
+		step.bridge.PrintInfo(logger.LogError, "transfer reverted on Ethereum, rejecting the batch deposits", "error", err)
+		return step.rejectStoredBatch()
+	}
+	if err != nil {
+		step.bridge.PrintInfo(logger.LogError, "error waiting for the transfer confirmation on Ethereum", "error", err)
+		return GettingPendingBatchFromElrond
+	}
+
 	return PerformingTransfer
 }
 
+// rejectStoredBatch marks all the deposits of the stored batch as rejected so the set status proposal will refund them
+func (step *waitTransferConfirmationStep) rejectStoredBatch() core.StepIdentifier {
+	storedBatch := step.bridge.GetStoredBatch()
+	if storedBatch == nil {
+		step.bridge.PrintInfo(logger.LogDebug, "nil batch stored")
+		return GettingPendingBatchFromElrond
+	}
+
+	step.bridge.ClearStoredP2PSignaturesForEthereum()
+	storedBatch.Statuses = make([]byte, len(storedBatch.Deposits))
+	for i := range storedBatch.Statuses {
+		storedBatch.Statuses[i] = clients.Rejected
+	}
+
+	return ProposingSetStatusOnElrond
+}
+
 // Identifier returns the step's identifier
 func (step *waitTransferConfirmationStep) Identifier() core.StepIdentifier {
 	return WaitingTransferConfirmation
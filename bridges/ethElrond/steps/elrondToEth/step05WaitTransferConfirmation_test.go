@@ -4,6 +4,8 @@ import (
 	"context"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
 	"github.com/stretchr/testify/assert"
@@ -24,4 +26,63 @@ func TestExecute_WaitTransferConfirmation(t *testing.T) {
 		expectedStep := core.StepIdentifier(PerformingTransfer)
 		assert.Equal(t, expectedStep, stepIdentifier)
 	})
+	t.Run("reverted transfer should reject the deposits and go to ProposingSetStatusOnElrond", func(t *testing.T) {
+		bridgeStub := bridgeTests.NewBridgeExecutorStub()
+		bridgeStub.WaitForTransferConfirmationCalled = func(ctx context.Context) error {
+			return ethElrond.ErrTransferReverted
+		}
+		storedBatch := &clients.TransferBatch{
+			ID:       112,
+			Deposits: []*clients.DepositTransfer{{Nonce: 1}, {Nonce: 2}},
+			Statuses: []byte{clients.Executed},
+		}
+		bridgeStub.GetStoredBatchCalled = func() *clients.TransferBatch {
+			return storedBatch
+		}
+		clearWasCalled := false
+		bridgeStub.ClearStoredP2PSignaturesForEthereumCalled = func() {
+			clearWasCalled = true
+		}
+
+		step := waitTransferConfirmationStep{
+			bridge: bridgeStub,
+		}
+
+		stepIdentifier := step.Execute(context.Background())
+		expectedStep := core.StepIdentifier(ProposingSetStatusOnElrond)
+		assert.Equal(t, expectedStep, stepIdentifier)
+		assert.Equal(t, []byte{clients.Rejected, clients.Rejected}, storedBatch.Statuses)
+		assert.True(t, clearWasCalled)
+	})
+	t.Run("reverted transfer with nil stored batch should go to GettingPendingBatchFromElrond", func(t *testing.T) {
+		bridgeStub := bridgeTests.NewBridgeExecutorStub()
+		bridgeStub.WaitForTransferConfirmationCalled = func(ctx context.Context) error {
+			return ethElrond.ErrTransferReverted
+		}
+		bridgeStub.GetStoredBatchCalled = func() *clients.TransferBatch {
+			return nil
+		}
+
+		step := waitTransferConfirmationStep{
+			bridge: bridgeStub,
+		}
+
+		stepIdentifier := step.Execute(context.Background())
+		expectedStep := core.StepIdentifier(GettingPendingBatchFromElrond)
+		assert.Equal(t, expectedStep, stepIdentifier)
+	})
+	t.Run("error waiting should go to GettingPendingBatchFromElrond", func(t *testing.T) {
+		bridgeStub := bridgeTests.NewBridgeExecutorStub()
+		bridgeStub.WaitForTransferConfirmationCalled = func(ctx context.Context) error {
+			return expectedError
+		}
+
+		step := waitTransferConfirmationStep{
+			bridge: bridgeStub,
+		}
+
+		stepIdentifier := step.Execute(context.Background())
+		expectedStep := core.StepIdentifier(GettingPendingBatchFromElrond)
+		assert.Equal(t, expectedStep, stepIdentifier)
+	})
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond/steps"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 )
@@ -36,6 +37,7 @@ func (step *resolveSetStatusStep) Execute(ctx context.Context) core.StepIdentifi
 		return GettingPendingBatchFromElrond
 	}
 
+	step.bridge.PrintInfo(logger.LogDebug, "final batch statuses", "statuses", clients.DepositStatusesFromBytes(statuses))
 	storedBatch.Statuses = statuses
 
 	step.bridge.ResolveNewDepositsStatuses(uint64(len(batch.Statuses)))
@@ -100,6 +100,13 @@ func TestExecute_ResolveSetStatus(t *testing.T) {
 	t.Run("WaitAndReturnFinalBatchStatusesCalled should finish with success and go to ProposingSetStatusOnElrond", func(t *testing.T) {
 		t.Parallel()
 		bridgeStub := createStubExecutorResolveSetStatus()
+		storedBatch := &clients.TransferBatch{
+			ID:       112233,
+			Statuses: []byte{0, 0},
+		}
+		bridgeStub.GetStoredBatchCalled = func() *clients.TransferBatch {
+			return storedBatch
+		}
 		bridgeStub.WaitAndReturnFinalBatchStatusesCalled = func(ctx context.Context) []byte {
 			return []byte{clients.Executed, clients.Rejected}
 		}
@@ -125,6 +132,8 @@ func TestExecute_ResolveSetStatus(t *testing.T) {
 		assert.NotEqual(t, step.Identifier(), stepIdentifier)
 		assert.Equal(t, expectedStep, stepIdentifier)
 		assert.True(t, clearWasCalled)
+		expectedStatuses := []clients.DepositStatus{clients.DepositStatusExecuted, clients.DepositStatusRejected}
+		assert.Equal(t, expectedStatuses, clients.DepositStatusesFromBytes(storedBatch.Statuses))
 	})
 }
 
@@ -28,7 +28,7 @@ func (step *performSetStatusStep) Execute(ctx context.Context) core.StepIdentifi
 	}
 
 	if !step.bridge.MyTurnAsLeader() {
-		step.bridge.PrintInfo(logger.LogDebug, "not my turn as leader in this round")
+		step.bridge.PrintNotMyTurnAsLeader()
 		return step.Identifier()
 	}
 
@@ -9,20 +9,25 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 )
 
-// CreateSteps creates all machine states providing the bridge executor
-func CreateSteps(executor steps.Executor) (core.MachineStates, error) {
+// CreateSteps creates all machine states providing the bridge executor. The batch validation step is only reached
+// if batchValidationEnabled is set
+func CreateSteps(executor steps.Executor, batchValidationEnabled bool) (core.MachineStates, error) {
 	if check.IfNil(executor) {
 		return nil, ethElrond.ErrNilExecutor
 	}
 
-	return createMachineStates(executor)
+	return createMachineStates(executor, batchValidationEnabled)
 }
 
-func createMachineStates(executor steps.Executor) (core.MachineStates, error) {
+func createMachineStates(executor steps.Executor, batchValidationEnabled bool) (core.MachineStates, error) {
 	machineStates := make(core.MachineStates)
 
 	stepsSlice := []core.Step{
 		&getPendingStep{
+			bridge:                 executor,
+			batchValidationEnabled: batchValidationEnabled,
+		},
+		&validateBatchStep{
 			bridge: executor,
 		},
 		&signProposedTransferStep{
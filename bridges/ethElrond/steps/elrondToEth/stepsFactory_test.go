@@ -12,7 +12,7 @@ import (
 func TestCreateSteps_Errors(t *testing.T) {
 	t.Parallel()
 
-	steps, err := CreateSteps(nil)
+	steps, err := CreateSteps(nil, false)
 
 	assert.Nil(t, steps)
 	assert.Equal(t, ethElrond.ErrNilExecutor, err)
@@ -21,7 +21,7 @@ func TestCreateSteps_Errors(t *testing.T) {
 func TestCreateSteps_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	steps, err := CreateSteps(bridgeTests.NewBridgeExecutorStub())
+	steps, err := CreateSteps(bridgeTests.NewBridgeExecutorStub(), true)
 
 	require.NotNil(t, steps)
 	require.Nil(t, err)
@@ -3,7 +3,9 @@ package ethToElrond
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
+	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond"
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond/steps"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -31,8 +33,12 @@ func (step *getPendingStep) Execute(ctx context.Context) core.StepIdentifier {
 	}
 
 	err = step.bridge.GetAndStoreBatchFromEthereum(ctx, lastEthBatchExecuted+1)
+	if errors.Is(err, ethElrond.ErrBatchNotFound) {
+		step.bridge.PrintInfo(logger.LogDebug, "no new batch found on eth", "batch ID", lastEthBatchExecuted+1, "message", err)
+		return step.Identifier()
+	}
 	if err != nil {
-		step.bridge.PrintInfo(logger.LogDebug, "cannot fetch eth batch", "batch ID", lastEthBatchExecuted+1, "message", err)
+		step.bridge.PrintInfo(logger.LogError, "cannot fetch eth batch", "batch ID", lastEthBatchExecuted+1, "error", err)
 		return step.Identifier()
 	}
 
@@ -50,11 +56,13 @@ func (step *getPendingStep) Execute(ctx context.Context) core.StepIdentifier {
 	}
 
 	if !isValid {
-		step.bridge.PrintInfo(logger.LogError, "batch not valid "+batch.String())
+		step.bridge.PrintInfo(logger.LogDebug, "batch not valid "+batch.String())
+		step.bridge.PrintInfo(logger.LogError, "batch not valid "+batch.Summary())
 		return step.Identifier()
 	}
 
-	step.bridge.PrintInfo(logger.LogInfo, "fetched new batch from Ethereum "+batch.String())
+	step.bridge.PrintInfo(logger.LogDebug, "fetched new batch from Ethereum "+batch.String())
+	step.bridge.PrintInfo(logger.LogInfo, "fetched new batch from Ethereum "+batch.Summary())
 
 	err = step.bridge.VerifyLastDepositNonceExecutedOnEthereumBatch(ctx)
 	if err != nil {
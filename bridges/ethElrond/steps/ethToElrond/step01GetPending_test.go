@@ -3,11 +3,14 @@ package ethToElrond
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond"
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -46,6 +49,34 @@ func TestExecuteGetPending(t *testing.T) {
 		bridgeStub.GetAndStoreBatchFromEthereumCalled = func(ctx context.Context, nonce uint64) error {
 			return expectedError
 		}
+		var printedLogLevel logger.LogLevel
+		bridgeStub.PrintInfoCalled = func(logLevel logger.LogLevel, message string, extras ...interface{}) {
+			printedLogLevel = logLevel
+		}
+
+		step := getPendingStep{
+			bridge: bridgeStub,
+		}
+
+		expectedStepIdentifier := step.Identifier()
+		stepIdentifier := step.Execute(context.Background())
+		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
+		assert.Equal(t, logger.LogError, printedLogLevel)
+	})
+
+	t.Run("batch not found on GetAndStoreBatchFromEthereum", func(t *testing.T) {
+		t.Parallel()
+		bridgeStub := createStubExecutor()
+		bridgeStub.GetLastExecutedEthBatchIDFromElrondCalled = func(ctx context.Context) (uint64, error) {
+			return 1122, nil
+		}
+		bridgeStub.GetAndStoreBatchFromEthereumCalled = func(ctx context.Context, nonce uint64) error {
+			return fmt.Errorf("%w, requested nonce: %d", ethElrond.ErrBatchNotFound, nonce)
+		}
+		var printedLogLevel logger.LogLevel
+		bridgeStub.PrintInfoCalled = func(logLevel logger.LogLevel, message string, extras ...interface{}) {
+			printedLogLevel = logLevel
+		}
 
 		step := getPendingStep{
 			bridge: bridgeStub,
@@ -54,6 +85,7 @@ func TestExecuteGetPending(t *testing.T) {
 		expectedStepIdentifier := step.Identifier()
 		stepIdentifier := step.Execute(context.Background())
 		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
+		assert.Equal(t, logger.LogDebug, printedLogLevel)
 	})
 
 	t.Run("nil on GetStoredBatch", func(t *testing.T) {
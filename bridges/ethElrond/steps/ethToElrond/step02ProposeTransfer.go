@@ -32,7 +32,7 @@ func (step *proposeTransferStep) Execute(ctx context.Context) core.StepIdentifie
 	}
 
 	if !step.bridge.MyTurnAsLeader() {
-		step.bridge.PrintInfo(logger.LogDebug, "not my turn as leader in this round")
+		step.bridge.PrintNotMyTurnAsLeader()
 		return step.Identifier()
 	}
 
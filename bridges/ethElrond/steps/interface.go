@@ -11,6 +11,7 @@ import (
 type Executor interface {
 	PrintInfo(logLevel logger.LogLevel, message string, extras ...interface{})
 	MyTurnAsLeader() bool
+	PrintNotMyTurnAsLeader()
 
 	GetBatchFromElrond(ctx context.Context) (*clients.TransferBatch, error)
 	StoreBatchFromElrond(batch *clients.TransferBatch) error
@@ -43,10 +44,10 @@ type Executor interface {
 
 	GetAndStoreBatchFromEthereum(ctx context.Context, nonce uint64) error
 	WasTransferPerformedOnEthereum(ctx context.Context) (bool, error)
-	SignTransferOnEthereum() error
+	SignTransferOnEthereum(ctx context.Context) error
 	PerformTransferOnEthereum(ctx context.Context) error
 	ProcessQuorumReachedOnEthereum(ctx context.Context) (bool, error)
-	WaitForTransferConfirmation(ctx context.Context)
+	WaitForTransferConfirmation(ctx context.Context) error
 	WaitAndReturnFinalBatchStatuses(ctx context.Context) []byte
 	GetBatchStatusesFromEthereum(ctx context.Context) ([]byte, error)
 
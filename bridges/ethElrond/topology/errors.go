@@ -7,6 +7,7 @@ import (
 var (
 	errNilPublicKeysProvider    = errors.New("nil public keys provider")
 	errInvalidIntervalForLeader = errors.New("invalid interval for leader")
+	errInvalidSkewTolerance     = errors.New("invalid skew tolerance")
 	errNilTimer                 = errors.New("nil timer")
 	errEmptyAddress             = errors.New("empty address")
 	errNilLogger                = errors.New("nil logger")
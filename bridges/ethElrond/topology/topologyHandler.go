@@ -9,11 +9,14 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 )
 
+const maxLookAheadRounds = 1000
+
 // ArgsTopologyHandler is the DTO used in the NewTopologyHandler constructor function
 type ArgsTopologyHandler struct {
 	PublicKeysProvider PublicKeysProvider
 	Timer              core.Timer
 	IntervalForLeader  time.Duration
+	SkewTolerance      time.Duration
 	AddressBytes       []byte
 	Log                logger.Logger
 	AddressConverter   core.AddressConverter
@@ -24,6 +27,7 @@ type topologyHandler struct {
 	publicKeysProvider PublicKeysProvider
 	timer              core.Timer
 	intervalForLeader  time.Duration
+	skewTolerance      time.Duration
 	addressBytes       []byte
 	selector           *hashRandomSelector
 	log                logger.Logger
@@ -41,6 +45,7 @@ func NewTopologyHandler(args ArgsTopologyHandler) (*topologyHandler, error) {
 		publicKeysProvider: args.PublicKeysProvider,
 		timer:              args.Timer,
 		intervalForLeader:  args.IntervalForLeader,
+		skewTolerance:      args.SkewTolerance,
 		addressBytes:       args.AddressBytes,
 		selector:           &hashRandomSelector{},
 		log:                args.Log,
@@ -48,7 +53,13 @@ func NewTopologyHandler(args ArgsTopologyHandler) (*topologyHandler, error) {
 	}, nil
 }
 
-// MyTurnAsLeader returns true if the current relay is leader
+// MyTurnAsLeader returns true if the current relay is leader. The leader of a round is deterministically selected
+// from the sorted relayers addresses list using the round number, so all relayers agree on it as long as they agree
+// on the current round. As the relayers clocks can drift apart, the current relay considers itself leader only if
+// it is also selected for the rounds computed at now - skew tolerance and now + skew tolerance. This way, around a
+// round boundary where the leader changes, the outgoing leader stops before the boundary and the incoming one
+// starts after it, so two relayers whose clocks are off by at most the skew tolerance never act as leaders at the
+// same time. A 0 skew tolerance disables the guard window
 func (t *topologyHandler) MyTurnAsLeader() bool {
 	sortedPublicKeys := t.publicKeysProvider.SortedPublicKeys()
 
@@ -56,13 +67,19 @@ func (t *topologyHandler) MyTurnAsLeader() bool {
 		t.log.Warn("topology handler: can not compute my turn as leader as the list is empty")
 		return false
 	} else {
-		numberOfPeers := int64(len(sortedPublicKeys))
+		numberOfPeers := uint64(len(sortedPublicKeys))
 
-		seed := uint64(t.timer.NowUnix() / int64(t.intervalForLeader.Seconds()))
-		index := t.selector.randomInt(seed, uint64(numberOfPeers))
+		now := t.timer.NowUnix()
+		index := t.leaderIndex(now, numberOfPeers)
+		inSkewWindow := false
+		skewInSeconds := int64(t.skewTolerance.Seconds())
+		if skewInSeconds > 0 {
+			inSkewWindow = t.leaderIndex(now-skewInSeconds, numberOfPeers) != index ||
+				t.leaderIndex(now+skewInSeconds, numberOfPeers) != index
+		}
 
 		leaderAddress := sortedPublicKeys[index]
-		isLeader := bytes.Equal(leaderAddress, t.addressBytes)
+		isLeader := bytes.Equal(leaderAddress, t.addressBytes) && !inSkewWindow
 		msg := "topology handler"
 		if isLeader {
 			msg += " (my turn)"
@@ -71,12 +88,75 @@ func (t *topologyHandler) MyTurnAsLeader() bool {
 		t.log.Debug(msg,
 			"leader", t.addressConverter.ToBech32String(leaderAddress),
 			"index", index,
+			"in skew window", inSkewWindow,
 			"self address", t.addressConverter.ToBech32String(t.addressBytes))
 
 		return isLeader
 	}
 }
 
+func (t *topologyHandler) leaderIndex(timestamp int64, numberOfPeers uint64) uint64 {
+	seed := uint64(timestamp / int64(t.intervalForLeader.Seconds()))
+
+	return t.selector.randomInt(seed, numberOfPeers)
+}
+
+// TimeUntilMyTurn returns the time left until MyTurnAsLeader will return true, 0 if it already does. The upcoming
+// rounds are checked up to maxLookAheadRounds rounds, the returned value being the duration of the whole look-ahead
+// window if the current relay is not selected in any of them. The same skew tolerance guard window as in
+// MyTurnAsLeader is applied: a round is entered only after the skew tolerance and is left the skew tolerance before
+// its end
+func (t *topologyHandler) TimeUntilMyTurn() time.Duration {
+	sortedPublicKeys := t.publicKeysProvider.SortedPublicKeys()
+	intervalInSeconds := int64(t.intervalForLeader.Seconds())
+	if len(sortedPublicKeys) == 0 {
+		t.log.Warn("topology handler: can not compute the time until my turn as the list is empty")
+		return t.intervalForLeader
+	}
+
+	now := t.timer.NowUnix()
+	currentRound := now / intervalInSeconds
+	numberOfPeers := uint64(len(sortedPublicKeys))
+	for i := int64(0); i < maxLookAheadRounds; i++ {
+		round := currentRound + i
+		index := t.selector.randomInt(uint64(round), numberOfPeers)
+		if !bytes.Equal(sortedPublicKeys[index], t.addressBytes) {
+			continue
+		}
+		if i == 0 {
+			timeUntilGuardWindowEnd, isTurnEnding := t.currentRoundGuardWindow(now, currentRound, index, numberOfPeers)
+			if isTurnEnding {
+				continue
+			}
+
+			return timeUntilGuardWindowEnd
+		}
+
+		return time.Duration(round*intervalInSeconds-now)*time.Second + t.skewTolerance
+	}
+
+	return time.Duration((currentRound+maxLookAheadRounds)*intervalInSeconds-now) * time.Second
+}
+
+// currentRoundGuardWindow applies the MyTurnAsLeader guard window on the current round, in which the current relay
+// is selected. It returns the time left until the guard window at the round start ends and true if the round is
+// already in the guard window before its end, so the current relay will not act as leader in this round anymore
+func (t *topologyHandler) currentRoundGuardWindow(now int64, currentRound int64, index uint64, numberOfPeers uint64) (time.Duration, bool) {
+	skewInSeconds := int64(t.skewTolerance.Seconds())
+	if skewInSeconds <= 0 {
+		return 0, false
+	}
+	if t.leaderIndex(now+skewInSeconds, numberOfPeers) != index {
+		return 0, true
+	}
+	if t.leaderIndex(now-skewInSeconds, numberOfPeers) != index {
+		roundStart := currentRound * int64(t.intervalForLeader.Seconds())
+		return time.Duration(roundStart+skewInSeconds-now) * time.Second, false
+	}
+
+	return 0, false
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (t *topologyHandler) IsInterfaceNil() bool {
 	return t == nil
@@ -92,6 +172,9 @@ func checkArgs(args ArgsTopologyHandler) error {
 	if int64(args.IntervalForLeader.Seconds()) <= 0 {
 		return errInvalidIntervalForLeader
 	}
+	if args.SkewTolerance < 0 || 2*args.SkewTolerance >= args.IntervalForLeader {
+		return errInvalidSkewTolerance
+	}
 	if len(args.AddressBytes) == 0 {
 		return errEmptyAddress
 	}
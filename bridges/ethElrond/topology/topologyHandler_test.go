@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var duration = time.Second
@@ -47,6 +48,23 @@ func TestNewTopologyHandler(t *testing.T) {
 		assert.True(t, check.IfNil(tph))
 		assert.Equal(t, errInvalidIntervalForLeader, err)
 	})
+	t.Run("invalid skew tolerance", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTopologyHandler()
+		args.SkewTolerance = -time.Second
+		tph, err := NewTopologyHandler(args)
+
+		assert.True(t, check.IfNil(tph))
+		assert.Equal(t, errInvalidSkewTolerance, err)
+
+		args.IntervalForLeader = time.Second * 10
+		args.SkewTolerance = time.Second * 5
+		tph, err = NewTopologyHandler(args)
+
+		assert.True(t, check.IfNil(tph))
+		assert.Equal(t, errInvalidSkewTolerance, err)
+	})
 	t.Run("empty address", func(t *testing.T) {
 		t.Parallel()
 
@@ -96,6 +114,7 @@ func TestNewTopologyHandler(t *testing.T) {
 		assert.True(t, args.PublicKeysProvider == tph.publicKeysProvider) // pointer testing
 		assert.Equal(t, args.Timer, tph.timer)
 		assert.Equal(t, args.IntervalForLeader, tph.intervalForLeader)
+		assert.Equal(t, args.SkewTolerance, tph.skewTolerance)
 		assert.Equal(t, args.AddressBytes, tph.addressBytes)
 	})
 }
@@ -137,6 +156,193 @@ func TestMyTurnAsLeader(t *testing.T) {
 	})
 }
 
+func TestMyTurnAsLeader_SkewTolerance(t *testing.T) {
+	t.Parallel()
+
+	interval := int64(10)
+	firstLeaderChange := func(startRound int64) int64 {
+		selector := &hashRandomSelector{}
+		for round := startRound; ; round++ {
+			if selector.randomInt(uint64(round), 2) != selector.randomInt(uint64(round+1), 2) {
+				return round + 1
+			}
+		}
+	}
+	createHandler := func(address []byte, skewTolerance time.Duration, now *int64, clockOffset int64) *topologyHandler {
+		args := createMockArgsTopologyHandler()
+		args.AddressBytes = address
+		args.IntervalForLeader = time.Duration(interval) * time.Second
+		args.SkewTolerance = skewTolerance
+		args.Timer = testsCommon.NewTimerStub()
+		args.Timer.(*testsCommon.TimerStub).NowUnixCalled = func() int64 {
+			return *now + clockOffset
+		}
+		tph, err := NewTopologyHandler(args)
+		require.Nil(t, err)
+
+		return tph
+	}
+	countLeaders := func(handlers ...*topologyHandler) int {
+		numLeaders := 0
+		for _, handler := range handlers {
+			if handler.MyTurnAsLeader() {
+				numLeaders++
+			}
+		}
+		return numLeaders
+	}
+
+	t.Run("skewed clocks without tolerance should overlap around the leader change", func(t *testing.T) {
+		t.Parallel()
+
+		boundary := firstLeaderChange(100) * interval
+		previousLeader := (&hashRandomSelector{}).randomInt(uint64(boundary/interval-1), 2)
+		addresses := [][]byte{bytes.Repeat([]byte("1"), 32), bytes.Repeat([]byte("2"), 32)}
+		now := boundary - 1
+		// the previous leader clock is behind while the next leader clock is ahead of the boundary
+		handler1 := createHandler(addresses[previousLeader], 0, &now, 0)
+		handler2 := createHandler(addresses[1-previousLeader], 0, &now, 2)
+
+		assert.Equal(t, 2, countLeaders(handler1, handler2))
+	})
+	t.Run("skewed clocks within tolerance should never overlap", func(t *testing.T) {
+		t.Parallel()
+
+		skew := int64(2)
+		for _, offset := range []int64{-2, -1, 0, 1, 2} {
+			now := int64(0)
+			handler1 := createHandler(bytes.Repeat([]byte("1"), 32), time.Duration(skew)*time.Second, &now, 0)
+			handler2 := createHandler(bytes.Repeat([]byte("2"), 32), time.Duration(skew)*time.Second, &now, offset)
+
+			for now = 100 * interval; now < 200*interval; now++ {
+				assert.True(t, countLeaders(handler1, handler2) <= 1, "offset %d, now %d", offset, now)
+			}
+		}
+	})
+	t.Run("should not be leader in the guard window around the leader change", func(t *testing.T) {
+		t.Parallel()
+
+		boundary := firstLeaderChange(100) * interval
+		now := int64(0)
+		handler1 := createHandler(bytes.Repeat([]byte("1"), 32), 2*time.Second, &now, 0)
+		handler2 := createHandler(bytes.Repeat([]byte("2"), 32), 2*time.Second, &now, 0)
+
+		now = boundary - 3
+		assert.Equal(t, 1, countLeaders(handler1, handler2))
+		for now = boundary - 2; now < boundary+2; now++ {
+			assert.Equal(t, 0, countLeaders(handler1, handler2), "now %d", now)
+		}
+		now = boundary + 2
+		assert.Equal(t, 1, countLeaders(handler1, handler2))
+	})
+}
+
+func TestTimeUntilMyTurn(t *testing.T) {
+	t.Parallel()
+
+	firstRoundSelecting := func(startRound int64, expectedIndex uint64) int64 {
+		selector := &hashRandomSelector{}
+		for round := startRound; ; round++ {
+			if selector.randomInt(uint64(round), 2) == expectedIndex {
+				return round
+			}
+		}
+	}
+
+	t.Run("empty SortedPublicKeys should return the leader interval", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTopologyHandler()
+		args.PublicKeysProvider = &testsCommon.BroadcasterStub{
+			SortedPublicKeysCalled: func() [][]byte {
+				return make([][]byte, 0)
+			},
+		}
+		tph, _ := NewTopologyHandler(args)
+
+		assert.Equal(t, duration, tph.TimeUntilMyTurn())
+	})
+	t.Run("leader should return 0", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTopologyHandler()
+		tph, _ := NewTopologyHandler(args)
+
+		assert.True(t, tph.MyTurnAsLeader())
+		assert.Equal(t, time.Duration(0), tph.TimeUntilMyTurn())
+	})
+	t.Run("not leader should return the time until the next selected round", func(t *testing.T) {
+		t.Parallel()
+
+		interval := int64(10)
+		currentRound := firstRoundSelecting(100, 0)
+		now := currentRound*interval + 5
+		expectedRound := firstRoundSelecting(currentRound, 1)
+		require.True(t, expectedRound > currentRound)
+
+		args := createMockArgsTopologyHandler()
+		args.AddressBytes = bytes.Repeat([]byte("2"), 32)
+		args.IntervalForLeader = time.Duration(interval) * time.Second
+		args.Timer = createTimerStubWithUnixValue(now)
+		tph, _ := NewTopologyHandler(args)
+
+		assert.False(t, tph.MyTurnAsLeader())
+		assert.Equal(t, time.Duration(expectedRound*interval-now)*time.Second, tph.TimeUntilMyTurn())
+	})
+	t.Run("should agree with MyTurnAsLeader at the edges of the guard window", func(t *testing.T) {
+		t.Parallel()
+
+		interval := int64(10)
+		skew := int64(2)
+		selector := &hashRandomSelector{}
+		// a round selecting the first relay, preceded and followed by rounds selecting the other relay
+		round := int64(100)
+		for ; ; round++ {
+			if selector.randomInt(uint64(round), 2) == 0 &&
+				selector.randomInt(uint64(round-1), 2) == 1 &&
+				selector.randomInt(uint64(round+1), 2) == 1 {
+				break
+			}
+		}
+		roundStart := round * interval
+		nextRoundOfMine := firstRoundSelecting(round+1, 0)
+
+		now := int64(0)
+		args := createMockArgsTopologyHandler()
+		args.IntervalForLeader = time.Duration(interval) * time.Second
+		args.SkewTolerance = time.Duration(skew) * time.Second
+		args.Timer = testsCommon.NewTimerStub()
+		args.Timer.(*testsCommon.TimerStub).NowUnixCalled = func() int64 {
+			return now
+		}
+		tph, _ := NewTopologyHandler(args)
+
+		for now = roundStart; now < roundStart+skew; now++ {
+			assert.False(t, tph.MyTurnAsLeader(), "now %d", now)
+			assert.Equal(t, time.Duration(roundStart+skew-now)*time.Second, tph.TimeUntilMyTurn(), "now %d", now)
+		}
+		for now = roundStart + skew; now <= roundStart+interval-skew-1; now++ {
+			assert.True(t, tph.MyTurnAsLeader(), "now %d", now)
+			assert.Equal(t, time.Duration(0), tph.TimeUntilMyTurn(), "now %d", now)
+		}
+		for now = roundStart + interval - skew; now < roundStart+interval; now++ {
+			assert.False(t, tph.MyTurnAsLeader(), "now %d", now)
+			expected := time.Duration(nextRoundOfMine*interval-now)*time.Second + args.SkewTolerance
+			assert.Equal(t, expected, tph.TimeUntilMyTurn(), "now %d", now)
+		}
+	})
+	t.Run("address not in the list should return the look-ahead window", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTopologyHandler()
+		args.AddressBytes = bytes.Repeat([]byte("3"), 32)
+		args.Timer = createTimerStubWithUnixValue(5)
+		tph, _ := NewTopologyHandler(args)
+
+		assert.Equal(t, maxLookAheadRounds*duration, tph.TimeUntilMyTurn())
+	})
+}
+
 func createTimerStubWithUnixValue(value int64) *testsCommon.TimerStub {
 	stub := testsCommon.NewTimerStub()
 	stub.NowUnixCalled = func() int64 {
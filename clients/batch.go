@@ -4,25 +4,34 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"sort"
+	"strings"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 )
 
 var log = logger.GetOrCreate("clients")
 
-// TransferBatch is the transfer batch structure agnostic of any chain implementation
+// TransferBatch is the transfer batch structure agnostic of any chain implementation. Its JSON form is the payload
+// sent to the batch validator microservice, so the json tags are part of the wire format and should not be changed
 type TransferBatch struct {
-	ID       uint64             `json:"batchId"`
-	Deposits []*DepositTransfer `json:"deposits"`
-	Statuses []byte             `json:"statuses"`
+	ID          uint64             `json:"batchId"`
+	Deposits    []*DepositTransfer `json:"deposits"`
+	Statuses    []byte             `json:"statuses"`
+	BlockNumber uint64             `json:"blockNumber,omitempty"`
+	// CorrelationID is generated by the relayer when the batch enters the state machine and is used to trace the
+	// batch across log lines. It is not part of the on-chain data and is not serialized
+	CorrelationID string `json:"-"`
 }
 
 // Clone will deep clone the current TransferBatch instance
 func (tb *TransferBatch) Clone() *TransferBatch {
 	cloned := &TransferBatch{
-		ID:       tb.ID,
-		Deposits: make([]*DepositTransfer, 0, len(tb.Deposits)),
-		Statuses: make([]byte, len(tb.Statuses)),
+		ID:            tb.ID,
+		Deposits:      make([]*DepositTransfer, 0, len(tb.Deposits)),
+		Statuses:      make([]byte, len(tb.Statuses)),
+		BlockNumber:   tb.BlockNumber,
+		CorrelationID: tb.CorrelationID,
 	}
 
 	for _, dt := range tb.Deposits {
@@ -44,6 +53,35 @@ func (tb *TransferBatch) String() string {
 	return str
 }
 
+// Summary will convert the transfer batch to a compact string containing the number of deposits and the
+// total amount for each token. Suitable for info-level logs, String() should be used for the full content
+func (tb *TransferBatch) Summary() string {
+	totals := make(map[string]*big.Int)
+	for _, dt := range tb.Deposits {
+		existing, found := totals[dt.DisplayableToken]
+		if !found {
+			existing = big.NewInt(0)
+			totals[dt.DisplayableToken] = existing
+		}
+		if dt.Amount != nil {
+			existing.Add(existing, dt.Amount)
+		}
+	}
+
+	tokens := make([]string, 0, len(totals))
+	for token := range totals {
+		tokens = append(tokens, token)
+	}
+	sort.Strings(tokens)
+
+	str := fmt.Sprintf("Batch id %d: %d deposits, %d tokens", tb.ID, len(tb.Deposits), len(tokens))
+	for _, token := range tokens {
+		str += fmt.Sprintf(", %s: %s", token, totals[token].String())
+	}
+
+	return str
+}
+
 // ResolveNewDeposits will add new statuses as rejected if the newNumDeposits exceeds the number of the deposits
 func (tb *TransferBatch) ResolveNewDeposits(newNumDeposits int) {
 	oldLen := len(tb.Statuses)
@@ -63,7 +101,44 @@ func (tb *TransferBatch) ResolveNewDeposits(newNumDeposits int) {
 	log.Warn("recovered num statuses", "len statuses", oldLen, "new num deposits", newNumDeposits)
 }
 
-// DepositTransfer is the deposit transfer structure agnostic of any chain implementation
+// SplitBatch partitions the deposits in consecutive sub-batches holding at most maxDeposits deposits each. The deposits
+// order is preserved and each sub-batch receives the statuses matching its deposits. All the sub-batches keep the
+// original batch ID and block number, as the ID identifies the batch on the source chain and the deposits nonces
+// already identify each transfer inside it. A batch that fits in maxDeposits, or a maxDeposits lower than 1, results
+// in a single clone of the whole batch
+func (tb *TransferBatch) SplitBatch(maxDeposits int) []*TransferBatch {
+	if maxDeposits < 1 || len(tb.Deposits) <= maxDeposits {
+		return []*TransferBatch{tb.Clone()}
+	}
+
+	subBatches := make([]*TransferBatch, 0, (len(tb.Deposits)+maxDeposits-1)/maxDeposits)
+	for start := 0; start < len(tb.Deposits); start += maxDeposits {
+		end := start + maxDeposits
+		if end > len(tb.Deposits) {
+			end = len(tb.Deposits)
+		}
+
+		subBatch := &TransferBatch{
+			ID:          tb.ID,
+			Deposits:    make([]*DepositTransfer, 0, end-start),
+			Statuses:    make([]byte, 0, end-start),
+			BlockNumber: tb.BlockNumber,
+		}
+		for i := start; i < end; i++ {
+			subBatch.Deposits = append(subBatch.Deposits, tb.Deposits[i].Clone())
+			if i < len(tb.Statuses) {
+				subBatch.Statuses = append(subBatch.Statuses, tb.Statuses[i])
+			}
+		}
+
+		subBatches = append(subBatches, subBatch)
+	}
+
+	return subBatches
+}
+
+// DepositTransfer is the deposit transfer structure agnostic of any chain implementation. Its JSON form is part of
+// the batch validator payload: only the displayable fields are sent and the amount is encoded as a JSON number
 type DepositTransfer struct {
 	Nonce               uint64   `json:"nonce"`
 	ToBytes             []byte   `json:"-"`
@@ -82,6 +157,35 @@ func (dt *DepositTransfer) String() string {
 		dt.DisplayableTo, dt.DisplayableFrom, dt.DisplayableToken, dt.Amount, dt.Nonce)
 }
 
+// FormattedAmount returns the amount scaled by the provided number of token decimals, as a decimal string without
+// trailing zeros in the fractional part (e.g. an amount of 1500000 with 6 decimals results in "1.5")
+func (dt *DepositTransfer) FormattedAmount(decimals uint8) string {
+	if dt.Amount == nil {
+		return "0"
+	}
+
+	digits := big.NewInt(0).Abs(dt.Amount).String()
+	sign := ""
+	if dt.Amount.Sign() < 0 {
+		sign = "-"
+	}
+	if decimals == 0 {
+		return sign + digits
+	}
+
+	numDecimals := int(decimals)
+	if len(digits) <= numDecimals {
+		digits = strings.Repeat("0", numDecimals-len(digits)+1) + digits
+	}
+	integerPart := digits[:len(digits)-numDecimals]
+	fractionalPart := strings.TrimRight(digits[len(digits)-numDecimals:], "0")
+	if len(fractionalPart) == 0 {
+		return sign + integerPart
+	}
+
+	return sign + integerPart + "." + fractionalPart
+}
+
 // Clone will deep clone the current DepositTransfer instance
 func (dt *DepositTransfer) Clone() *DepositTransfer {
 	cloned := &DepositTransfer{
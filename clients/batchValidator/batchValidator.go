@@ -6,31 +6,55 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients/chain"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/core/retry"
+	"github.com/ElrondNetwork/elrond-eth-bridge/status/disabled"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 )
 
 const minRequestTime = time.Millisecond
+const minRetryDelay = time.Millisecond
 const logPath = "BatchValidator"
+const evmAddressLength = 20
+const multiversXAddressLength = 32
+const jsonContentType = "application/json"
 
-// ArgsBatchValidator is the DTO used for the creating a new batch validator instance
+// ArgsBatchValidator is the DTO used for the creating a new batch validator instance. If BothDirections is set, the
+// instance also validates the batches going from DestinationChain to SourceChain. If Metrics is not set, no metrics
+// are recorded. If RequestMethod is empty, the requests are sent using POST
 type ArgsBatchValidator struct {
 	SourceChain      chain.Chain
 	DestinationChain chain.Chain
 	RequestURL       string
 	RequestTime      time.Duration
+	MaxRetries       uint64
+	RetryDelay       time.Duration
+	RequestMethod    string
+	BothDirections   bool
+	Metrics          core.BatchValidatorMetrics
 }
 
 type batchValidator struct {
-	requestURL  string
-	requestTime time.Duration
-	log         logger.Logger
-	httpClient  HTTPClient
+	baseURL          string
+	requestURL       string
+	sourceChain      chain.Chain
+	destinationChain chain.Chain
+	bothDirections   bool
+	requestTime      time.Duration
+	maxRetries       uint64
+	retryDelay       time.Duration
+	requestMethod    string
+	log              logger.Logger
+	httpClient       HTTPClient
+	metrics          core.BatchValidatorMetrics
 }
 
 // NewBatchValidator returns a new batch validator instance
@@ -41,9 +65,23 @@ func NewBatchValidator(args ArgsBatchValidator) (*batchValidator, error) {
 	}
 
 	bv := &batchValidator{
-		requestURL:  fmt.Sprintf("%s/%s/%s", args.RequestURL, args.SourceChain.ToLower(), args.DestinationChain.ToLower()),
-		requestTime: args.RequestTime,
-		httpClient:  http.DefaultClient,
+		baseURL:          args.RequestURL,
+		requestURL:       createRequestURL(args.RequestURL, args.SourceChain, args.DestinationChain),
+		sourceChain:      args.SourceChain,
+		destinationChain: args.DestinationChain,
+		bothDirections:   args.BothDirections,
+		requestTime:      args.RequestTime,
+		maxRetries:       args.MaxRetries,
+		retryDelay:       args.RetryDelay,
+		requestMethod:    args.RequestMethod,
+		httpClient:       http.DefaultClient,
+		metrics:          args.Metrics,
+	}
+	if len(bv.requestMethod) == 0 {
+		bv.requestMethod = http.MethodPost
+	}
+	if check.IfNil(bv.metrics) {
+		bv.metrics = &disabled.DisabledBatchValidatorMetrics{}
 	}
 	bv.log = logger.GetOrCreate(logPath)
 	return bv, nil
@@ -63,21 +101,88 @@ func checkArgs(args ArgsBatchValidator) error {
 	if args.RequestTime < minRequestTime {
 		return fmt.Errorf("%w in checkArgs for value RequestTime", clients.ErrInvalidValue)
 	}
+	if args.MaxRetries > 0 && args.RetryDelay < minRetryDelay {
+		return fmt.Errorf("%w in checkArgs for value RetryDelay", clients.ErrInvalidValue)
+	}
+	switch args.RequestMethod {
+	case "", http.MethodPost, http.MethodPut:
+	default:
+		return fmt.Errorf("%w in checkArgs for value RequestMethod: %q", clients.ErrInvalidValue, args.RequestMethod)
+	}
 
 	return nil
 }
 
-// ValidateBatch checks whether the given batch is the same also on miscroservice side
+func createRequestURL(baseURL string, sourceChain chain.Chain, destinationChain chain.Chain) string {
+	return fmt.Sprintf("%s/%s/%s", baseURL, sourceChain.ToLower(), destinationChain.ToLower())
+}
+
+// ValidateBatch checks whether the given batch is the same also on miscroservice side. The batch is validated for
+// the configured SourceChain to DestinationChain direction
 func (bv *batchValidator) ValidateBatch(ctx context.Context, batch *clients.TransferBatch) (bool, error) {
+	return bv.validateBatch(ctx, batch, bv.sourceChain, bv.destinationChain, bv.requestURL)
+}
+
+// ValidateBatchForDirection checks whether the given batch is the same also on miscroservice side, routing the
+// request for the provided direction. Errors if the direction is not served by this instance
+func (bv *batchValidator) ValidateBatchForDirection(ctx context.Context, batch *clients.TransferBatch, sourceChain chain.Chain, destinationChain chain.Chain) (bool, error) {
+	err := bv.checkDirection(sourceChain, destinationChain)
+	if err != nil {
+		return false, err
+	}
+
+	return bv.validateBatch(ctx, batch, sourceChain, destinationChain, createRequestURL(bv.baseURL, sourceChain, destinationChain))
+}
+
+// ForDirection returns a batch validator bound to the provided direction, sharing this instance
+func (bv *batchValidator) ForDirection(sourceChain chain.Chain, destinationChain chain.Chain) (clients.BatchValidator, error) {
+	err := bv.checkDirection(sourceChain, destinationChain)
+	if err != nil {
+		return nil, err
+	}
+
+	return &directionalBatchValidator{
+		validator:        bv,
+		sourceChain:      sourceChain,
+		destinationChain: destinationChain,
+	}, nil
+}
+
+func (bv *batchValidator) checkDirection(sourceChain chain.Chain, destinationChain chain.Chain) error {
+	isConfiguredDirection := sourceChain == bv.sourceChain && destinationChain == bv.destinationChain
+	isReverseDirection := sourceChain == bv.destinationChain && destinationChain == bv.sourceChain
+	if isConfiguredDirection || (bv.bothDirections && isReverseDirection) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: unsupported direction %s -> %s", clients.ErrInvalidValue, sourceChain, destinationChain)
+}
+
+func (bv *batchValidator) validateBatch(
+	ctx context.Context,
+	batch *clients.TransferBatch,
+	sourceChain chain.Chain,
+	destinationChain chain.Chain,
+	requestURL string,
+) (bool, error) {
+	err := checkDeposits(batch, sourceChain, destinationChain)
+	if err != nil {
+		return false, err
+	}
+
 	body, err := json.Marshal(batch)
 	if err != nil {
 		return false, fmt.Errorf("%w during request marshal", err)
 	}
 
-	responseAsBytes, err := bv.doRequest(ctx, body)
+	requestStart := time.Now()
+	responseAsBytes, err := bv.doRequest(ctx, requestURL, body)
+	bv.metrics.ObserveRequestDuration(time.Since(requestStart))
 	if err != nil {
+		bv.metrics.IncRequestsFailed()
 		return false, fmt.Errorf("%w while executing request", err)
 	}
+	bv.metrics.IncRequestsSucceeded()
 	if len(responseAsBytes) == 0 {
 		return false, errors.New("empty response")
 	}
@@ -88,14 +193,77 @@ func (bv *batchValidator) ValidateBatch(ctx context.Context, batch *clients.Tran
 		return false, fmt.Errorf("%w during response unmarshal", err)
 	}
 
+	core.NewLoggerWithBatchID(ctx, bv.log).Debug("batch validator response", "response", response.String())
+	if response.Valid {
+		bv.metrics.IncBatchesValid()
+	} else {
+		bv.metrics.IncBatchesInvalid()
+	}
+
 	return response.Valid, nil
 }
 
-func (bv *batchValidator) doRequest(ctx context.Context, batch []byte) ([]byte, error) {
-	requestContext, cancel := context.WithTimeout(ctx, bv.requestTime)
-	defer cancel()
+// checkDeposits rejects, without querying the microservice, the batches holding deposits with addresses that can not
+// belong to the provided direction: the sender should be a source chain address, the recipient a destination chain
+// address and the ERC20 token, on whichever side it is, an EVM address
+func checkDeposits(batch *clients.TransferBatch, sourceChain chain.Chain, destinationChain chain.Chain) error {
+	if batch == nil {
+		return nil
+	}
+
+	for _, deposit := range batch.Deposits {
+		err := checkAddressLength("sender", deposit.FromBytes, sourceChain)
+		if err == nil {
+			err = checkAddressLength("recipient", deposit.ToBytes, destinationChain)
+		}
+		if err == nil && sourceChain != chain.MultiversX {
+			err = checkAddressLength("token", deposit.TokenBytes, sourceChain)
+		}
+		if err == nil && destinationChain != chain.MultiversX {
+			err = checkAddressLength("converted token", deposit.ConvertedTokenBytes, destinationChain)
+		}
+		if err != nil {
+			return fmt.Errorf("%w, batch ID: %d, deposit nonce: %d, direction %s -> %s",
+				err, batch.ID, deposit.Nonce, sourceChain, destinationChain)
+		}
+	}
+
+	return nil
+}
+
+func checkAddressLength(field string, address []byte, addressChain chain.Chain) error {
+	expectedLength := evmAddressLength
+	if addressChain == chain.MultiversX {
+		expectedLength = multiversXAddressLength
+	}
+	if len(address) != expectedLength {
+		return fmt.Errorf("%w: invalid %s address length %d, expected %d for %s",
+			errMalformedDeposit, field, len(address), expectedLength, addressChain)
+	}
+
+	return nil
+}
+
+// doRequest sends the request, retrying it up to maxRetries times on failure. Each attempt has its own timeout, while
+// the cancellation of the parent context interrupts the whole request lifecycle, body read included
+func (bv *batchValidator) doRequest(ctx context.Context, requestURL string, batch []byte) ([]byte, error) {
+	var responseAsBytes []byte
+	attempt := uint64(0)
+	err := retry.Do(ctx, int(bv.maxRetries)+1, retry.NewExponentialBackoff(bv.retryDelay), func() error {
+		requestContext, cancel := context.WithTimeout(ctx, bv.requestTime)
+		defer cancel()
+
+		var errRequest error
+		responseAsBytes, errRequest = bv.doRequestReturningBytes(requestURL, batch, requestContext)
+		errRequest = classifyRequestError(ctx, requestContext, errRequest)
+		if errRequest != nil && attempt < bv.maxRetries {
+			bv.log.Debug("batch validator request failed, retrying",
+				"attempt", attempt+1, "max retries", bv.maxRetries, "error", errRequest)
+		}
+		attempt++
 
-	responseAsBytes, err := bv.doRequestReturningBytes(batch, requestContext)
+		return errRequest
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -103,19 +271,40 @@ func (bv *batchValidator) doRequest(ctx context.Context, batch []byte) ([]byte,
 	return responseAsBytes, nil
 }
 
-func (bv *batchValidator) doRequestReturningBytes(batch []byte, ctx context.Context) ([]byte, error) {
-	request, err := http.NewRequestWithContext(ctx, http.MethodPost, bv.requestURL, bytes.NewBuffer(batch))
-	request.Header.Set("Content-Type", "application/json")
+// classifyRequestError marks the error caused by the parent context cancellation as errRequestCancelled and the one
+// caused by a reached deadline as errRequestTimeout, so the callers can tell them apart
+func classifyRequestError(parentContext context.Context, requestContext context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(parentContext.Err(), context.Canceled) {
+		return fmt.Errorf("%w: %s", errRequestCancelled, err.Error())
+	}
+	if errors.Is(requestContext.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%w: %s", errRequestTimeout, err.Error())
+	}
+
+	return err
+}
+
+func (bv *batchValidator) doRequestReturningBytes(requestURL string, batch []byte, ctx context.Context) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, bv.requestMethod, requestURL, bytes.NewBuffer(batch))
 	if err != nil {
 		return nil, err
 	}
+	request.Header.Set("Content-Type", jsonContentType)
+	request.Header.Set("Accept", jsonContentType)
 
 	response, err := bv.httpClient.Do(request)
 	if err != nil {
 		return nil, err
 	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
 	if response.StatusCode == http.StatusBadRequest && response.Body != http.NoBody {
-		data, _ := ioutil.ReadAll(response.Body)
+		data, _ := readBody(ctx, response.Body)
 		badResponse := &microserviceBadRequestBody{}
 		err = json.Unmarshal(data, badResponse)
 		if err != nil {
@@ -127,11 +316,7 @@ func (bv *batchValidator) doRequestReturningBytes(batch []byte, ctx context.Cont
 		return nil, fmt.Errorf("got status %s", response.Status)
 	}
 
-	defer func() {
-		_ = response.Body.Close()
-	}()
-
-	body, err := ioutil.ReadAll(response.Body)
+	body, err := readBody(ctx, response.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -139,7 +324,46 @@ func (bv *batchValidator) doRequestReturningBytes(batch []byte, ctx context.Cont
 	return body, nil
 }
 
+// readBody reads the whole body, closing it as soon as the context is done so the read is interrupted regardless of
+// the transport used
+func readBody(ctx context.Context, body io.ReadCloser) ([]byte, error) {
+	readDone := make(chan struct{})
+	defer close(readDone)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = body.Close()
+		case <-readDone:
+		}
+	}()
+
+	data, err := ioutil.ReadAll(body)
+	if err != nil && ctx.Err() != nil {
+		return nil, fmt.Errorf("%w while reading the response body", ctx.Err())
+	}
+
+	return data, err
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (bv *batchValidator) IsInterfaceNil() bool {
 	return bv == nil
 }
+
+// directionalBatchValidator is a view over a batch validator that validates the batches for a single direction
+type directionalBatchValidator struct {
+	validator        *batchValidator
+	sourceChain      chain.Chain
+	destinationChain chain.Chain
+}
+
+// ValidateBatch checks whether the given batch is the same also on miscroservice side for the bound direction
+func (dbv *directionalBatchValidator) ValidateBatch(ctx context.Context, batch *clients.TransferBatch) (bool, error) {
+	return dbv.validator.ValidateBatchForDirection(ctx, batch, dbv.sourceChain, dbv.destinationChain)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dbv *directionalBatchValidator) IsInterfaceNil() bool {
+	return dbv == nil
+}
@@ -1,6 +1,7 @@
 package batchValidatorManagement
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -30,6 +31,24 @@ func createMockArgsBatchValidator() ArgsBatchValidator {
 	}
 }
 
+func createMockDeposit(nonce uint64, sourceChain chain.Chain, destinationChain chain.Chain) *clients.DepositTransfer {
+	addressLength := func(addressChain chain.Chain) int {
+		if addressChain == chain.MultiversX {
+			return multiversXAddressLength
+		}
+		return evmAddressLength
+	}
+
+	return &clients.DepositTransfer{
+		Nonce:               nonce,
+		FromBytes:           bytes.Repeat([]byte{1}, addressLength(sourceChain)),
+		ToBytes:             bytes.Repeat([]byte{2}, addressLength(destinationChain)),
+		TokenBytes:          bytes.Repeat([]byte{3}, addressLength(sourceChain)),
+		ConvertedTokenBytes: bytes.Repeat([]byte{4}, addressLength(destinationChain)),
+		Amount:              big.NewInt(1),
+	}
+}
+
 func TestNewBatchValidator(t *testing.T) {
 	t.Parallel()
 
@@ -58,6 +77,16 @@ func TestNewBatchValidator(t *testing.T) {
 		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
 		assert.True(t, strings.Contains(err.Error(), "checkArgs for value RequestTime"))
 	})
+	t.Run("invalid retry delay", func(t *testing.T) {
+		args := createMockArgsBatchValidator()
+		args.MaxRetries = 1
+		args.RetryDelay = time.Duration(minRetryDelay.Nanoseconds() - 1)
+
+		bv, err := NewBatchValidator(args)
+		assert.True(t, check.IfNil(bv))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "checkArgs for value RetryDelay"))
+	})
 	t.Run("should work", func(t *testing.T) {
 		args := createMockArgsBatchValidator()
 
@@ -65,6 +94,31 @@ func TestNewBatchValidator(t *testing.T) {
 		assert.False(t, check.IfNil(bv))
 		assert.Nil(t, err)
 	})
+	t.Run("invalid request method", func(t *testing.T) {
+		args := createMockArgsBatchValidator()
+		args.RequestMethod = http.MethodGet
+
+		bv, err := NewBatchValidator(args)
+		assert.True(t, check.IfNil(bv))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "checkArgs for value RequestMethod"))
+	})
+	t.Run("empty request method should default to POST", func(t *testing.T) {
+		args := createMockArgsBatchValidator()
+		args.RequestMethod = ""
+
+		bv, err := NewBatchValidator(args)
+		assert.Nil(t, err)
+		assert.Equal(t, http.MethodPost, bv.requestMethod)
+	})
+	t.Run("nil metrics should use the disabled metrics", func(t *testing.T) {
+		args := createMockArgsBatchValidator()
+		args.Metrics = nil
+
+		bv, err := NewBatchValidator(args)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(bv.metrics))
+	})
 }
 
 func TestBatchValidator_ValidateBatch(t *testing.T) {
@@ -77,15 +131,21 @@ func TestBatchValidator_ValidateBatch(t *testing.T) {
 		Deposits: []*clients.DepositTransfer{
 			{
 				Nonce:            1,
+				ToBytes:          bytes.Repeat([]byte{1}, multiversXAddressLength),
 				DisplayableTo:    "to1",
+				FromBytes:        bytes.Repeat([]byte{2}, evmAddressLength),
 				DisplayableFrom:  "from1",
+				TokenBytes:       bytes.Repeat([]byte{3}, evmAddressLength),
 				DisplayableToken: "token1",
 				Amount:           big.NewInt(0).Add(largeValue, big.NewInt(1)),
 			},
 			{
 				Nonce:            2,
+				ToBytes:          bytes.Repeat([]byte{4}, multiversXAddressLength),
 				DisplayableTo:    "to2",
+				FromBytes:        bytes.Repeat([]byte{5}, evmAddressLength),
 				DisplayableFrom:  "from2",
+				TokenBytes:       bytes.Repeat([]byte{6}, evmAddressLength),
 				DisplayableToken: "token2",
 				Amount:           big.NewInt(0).Add(largeValue, big.NewInt(2)),
 			},
@@ -284,4 +344,525 @@ func TestBatchValidator_ValidateBatch(t *testing.T) {
 		assert.True(t, isValid)
 		assert.Nil(t, err)
 	})
+	t.Run("should retry the failed requests", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBatchValidator()
+		args.MaxRetries = 2
+		args.RetryDelay = time.Millisecond
+		numRequests := 0
+		responseHandler := &testsCommon.HTTPHandlerStub{
+			ServeHTTPCalled: func(writer http.ResponseWriter, request *http.Request) {
+				numRequests++
+				if numRequests <= 2 {
+					writer.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+
+				writer.WriteHeader(http.StatusOK)
+				respBytes, _ := json.Marshal(&microserviceResponse{Valid: true})
+				_, _ = writer.Write(respBytes)
+			},
+		}
+
+		server := httptest.NewServer(responseHandler)
+		defer server.Close()
+
+		args.RequestURL = server.URL
+		bv, _ := NewBatchValidator(args)
+		isValid, err := bv.ValidateBatch(context.Background(), batch)
+		assert.True(t, isValid)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, numRequests)
+	})
+	t.Run("should return the last error after the maximum number of retries", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBatchValidator()
+		args.MaxRetries = 2
+		args.RetryDelay = time.Millisecond
+		numRequests := 0
+		responseHandler := &testsCommon.HTTPHandlerStub{
+			ServeHTTPCalled: func(writer http.ResponseWriter, request *http.Request) {
+				numRequests++
+				writer.WriteHeader(http.StatusServiceUnavailable)
+			},
+		}
+
+		server := httptest.NewServer(responseHandler)
+		defer server.Close()
+
+		args.RequestURL = server.URL
+		bv, _ := NewBatchValidator(args)
+		isValid, err := bv.ValidateBatch(context.Background(), batch)
+		assert.False(t, isValid)
+		assert.Equal(t, "got status 503 Service Unavailable while executing request", err.Error())
+		assert.Equal(t, 3, numRequests)
+	})
+}
+
+func TestBatchValidator_PayloadGoldenFile(t *testing.T) {
+	t.Parallel()
+
+	amount, ok := big.NewInt(0).SetString("1000000000000000000001", 10)
+	require.True(t, ok)
+	batch := &clients.TransferBatch{
+		ID: 37,
+		Deposits: []*clients.DepositTransfer{
+			{
+				Nonce:               1,
+				ToBytes:             bytes.Repeat([]byte("t"), multiversXAddressLength),
+				DisplayableTo:       "erd1to",
+				FromBytes:           bytes.Repeat([]byte("f"), evmAddressLength),
+				DisplayableFrom:     "0xfrom",
+				TokenBytes:          bytes.Repeat([]byte("k"), evmAddressLength),
+				ConvertedTokenBytes: []byte("converted token"),
+				DisplayableToken:    "0xtoken",
+				Amount:              amount,
+			},
+			{
+				Nonce:            2,
+				ToBytes:          bytes.Repeat([]byte("t"), multiversXAddressLength),
+				DisplayableTo:    "erd1to2",
+				FromBytes:        bytes.Repeat([]byte("f"), evmAddressLength),
+				DisplayableFrom:  "0xfrom2",
+				TokenBytes:       bytes.Repeat([]byte("k"), evmAddressLength),
+				DisplayableToken: "0xtoken2",
+				Amount:           big.NewInt(5),
+			},
+		},
+		Statuses:      []byte{0x3, 0x4},
+		BlockNumber:   1122,
+		CorrelationID: "a1b2c3",
+	}
+
+	expectedPayload, err := ioutil.ReadFile("testdata/batchPayload.json")
+	require.Nil(t, err)
+
+	var receivedPayload []byte
+	responseHandler := &testsCommon.HTTPHandlerStub{
+		ServeHTTPCalled: func(writer http.ResponseWriter, request *http.Request) {
+			defer func() {
+				_ = request.Body.Close()
+			}()
+
+			var errRead error
+			receivedPayload, errRead = ioutil.ReadAll(request.Body)
+			require.Nil(t, errRead)
+
+			writer.WriteHeader(http.StatusOK)
+			respBytes, _ := json.Marshal(&microserviceResponse{Valid: true})
+			_, _ = writer.Write(respBytes)
+		},
+	}
+
+	server := httptest.NewServer(responseHandler)
+	defer server.Close()
+
+	args := createMockArgsBatchValidator()
+	args.RequestURL = server.URL
+	bv, _ := NewBatchValidator(args)
+
+	isValid, err := bv.ValidateBatch(context.Background(), batch)
+	assert.True(t, isValid)
+	assert.Nil(t, err)
+	assert.Equal(t, strings.TrimSpace(string(expectedPayload)), string(receivedPayload))
+}
+
+func TestBatchValidator_Directions(t *testing.T) {
+	t.Parallel()
+
+	batch := &clients.TransferBatch{ID: 1}
+	createServer := func(requestedURLs *[]string) *httptest.Server {
+		return httptest.NewServer(&testsCommon.HTTPHandlerStub{
+			ServeHTTPCalled: func(writer http.ResponseWriter, request *http.Request) {
+				*requestedURLs = append(*requestedURLs, request.URL.String())
+
+				writer.WriteHeader(http.StatusOK)
+				respBytes, _ := json.Marshal(&microserviceResponse{Valid: true})
+				_, _ = writer.Write(respBytes)
+			},
+		})
+	}
+
+	t.Run("single direction should reject the reverse direction", func(t *testing.T) {
+		t.Parallel()
+
+		requestedURLs := make([]string, 0)
+		server := createServer(&requestedURLs)
+		defer server.Close()
+
+		args := createMockArgsBatchValidator()
+		args.RequestURL = server.URL
+		bv, _ := NewBatchValidator(args)
+
+		isValid, err := bv.ValidateBatchForDirection(context.Background(), batch, chain.MultiversX, chain.Ethereum)
+		assert.False(t, isValid)
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "unsupported direction"))
+
+		directional, err := bv.ForDirection(chain.MultiversX, chain.Ethereum)
+		assert.True(t, check.IfNil(directional))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+
+		isValid, err = bv.ValidateBatchForDirection(context.Background(), batch, chain.Ethereum, chain.MultiversX)
+		assert.True(t, isValid)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"/ethereum/msx"}, requestedURLs)
+	})
+	t.Run("both directions should route each direction to its URL", func(t *testing.T) {
+		t.Parallel()
+
+		requestedURLs := make([]string, 0)
+		server := createServer(&requestedURLs)
+		defer server.Close()
+
+		args := createMockArgsBatchValidator()
+		args.RequestURL = server.URL
+		args.BothDirections = true
+		bv, _ := NewBatchValidator(args)
+
+		ethToElrond, err := bv.ForDirection(chain.Ethereum, chain.MultiversX)
+		require.Nil(t, err)
+		elrondToEth, err := bv.ForDirection(chain.MultiversX, chain.Ethereum)
+		require.Nil(t, err)
+
+		isValid, err := ethToElrond.ValidateBatch(context.Background(), batch)
+		assert.True(t, isValid)
+		assert.Nil(t, err)
+		isValid, err = elrondToEth.ValidateBatch(context.Background(), batch)
+		assert.True(t, isValid)
+		assert.Nil(t, err)
+		isValid, err = bv.ValidateBatch(context.Background(), batch)
+		assert.True(t, isValid)
+		assert.Nil(t, err)
+
+		assert.Equal(t, []string{"/ethereum/msx", "/msx/ethereum", "/ethereum/msx"}, requestedURLs)
+
+		_, err = bv.ForDirection(chain.Bsc, chain.MultiversX)
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+	})
+}
+
+func TestBatchValidator_MalformedDeposits(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(&testsCommon.HTTPHandlerStub{
+		ServeHTTPCalled: func(writer http.ResponseWriter, request *http.Request) {
+			assert.Fail(t, "should have not called the microservice")
+		},
+	})
+	defer server.Close()
+
+	args := createMockArgsBatchValidator()
+	args.RequestURL = server.URL
+	args.BothDirections = true
+	bv, _ := NewBatchValidator(args)
+
+	testMalformed := func(sourceChain chain.Chain, destinationChain chain.Chain, alterDeposit func(deposit *clients.DepositTransfer), expectedMessage string) {
+		deposit := createMockDeposit(2, sourceChain, destinationChain)
+		alterDeposit(deposit)
+		batch := &clients.TransferBatch{
+			ID:       44,
+			Deposits: []*clients.DepositTransfer{createMockDeposit(1, sourceChain, destinationChain), deposit},
+		}
+
+		isValid, err := bv.ValidateBatchForDirection(context.Background(), batch, sourceChain, destinationChain)
+		assert.False(t, isValid)
+		assert.True(t, errors.Is(err, errMalformedDeposit))
+		assert.True(t, strings.Contains(err.Error(), expectedMessage))
+		assert.True(t, strings.Contains(err.Error(), "batch ID: 44, deposit nonce: 2"))
+	}
+
+	t.Run("Ethereum to MultiversX", func(t *testing.T) {
+		t.Parallel()
+
+		testMalformed(chain.Ethereum, chain.MultiversX, func(deposit *clients.DepositTransfer) {
+			deposit.FromBytes = bytes.Repeat([]byte{1}, multiversXAddressLength)
+		}, "invalid sender address length 32")
+		testMalformed(chain.Ethereum, chain.MultiversX, func(deposit *clients.DepositTransfer) {
+			deposit.ToBytes = bytes.Repeat([]byte{1}, evmAddressLength)
+		}, "invalid recipient address length 20")
+		testMalformed(chain.Ethereum, chain.MultiversX, func(deposit *clients.DepositTransfer) {
+			deposit.TokenBytes = []byte("token")
+		}, "invalid token address length 5")
+	})
+	t.Run("MultiversX to Ethereum", func(t *testing.T) {
+		t.Parallel()
+
+		testMalformed(chain.MultiversX, chain.Ethereum, func(deposit *clients.DepositTransfer) {
+			deposit.FromBytes = nil
+		}, "invalid sender address length 0")
+		testMalformed(chain.MultiversX, chain.Ethereum, func(deposit *clients.DepositTransfer) {
+			deposit.ToBytes = bytes.Repeat([]byte{1}, multiversXAddressLength)
+		}, "invalid recipient address length 32")
+		testMalformed(chain.MultiversX, chain.Ethereum, func(deposit *clients.DepositTransfer) {
+			deposit.ConvertedTokenBytes = []byte("converted token")
+		}, "invalid converted token address length 15")
+	})
+	t.Run("well formed deposits should reach the microservice", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := 0
+		validServer := httptest.NewServer(&testsCommon.HTTPHandlerStub{
+			ServeHTTPCalled: func(writer http.ResponseWriter, request *http.Request) {
+				numRequests++
+				writer.WriteHeader(http.StatusOK)
+				respBytes, _ := json.Marshal(&microserviceResponse{Valid: true})
+				_, _ = writer.Write(respBytes)
+			},
+		})
+		defer validServer.Close()
+
+		validArgs := createMockArgsBatchValidator()
+		validArgs.RequestURL = validServer.URL
+		validArgs.BothDirections = true
+		validator, _ := NewBatchValidator(validArgs)
+
+		batch := &clients.TransferBatch{
+			ID:       45,
+			Deposits: []*clients.DepositTransfer{createMockDeposit(1, chain.MultiversX, chain.Ethereum)},
+		}
+		isValid, err := validator.ValidateBatchForDirection(context.Background(), batch, chain.MultiversX, chain.Ethereum)
+		assert.True(t, isValid)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numRequests)
+	})
+}
+
+func TestBatchValidator_Metrics(t *testing.T) {
+	t.Parallel()
+
+	type recordedMetrics struct {
+		numDurations int
+		numSucceeded int
+		numFailed    int
+		numValid     int
+		numInvalid   int
+	}
+	createMetrics := func(recorded *recordedMetrics) *testsCommon.BatchValidatorMetricsStub {
+		return &testsCommon.BatchValidatorMetricsStub{
+			ObserveRequestDurationCalled: func(duration time.Duration) {
+				recorded.numDurations++
+			},
+			IncRequestsSucceededCalled: func() {
+				recorded.numSucceeded++
+			},
+			IncRequestsFailedCalled: func() {
+				recorded.numFailed++
+			},
+			IncBatchesValidCalled: func() {
+				recorded.numValid++
+			},
+			IncBatchesInvalidCalled: func() {
+				recorded.numInvalid++
+			},
+		}
+	}
+	createServer := func(statusCode int, response *microserviceResponse) *httptest.Server {
+		return httptest.NewServer(&testsCommon.HTTPHandlerStub{
+			ServeHTTPCalled: func(writer http.ResponseWriter, request *http.Request) {
+				writer.WriteHeader(statusCode)
+				respBytes, _ := json.Marshal(response)
+				_, _ = writer.Write(respBytes)
+			},
+		})
+	}
+	batch := &clients.TransferBatch{
+		ID:       1,
+		Deposits: []*clients.DepositTransfer{createMockDeposit(1, chain.Ethereum, chain.MultiversX)},
+	}
+
+	t.Run("valid and invalid batches should be counted", func(t *testing.T) {
+		t.Parallel()
+
+		validServer := createServer(http.StatusOK, &microserviceResponse{Valid: true})
+		defer validServer.Close()
+		invalidServer := createServer(http.StatusOK, &microserviceResponse{Valid: false})
+		defer invalidServer.Close()
+
+		recorded := &recordedMetrics{}
+		args := createMockArgsBatchValidator()
+		args.Metrics = createMetrics(recorded)
+
+		args.RequestURL = validServer.URL
+		bv, _ := NewBatchValidator(args)
+		isValid, err := bv.ValidateBatch(context.Background(), batch)
+		assert.True(t, isValid)
+		assert.Nil(t, err)
+
+		args.RequestURL = invalidServer.URL
+		bv, _ = NewBatchValidator(args)
+		isValid, err = bv.ValidateBatch(context.Background(), batch)
+		assert.False(t, isValid)
+		assert.Nil(t, err)
+
+		assert.Equal(t, recordedMetrics{numDurations: 2, numSucceeded: 2, numValid: 1, numInvalid: 1}, *recorded)
+	})
+	t.Run("failed request should be counted once, after all retries", func(t *testing.T) {
+		t.Parallel()
+
+		server := createServer(http.StatusInternalServerError, &microserviceResponse{})
+		defer server.Close()
+
+		recorded := &recordedMetrics{}
+		args := createMockArgsBatchValidator()
+		args.RequestURL = server.URL
+		args.MaxRetries = 2
+		args.RetryDelay = time.Millisecond
+		args.Metrics = createMetrics(recorded)
+		bv, _ := NewBatchValidator(args)
+
+		isValid, err := bv.ValidateBatch(context.Background(), batch)
+		assert.False(t, isValid)
+		assert.NotNil(t, err)
+
+		assert.Equal(t, recordedMetrics{numDurations: 1, numFailed: 1}, *recorded)
+	})
+	t.Run("malformed deposit should not record anything", func(t *testing.T) {
+		t.Parallel()
+
+		recorded := &recordedMetrics{}
+		args := createMockArgsBatchValidator()
+		args.Metrics = createMetrics(recorded)
+		bv, _ := NewBatchValidator(args)
+
+		malformedBatch := &clients.TransferBatch{
+			ID:       2,
+			Deposits: []*clients.DepositTransfer{createMockDeposit(1, chain.MultiversX, chain.Ethereum)},
+		}
+		_, err := bv.ValidateBatch(context.Background(), malformedBatch)
+		assert.True(t, errors.Is(err, errMalformedDeposit))
+
+		assert.Equal(t, recordedMetrics{}, *recorded)
+	})
+}
+
+func TestBatchValidator_RequestMethodAndHeaders(t *testing.T) {
+	t.Parallel()
+
+	testRequest := func(configuredMethod string, expectedMethod string) {
+		numRequests := 0
+		server := httptest.NewServer(&testsCommon.HTTPHandlerStub{
+			ServeHTTPCalled: func(writer http.ResponseWriter, request *http.Request) {
+				numRequests++
+				assert.Equal(t, expectedMethod, request.Method)
+				assert.Equal(t, "application/json", request.Header.Get("Content-Type"))
+				assert.Equal(t, "application/json", request.Header.Get("Accept"))
+
+				writer.WriteHeader(http.StatusOK)
+				respBytes, _ := json.Marshal(&microserviceResponse{Valid: true})
+				_, _ = writer.Write(respBytes)
+			},
+		})
+		defer server.Close()
+
+		args := createMockArgsBatchValidator()
+		args.RequestURL = server.URL
+		args.RequestMethod = configuredMethod
+		bv, _ := NewBatchValidator(args)
+
+		isValid, err := bv.ValidateBatch(context.Background(), &clients.TransferBatch{ID: 1})
+		assert.True(t, isValid)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numRequests)
+	}
+
+	t.Run("default method", func(t *testing.T) {
+		t.Parallel()
+
+		testRequest("", http.MethodPost)
+	})
+	t.Run("POST", func(t *testing.T) {
+		t.Parallel()
+
+		testRequest(http.MethodPost, http.MethodPost)
+	})
+	t.Run("PUT", func(t *testing.T) {
+		t.Parallel()
+
+		testRequest(http.MethodPut, http.MethodPut)
+	})
+}
+
+func TestBatchValidator_Cancellation(t *testing.T) {
+	t.Parallel()
+
+	createSlowBodyServer := func(bodyStarted chan struct{}) *httptest.Server {
+		return httptest.NewServer(&testsCommon.HTTPHandlerStub{
+			ServeHTTPCalled: func(writer http.ResponseWriter, request *http.Request) {
+				writer.WriteHeader(http.StatusOK)
+				_, _ = writer.Write([]byte(`{"valid":`))
+				writer.(http.Flusher).Flush()
+				close(bodyStarted)
+
+				// the rest of the body is never sent, the request can only end on the client side
+				<-request.Context().Done()
+			},
+		})
+	}
+
+	t.Run("parent context cancelled during the body read should return promptly", func(t *testing.T) {
+		t.Parallel()
+
+		bodyStarted := make(chan struct{})
+		server := createSlowBodyServer(bodyStarted)
+		defer server.Close()
+
+		args := createMockArgsBatchValidator()
+		args.RequestURL = server.URL
+		args.RequestTime = time.Minute
+		args.MaxRetries = 3
+		args.RetryDelay = time.Minute
+		bv, _ := NewBatchValidator(args)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			<-bodyStarted
+			time.Sleep(time.Millisecond * 50)
+			cancel()
+		}()
+
+		start := time.Now()
+		isValid, err := bv.ValidateBatch(ctx, &clients.TransferBatch{ID: 1})
+		assert.False(t, isValid)
+		assert.True(t, errors.Is(err, errRequestCancelled))
+		assert.False(t, errors.Is(err, errRequestTimeout))
+		assert.Less(t, time.Since(start), time.Second*5)
+	})
+	t.Run("request timeout during the body read should return a timeout error", func(t *testing.T) {
+		t.Parallel()
+
+		bodyStarted := make(chan struct{})
+		server := createSlowBodyServer(bodyStarted)
+		defer server.Close()
+
+		args := createMockArgsBatchValidator()
+		args.RequestURL = server.URL
+		args.RequestTime = time.Millisecond * 200
+		bv, _ := NewBatchValidator(args)
+
+		isValid, err := bv.ValidateBatch(context.Background(), &clients.TransferBatch{ID: 1})
+		assert.False(t, isValid)
+		assert.True(t, errors.Is(err, errRequestTimeout))
+		assert.False(t, errors.Is(err, errRequestCancelled))
+	})
+	t.Run("already cancelled parent context should return a cancellation error", func(t *testing.T) {
+		t.Parallel()
+
+		bodyStarted := make(chan struct{})
+		server := createSlowBodyServer(bodyStarted)
+		defer server.Close()
+
+		args := createMockArgsBatchValidator()
+		args.RequestURL = server.URL
+		bv, _ := NewBatchValidator(args)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		isValid, err := bv.ValidateBatch(ctx, &clients.TransferBatch{ID: 1})
+		assert.False(t, isValid)
+		assert.True(t, errors.Is(err, errRequestCancelled))
+	})
 }
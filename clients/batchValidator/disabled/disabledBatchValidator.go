@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients/chain"
 )
 
 type disabledBatchValidator struct{}
@@ -18,6 +19,11 @@ func (dbv *disabledBatchValidator) ValidateBatch(_ context.Context, _ *clients.T
 	return true, nil
 }
 
+// ForDirection returns the same disabled batch validator instance, regardless of the direction
+func (dbv *disabledBatchValidator) ForDirection(_ chain.Chain, _ chain.Chain) (clients.BatchValidator, error) {
+	return dbv, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (dbv *disabledBatchValidator) IsInterfaceNil() bool {
 	return dbv == nil
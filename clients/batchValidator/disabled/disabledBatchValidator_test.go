@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients/chain"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/stretchr/testify/assert"
 )
@@ -16,4 +17,8 @@ func TestNewDisabledBatchValidator(t *testing.T) {
 	isValid, err := dbv.ValidateBatch(context.Background(), nil)
 	assert.True(t, isValid)
 	assert.Nil(t, err)
+
+	directional, err := dbv.ForDirection(chain.MultiversX, chain.Ethereum)
+	assert.Nil(t, err)
+	assert.True(t, directional == dbv)
 }
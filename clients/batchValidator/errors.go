@@ -0,0 +1,9 @@
+package batchValidatorManagement
+
+import "errors"
+
+var errMalformedDeposit = errors.New("malformed deposit")
+
+var errRequestCancelled = errors.New("batch validator request cancelled")
+
+var errRequestTimeout = errors.New("batch validator request timed out")
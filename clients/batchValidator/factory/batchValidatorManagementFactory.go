@@ -13,3 +13,11 @@ func CreateBatchValidator(args batchValidatorManagement.ArgsBatchValidator, enab
 	}
 	return disabled.NewDisabledBatchValidator(), nil
 }
+
+// CreateBidirectionalBatchValidator generates an implementation of BidirectionalBatchValidator
+func CreateBidirectionalBatchValidator(args batchValidatorManagement.ArgsBatchValidator, enabled bool) (batchValidatorManagement.BidirectionalBatchValidator, error) {
+	if enabled {
+		return batchValidatorManagement.NewBatchValidator(args)
+	}
+	return disabled.NewDisabledBatchValidator(), nil
+}
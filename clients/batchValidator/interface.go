@@ -1,8 +1,21 @@
 package batchValidatorManagement
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients/chain"
+)
 
 // HTTPClient is the interface we expect to call in order to do the HTTP requests
 type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
+
+// BidirectionalBatchValidator is a batch validator able to provide validators bound to each served direction
+type BidirectionalBatchValidator interface {
+	ValidateBatch(ctx context.Context, batch *clients.TransferBatch) (bool, error)
+	ForDirection(sourceChain chain.Chain, destinationChain chain.Chain) (clients.BatchValidator, error)
+	IsInterfaceNil() bool
+}
@@ -1,10 +1,12 @@
 package clients
 
 import (
+	"fmt"
 	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDepositTransfer_Clone(t *testing.T) {
@@ -46,6 +48,41 @@ func TestDepositTransfer_String(t *testing.T) {
 	assert.Equal(t, expectedString, dt.String())
 }
 
+func TestDepositTransfer_FormattedAmount(t *testing.T) {
+	t.Parallel()
+
+	oneEther, _ := big.NewInt(0).SetString("1000000000000000000", 10)
+	largeAmount, _ := big.NewInt(0).SetString("123456789012345678901234567890", 10)
+	testCases := []struct {
+		amount   *big.Int
+		decimals uint8
+		expected string
+	}{
+		{amount: nil, decimals: 18, expected: "0"},
+		{amount: big.NewInt(0), decimals: 0, expected: "0"},
+		{amount: big.NewInt(0), decimals: 18, expected: "0"},
+		{amount: big.NewInt(7463), decimals: 0, expected: "7463"},
+		{amount: big.NewInt(7463), decimals: 2, expected: "74.63"},
+		{amount: big.NewInt(7460), decimals: 2, expected: "74.6"},
+		{amount: big.NewInt(7400), decimals: 2, expected: "74"},
+		{amount: big.NewInt(7463), decimals: 4, expected: "0.7463"},
+		{amount: big.NewInt(7463), decimals: 6, expected: "0.007463"},
+		{amount: big.NewInt(1500000), decimals: 6, expected: "1.5"},
+		{amount: big.NewInt(1), decimals: 18, expected: "0.000000000000000001"},
+		{amount: oneEther, decimals: 18, expected: "1"},
+		{amount: largeAmount, decimals: 18, expected: "123456789012.34567890123456789"},
+		{amount: big.NewInt(-7463), decimals: 0, expected: "-7463"},
+		{amount: big.NewInt(-7463), decimals: 6, expected: "-0.007463"},
+	}
+
+	for _, tc := range testCases {
+		dt := &DepositTransfer{
+			Amount: tc.amount,
+		}
+		assert.Equal(t, tc.expected, dt.FormattedAmount(tc.decimals), "amount %v, decimals %d", tc.amount, tc.decimals)
+	}
+}
+
 func TestTransferBatch_Clone(t *testing.T) {
 	t.Parallel()
 
@@ -75,7 +112,9 @@ func TestTransferBatch_Clone(t *testing.T) {
 				ConvertedTokenBytes: []byte("converted token2"),
 			},
 		},
-		Statuses: []byte{Executed, Rejected},
+		Statuses:      []byte{Executed, Rejected},
+		BlockNumber:   8899,
+		CorrelationID: "a1b2c3",
 	}
 
 	cloned := tb.Clone()
@@ -121,6 +160,58 @@ Statuses: 0304`
 	assert.Equal(t, expectedString, tb.String())
 }
 
+func TestTransferBatch_Summary(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty batch", func(t *testing.T) {
+		t.Parallel()
+
+		tb := &TransferBatch{
+			ID: 2243,
+		}
+
+		assert.Equal(t, "Batch id 2243: 0 deposits, 0 tokens", tb.Summary())
+	})
+	t.Run("multi token batch", func(t *testing.T) {
+		t.Parallel()
+
+		tb := &TransferBatch{
+			ID: 2243,
+			Deposits: []*DepositTransfer{
+				{
+					Nonce:            1,
+					DisplayableToken: "tokenB",
+					Amount:           big.NewInt(3344),
+				},
+				{
+					Nonce:            2,
+					DisplayableToken: "tokenA",
+					Amount:           big.NewInt(5566),
+				},
+				{
+					Nonce:            3,
+					DisplayableToken: "tokenB",
+					Amount:           big.NewInt(1000),
+				},
+				{
+					Nonce:            4,
+					DisplayableToken: "tokenA",
+					Amount:           big.NewInt(4),
+				},
+				{
+					Nonce:            5,
+					DisplayableToken: "tokenC",
+				},
+			},
+			Statuses: make([]byte, 5),
+		}
+
+		expectedString := "Batch id 2243: 5 deposits, 3 tokens, tokenA: 5570, tokenB: 4344, tokenC: 0"
+		assert.Equal(t, expectedString, tb.Summary())
+		assert.Equal(t, big.NewInt(3344), tb.Deposits[0].Amount) // amounts should not be altered
+	})
+}
+
 func TestTransferBatch_ResolveNewDeposits(t *testing.T) {
 	t.Parallel()
 
@@ -162,3 +253,98 @@ func TestTransferBatch_ResolveNewDeposits(t *testing.T) {
 		assert.Equal(t, []byte{0, 0, Rejected}, workingBatch.Statuses)
 	})
 }
+
+func TestTransferBatch_SplitBatch(t *testing.T) {
+	t.Parallel()
+
+	createBatch := func(numDeposits int) *TransferBatch {
+		tb := &TransferBatch{
+			ID:          44,
+			Deposits:    make([]*DepositTransfer, 0, numDeposits),
+			Statuses:    make([]byte, 0, numDeposits),
+			BlockNumber: 5566,
+		}
+		for i := 0; i < numDeposits; i++ {
+			tb.Deposits = append(tb.Deposits, &DepositTransfer{
+				Nonce:               uint64(i + 1),
+				ToBytes:             []byte(fmt.Sprintf("to%d", i)),
+				FromBytes:           []byte(fmt.Sprintf("from%d", i)),
+				TokenBytes:          []byte("token"),
+				ConvertedTokenBytes: []byte("converted token"),
+				Amount:              big.NewInt(int64(i + 100)),
+			})
+			tb.Statuses = append(tb.Statuses, byte(i))
+		}
+
+		return tb
+	}
+	checkSubBatches := func(t *testing.T, tb *TransferBatch, subBatches []*TransferBatch, expectedSizes []int) {
+		require.Equal(t, len(expectedSizes), len(subBatches))
+
+		depositIndex := 0
+		for i, subBatch := range subBatches {
+			assert.Equal(t, tb.ID, subBatch.ID)
+			assert.Equal(t, tb.BlockNumber, subBatch.BlockNumber)
+			require.Equal(t, expectedSizes[i], len(subBatch.Deposits))
+			assert.Equal(t, tb.Statuses[depositIndex:depositIndex+expectedSizes[i]], subBatch.Statuses)
+			for _, dt := range subBatch.Deposits {
+				assert.Equal(t, tb.Deposits[depositIndex], dt)
+				assert.False(t, tb.Deposits[depositIndex] == dt) // pointer testing
+				depositIndex++
+			}
+		}
+		assert.Equal(t, len(tb.Deposits), depositIndex)
+	}
+
+	t.Run("even split", func(t *testing.T) {
+		t.Parallel()
+
+		tb := createBatch(6)
+		checkSubBatches(t, tb, tb.SplitBatch(2), []int{2, 2, 2})
+	})
+	t.Run("uneven split", func(t *testing.T) {
+		t.Parallel()
+
+		tb := createBatch(7)
+		checkSubBatches(t, tb, tb.SplitBatch(3), []int{3, 3, 1})
+	})
+	t.Run("batch fitting in the limit should return a clone", func(t *testing.T) {
+		t.Parallel()
+
+		tb := createBatch(3)
+		subBatches := tb.SplitBatch(3)
+		require.Equal(t, 1, len(subBatches))
+		assert.Equal(t, tb, subBatches[0])
+		assert.False(t, tb == subBatches[0]) // pointer testing
+	})
+	t.Run("single deposit batch", func(t *testing.T) {
+		t.Parallel()
+
+		tb := createBatch(1)
+		checkSubBatches(t, tb, tb.SplitBatch(1), []int{1})
+	})
+	t.Run("single deposit sub-batches", func(t *testing.T) {
+		t.Parallel()
+
+		tb := createBatch(3)
+		checkSubBatches(t, tb, tb.SplitBatch(1), []int{1, 1, 1})
+	})
+	t.Run("invalid max deposits should return a clone", func(t *testing.T) {
+		t.Parallel()
+
+		tb := createBatch(3)
+		subBatches := tb.SplitBatch(0)
+		require.Equal(t, 1, len(subBatches))
+		assert.Equal(t, tb, subBatches[0])
+	})
+	t.Run("missing statuses should not panic", func(t *testing.T) {
+		t.Parallel()
+
+		tb := createBatch(3)
+		tb.Statuses = tb.Statuses[:1]
+		subBatches := tb.SplitBatch(2)
+		require.Equal(t, 2, len(subBatches))
+		assert.Equal(t, []byte{0}, subBatches[0].Statuses)
+		assert.Empty(t, subBatches[1].Statuses)
+	})
+}
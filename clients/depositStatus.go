@@ -0,0 +1,39 @@
+package clients
+
+import "fmt"
+
+// DepositStatus is the typed representation of a deposit status byte
+type DepositStatus byte
+
+const (
+	// DepositStatusPending is the Pending status value
+	DepositStatusPending = DepositStatus(1)
+	// DepositStatusExecuted is the Executed with success status value
+	DepositStatusExecuted = DepositStatus(Executed)
+	// DepositStatusRejected is the Rejected status value
+	DepositStatusRejected = DepositStatus(Rejected)
+)
+
+// String returns the human-readable form of the deposit status
+func (status DepositStatus) String() string {
+	switch status {
+	case DepositStatusPending:
+		return "Pending"
+	case DepositStatusExecuted:
+		return "Executed"
+	case DepositStatusRejected:
+		return "Rejected"
+	default:
+		return fmt.Sprintf("Unknown(%d)", byte(status))
+	}
+}
+
+// DepositStatusesFromBytes converts the raw statuses bytes into typed deposit statuses
+func DepositStatusesFromBytes(statuses []byte) []DepositStatus {
+	results := make([]DepositStatus, 0, len(statuses))
+	for _, status := range statuses {
+		results = append(results, DepositStatus(status))
+	}
+
+	return results
+}
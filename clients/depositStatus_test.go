@@ -0,0 +1,34 @@
+package clients
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDepositStatus_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "Pending", DepositStatusPending.String())
+	assert.Equal(t, "Executed", DepositStatusExecuted.String())
+	assert.Equal(t, "Rejected", DepositStatusRejected.String())
+	assert.Equal(t, "Unknown(0)", DepositStatus(0).String())
+	assert.Equal(t, "Unknown(37)", DepositStatus(37).String())
+}
+
+func TestDepositStatusesFromBytes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil statuses should return empty slice", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, DepositStatusesFromBytes(nil))
+	})
+	t.Run("should map each status", func(t *testing.T) {
+		t.Parallel()
+
+		statuses := DepositStatusesFromBytes([]byte{Executed, Rejected, 1})
+		expected := []DepositStatus{DepositStatusExecuted, DepositStatusRejected, DepositStatusPending}
+		assert.Equal(t, expected, statuses)
+	})
+}
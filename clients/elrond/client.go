@@ -29,11 +29,14 @@ const (
 	signFuncName             = "sign"
 	performActionFuncName    = "performAction"
 	minAllowedDelta          = 1
+	quorumCacheDuration      = time.Minute
 
 	elrondDataGetterLogId = "ElrondEth-ElrondDataGetter"
 )
 
-// ClientArgs represents the argument for the NewClient constructor function
+// ClientArgs represents the argument for the NewClient constructor function. If EstimateGasUsingProxy is set, the
+// gas limits of the propose and perform transactions are estimated by the proxy, falling back on the GasMapConfig
+// values whenever the estimation fails
 type ClientArgs struct {
 	GasMapConfig                 config.ElrondGasMapConfig
 	Proxy                        ElrondProxy
@@ -45,6 +48,7 @@ type ClientArgs struct {
 	RoleProvider                 roleProvider
 	StatusHandler                bridgeCore.StatusHandler
 	AllowDelta                   uint64
+	EstimateGasUsingProxy        bool
 }
 
 // client represents the Elrond Client implementation
@@ -60,10 +64,16 @@ type client struct {
 	addressPublicKeyConverter bridgeCore.AddressConverter
 	statusHandler             bridgeCore.StatusHandler
 	allowDelta                uint64
+	estimateGasUsingProxy     bool
 
 	lastNonce                uint64
 	retriesAvailabilityCheck uint64
 	mut                      sync.RWMutex
+
+	mutQuorum           sync.Mutex
+	cachedQuorum        int
+	quorumFetchTime     time.Time
+	quorumCacheDuration time.Duration
 }
 
 // NewClient returns a new Elrond Client instance
@@ -122,6 +132,8 @@ func NewClient(args ClientArgs) (*client, error) {
 		tokensMapper:              args.TokensMapper,
 		statusHandler:             args.StatusHandler,
 		allowDelta:                args.AllowDelta,
+		estimateGasUsingProxy:     args.EstimateGasUsingProxy,
+		quorumCacheDuration:       quorumCacheDuration,
 	}
 
 	c.log.Info("NewElrondClient",
@@ -272,15 +284,13 @@ func (c *client) ProposeSetStatus(ctx context.Context, batch *clients.TransferBa
 		return "", err
 	}
 
-	txBuilder := c.createCommonTxDataBuilder(proposeSetStatusFuncName, int64(batch.ID))
-	for _, stat := range batch.Statuses {
-		txBuilder.ArgBytes([]byte{stat})
-	}
-
-	gasLimit := c.gasMapConfig.ProposeStatusBase + uint64(len(batch.Deposits))*c.gasMapConfig.ProposeStatusForEach
+	txBuilder := c.createProposeSetStatusTxDataBuilder(batch)
+	gasLimit := c.computeGasLimit(ctx, proposeSetStatusFuncName, txBuilder, c.proposeSetStatusStaticGas(batch))
 	hash, err := c.txHandler.SendTransactionReturnHash(ctx, txBuilder, gasLimit)
 	if err == nil {
-		c.log.Info("proposed set statuses"+batch.String(), "transaction hash", hash)
+		batchLog := bridgeCore.NewLoggerWithBatchID(ctx, c.log)
+		batchLog.Info("proposed set statuses "+batch.Summary(), "transaction hash", hash)
+		batchLog.Debug("proposed set statuses " + batch.String())
 	}
 
 	return hash, err
@@ -297,20 +307,13 @@ func (c *client) ProposeTransfer(ctx context.Context, batch *clients.TransferBat
 		return "", err
 	}
 
-	txBuilder := c.createCommonTxDataBuilder(proposeTransferFuncName, int64(batch.ID))
-
-	for _, dt := range batch.Deposits {
-		txBuilder.ArgBytes(dt.FromBytes).
-			ArgBytes(dt.ToBytes).
-			ArgBytes(dt.ConvertedTokenBytes).
-			ArgBigInt(dt.Amount).
-			ArgInt64(int64(dt.Nonce))
-	}
-
-	gasLimit := c.gasMapConfig.ProposeTransferBase + uint64(len(batch.Deposits))*c.gasMapConfig.ProposeTransferForEach
+	txBuilder := c.createProposeTransferTxDataBuilder(batch)
+	gasLimit := c.computeGasLimit(ctx, proposeTransferFuncName, txBuilder, c.proposeTransferStaticGas(batch))
 	hash, err := c.txHandler.SendTransactionReturnHash(ctx, txBuilder, gasLimit)
 	if err == nil {
-		c.log.Info("proposed transfer"+batch.String(), "transaction hash", hash)
+		batchLog := bridgeCore.NewLoggerWithBatchID(ctx, c.log)
+		batchLog.Info("proposed transfer "+batch.Summary(), "transaction hash", hash)
+		batchLog.Debug("proposed transfer " + batch.String())
 	}
 
 	return hash, err
@@ -327,7 +330,7 @@ func (c *client) Sign(ctx context.Context, actionID uint64) (string, error) {
 
 	hash, err := c.txHandler.SendTransactionReturnHash(ctx, txBuilder, c.gasMapConfig.Sign)
 	if err == nil {
-		c.log.Info("signed", "action ID", actionID, "transaction hash", hash)
+		bridgeCore.NewLoggerWithBatchID(ctx, c.log).Info("signed", "action ID", actionID, "transaction hash", hash)
 	}
 
 	return hash, err
@@ -345,17 +348,103 @@ func (c *client) PerformAction(ctx context.Context, actionID uint64, batch *clie
 	}
 
 	txBuilder := c.createCommonTxDataBuilder(performActionFuncName, int64(actionID))
-
-	gasLimit := c.gasMapConfig.PerformActionBase + uint64(len(batch.Statuses))*c.gasMapConfig.PerformActionForEach
+	gasLimit := c.computeGasLimit(ctx, performActionFuncName, txBuilder, c.performActionStaticGas(batch))
 	hash, err := c.txHandler.SendTransactionReturnHash(ctx, txBuilder, gasLimit)
 
 	if err == nil {
-		c.log.Info("performed action", "actionID", actionID, "transaction hash", hash)
+		bridgeCore.NewLoggerWithBatchID(ctx, c.log).Info("performed action", "actionID", actionID, "transaction hash", hash)
 	}
 
 	return hash, err
 }
 
+// EstimateActionGas returns the gas limit the provided action type needs for the given batch. The proxy estimation is
+// used if enabled and successful, otherwise the value is computed from the static gas map
+func (c *client) EstimateActionGas(ctx context.Context, actionType clients.ElrondActionType, batch *clients.TransferBatch) (uint64, error) {
+	if batch == nil {
+		return 0, clients.ErrNilBatch
+	}
+
+	switch actionType {
+	case clients.ProposeTransferAction:
+		txBuilder := c.createProposeTransferTxDataBuilder(batch)
+		return c.computeGasLimit(ctx, string(actionType), txBuilder, c.proposeTransferStaticGas(batch)), nil
+	case clients.ProposeSetStatusAction:
+		txBuilder := c.createProposeSetStatusTxDataBuilder(batch)
+		return c.computeGasLimit(ctx, string(actionType), txBuilder, c.proposeSetStatusStaticGas(batch)), nil
+	case clients.PerformTransferAction:
+		actionID, err := c.GetActionIDForProposeTransfer(ctx, batch)
+		if err != nil {
+			return 0, err
+		}
+		txBuilder := c.createCommonTxDataBuilder(performActionFuncName, int64(actionID))
+		return c.computeGasLimit(ctx, string(actionType), txBuilder, c.performActionStaticGas(batch)), nil
+	case clients.PerformSetStatusAction:
+		actionID, err := c.GetActionIDForSetStatusOnPendingTransfer(ctx, batch)
+		if err != nil {
+			return 0, err
+		}
+		txBuilder := c.createCommonTxDataBuilder(performActionFuncName, int64(actionID))
+		return c.computeGasLimit(ctx, string(actionType), txBuilder, c.performActionStaticGas(batch)), nil
+	default:
+		return 0, fmt.Errorf("%w for action type %q", clients.ErrInvalidValue, actionType)
+	}
+}
+
+// computeGasLimit returns the proxy estimation, if enabled, or the static gas limit if the estimation is disabled
+// or it failed
+func (c *client) computeGasLimit(ctx context.Context, operation string, txBuilder builders.TxDataBuilder, staticGasLimit uint64) uint64 {
+	if !c.estimateGasUsingProxy {
+		return staticGasLimit
+	}
+
+	estimatedGasLimit, err := c.txHandler.EstimateTransactionGas(ctx, txBuilder)
+	if err != nil {
+		bridgeCore.NewLoggerWithBatchID(ctx, c.log).Warn("gas estimation failed, using the gas map value",
+			"operation", operation, "gas limit", staticGasLimit, "error", err)
+		return staticGasLimit
+	}
+
+	bridgeCore.NewLoggerWithBatchID(ctx, c.log).Debug("estimated gas limit",
+		"operation", operation, "gas limit", estimatedGasLimit, "gas map value", staticGasLimit)
+
+	return estimatedGasLimit
+}
+
+func (c *client) createProposeTransferTxDataBuilder(batch *clients.TransferBatch) builders.TxDataBuilder {
+	txBuilder := c.createCommonTxDataBuilder(proposeTransferFuncName, int64(batch.ID))
+	for _, dt := range batch.Deposits {
+		txBuilder.ArgBytes(dt.FromBytes).
+			ArgBytes(dt.ToBytes).
+			ArgBytes(dt.ConvertedTokenBytes).
+			ArgBigInt(dt.Amount).
+			ArgInt64(int64(dt.Nonce))
+	}
+
+	return txBuilder
+}
+
+func (c *client) createProposeSetStatusTxDataBuilder(batch *clients.TransferBatch) builders.TxDataBuilder {
+	txBuilder := c.createCommonTxDataBuilder(proposeSetStatusFuncName, int64(batch.ID))
+	for _, stat := range batch.Statuses {
+		txBuilder.ArgBytes([]byte{stat})
+	}
+
+	return txBuilder
+}
+
+func (c *client) proposeTransferStaticGas(batch *clients.TransferBatch) uint64 {
+	return c.gasMapConfig.ProposeTransferBase + uint64(len(batch.Deposits))*c.gasMapConfig.ProposeTransferForEach
+}
+
+func (c *client) proposeSetStatusStaticGas(batch *clients.TransferBatch) uint64 {
+	return c.gasMapConfig.ProposeStatusBase + uint64(len(batch.Deposits))*c.gasMapConfig.ProposeStatusForEach
+}
+
+func (c *client) performActionStaticGas(batch *clients.TransferBatch) uint64 {
+	return c.gasMapConfig.PerformActionBase + uint64(len(batch.Statuses))*c.gasMapConfig.PerformActionForEach
+}
+
 func (c *client) checkIsPaused(ctx context.Context) error {
 	isPaused, err := c.IsPaused(ctx)
 	if err != nil {
@@ -392,7 +481,7 @@ func (c *client) CheckClientAvailability(ctx context.Context) error {
 		message := fmt.Sprintf("nonce %d fetched for %d times in a row", currentNonce, c.retriesAvailabilityCheck)
 		c.setStatusForAvailabilityCheck(ethElrond.Unavailable, message, currentNonce)
 
-		return nil
+		return fmt.Errorf("%w: %s", clients.ErrClientStalled, message)
 	}
 
 	c.setStatusForAvailabilityCheck(ethElrond.Available, "", currentNonce)
@@ -410,6 +499,33 @@ func (c *client) setStatusForAvailabilityCheck(status ethElrond.ClientStatus, me
 	c.statusHandler.SetIntMetric(bridgeCore.MetricLastBlockNonce, int(nonce))
 }
 
+// GetRelayers returns the public keys of the relayers currently registered in the multisig contract
+func (c *client) GetRelayers(ctx context.Context) ([][]byte, error) {
+	return c.GetAllStakedRelayers(ctx)
+}
+
+// GetQuorum returns the quorum set in the multisig contract. The value can change through governance so it is
+// read from the contract, but it is cached for quorumCacheDuration so callers can invoke this method on each
+// state machine step without querying the contract every time
+func (c *client) GetQuorum(ctx context.Context) (int, error) {
+	c.mutQuorum.Lock()
+	defer c.mutQuorum.Unlock()
+
+	if !c.quorumFetchTime.IsZero() && time.Since(c.quorumFetchTime) < c.quorumCacheDuration {
+		return c.cachedQuorum, nil
+	}
+
+	quorum, err := c.elrondClientDataGetter.GetQuorum(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	c.cachedQuorum = int(quorum)
+	c.quorumFetchTime = time.Now()
+
+	return c.cachedQuorum, nil
+}
+
 // Close will close any started go routines. It returns nil.
 func (c *client) Close() error {
 	return c.txHandler.Close()
@@ -0,0 +1,264 @@
+package elrond
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+const minCallTimeout = time.Millisecond
+
+// ArgsClientWithTimeout represents the argument for the NewClientWithTimeout constructor function
+type ArgsClientWithTimeout struct {
+	Client      ethElrond.ElrondClient
+	CallTimeout time.Duration
+}
+
+// clientWithTimeout is an Elrond client decorator that bounds each call with a timeout
+type clientWithTimeout struct {
+	client      ethElrond.ElrondClient
+	callTimeout time.Duration
+}
+
+// NewClientWithTimeout returns a new Elrond client decorator that applies the call timeout on each call
+func NewClientWithTimeout(args ArgsClientWithTimeout) (*clientWithTimeout, error) {
+	if check.IfNil(args.Client) {
+		return nil, ethElrond.ErrNilElrondClient
+	}
+	if args.CallTimeout < minCallTimeout {
+		return nil, fmt.Errorf("%w for CallTimeout: %v, minimum: %v", clients.ErrInvalidValue, args.CallTimeout, minCallTimeout)
+	}
+
+	return &clientWithTimeout{
+		client:      args.Client,
+		callTimeout: args.CallTimeout,
+	}, nil
+}
+
+func (c *clientWithTimeout) contextWithTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, c.callTimeout)
+}
+
+// convertError replaces the error with ErrCallTimeout if the call timeout expired while the parent context is still alive
+func (c *clientWithTimeout) convertError(parentCtx context.Context, callCtx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	if callCtx.Err() != context.DeadlineExceeded || parentCtx.Err() != nil {
+		return err
+	}
+
+	return fmt.Errorf("%w after %v: %s", ErrCallTimeout, c.callTimeout, err.Error())
+}
+
+// GetPending returns the pending batch
+func (c *clientWithTimeout) GetPending(ctx context.Context) (*clients.TransferBatch, error) {
+	callCtx, cancel := c.contextWithTimeout(ctx)
+	defer cancel()
+
+	batch, err := c.client.GetPending(callCtx)
+	return batch, c.convertError(ctx, callCtx, err)
+}
+
+// GetCurrentBatchAsDataBytes returns the current batch as data bytes
+func (c *clientWithTimeout) GetCurrentBatchAsDataBytes(ctx context.Context) ([][]byte, error) {
+	callCtx, cancel := c.contextWithTimeout(ctx)
+	defer cancel()
+
+	buff, err := c.client.GetCurrentBatchAsDataBytes(callCtx)
+	return buff, c.convertError(ctx, callCtx, err)
+}
+
+// WasProposedTransfer returns true if the transfer action proposed was triggered
+func (c *clientWithTimeout) WasProposedTransfer(ctx context.Context, batch *clients.TransferBatch) (bool, error) {
+	callCtx, cancel := c.contextWithTimeout(ctx)
+	defer cancel()
+
+	wasProposed, err := c.client.WasProposedTransfer(callCtx, batch)
+	return wasProposed, c.convertError(ctx, callCtx, err)
+}
+
+// QuorumReached returns true if the provided action ID reached the set quorum
+func (c *clientWithTimeout) QuorumReached(ctx context.Context, actionID uint64) (bool, error) {
+	callCtx, cancel := c.contextWithTimeout(ctx)
+	defer cancel()
+
+	reached, err := c.client.QuorumReached(callCtx, actionID)
+	return reached, c.convertError(ctx, callCtx, err)
+}
+
+// WasExecuted returns true if the provided actionID was executed or not
+func (c *clientWithTimeout) WasExecuted(ctx context.Context, actionID uint64) (bool, error) {
+	callCtx, cancel := c.contextWithTimeout(ctx)
+	defer cancel()
+
+	wasExecuted, err := c.client.WasExecuted(callCtx, actionID)
+	return wasExecuted, c.convertError(ctx, callCtx, err)
+}
+
+// GetActionIDForProposeTransfer returns the action ID for the proposed transfer operation
+func (c *clientWithTimeout) GetActionIDForProposeTransfer(ctx context.Context, batch *clients.TransferBatch) (uint64, error) {
+	callCtx, cancel := c.contextWithTimeout(ctx)
+	defer cancel()
+
+	actionID, err := c.client.GetActionIDForProposeTransfer(callCtx, batch)
+	return actionID, c.convertError(ctx, callCtx, err)
+}
+
+// WasProposedSetStatus returns true if the proposed set status was triggered
+func (c *clientWithTimeout) WasProposedSetStatus(ctx context.Context, batch *clients.TransferBatch) (bool, error) {
+	callCtx, cancel := c.contextWithTimeout(ctx)
+	defer cancel()
+
+	wasProposed, err := c.client.WasProposedSetStatus(callCtx, batch)
+	return wasProposed, c.convertError(ctx, callCtx, err)
+}
+
+// GetTransactionsStatuses returns the transactions statuses from the batch ID
+func (c *clientWithTimeout) GetTransactionsStatuses(ctx context.Context, batchID uint64) ([]byte, error) {
+	callCtx, cancel := c.contextWithTimeout(ctx)
+	defer cancel()
+
+	statuses, err := c.client.GetTransactionsStatuses(callCtx, batchID)
+	return statuses, c.convertError(ctx, callCtx, err)
+}
+
+// GetActionIDForSetStatusOnPendingTransfer returns the action ID for setting the status on the pending transfer batch
+func (c *clientWithTimeout) GetActionIDForSetStatusOnPendingTransfer(ctx context.Context, batch *clients.TransferBatch) (uint64, error) {
+	callCtx, cancel := c.contextWithTimeout(ctx)
+	defer cancel()
+
+	actionID, err := c.client.GetActionIDForSetStatusOnPendingTransfer(callCtx, batch)
+	return actionID, c.convertError(ctx, callCtx, err)
+}
+
+// GetLastExecutedEthBatchID returns the last executed Ethereum batch ID
+func (c *clientWithTimeout) GetLastExecutedEthBatchID(ctx context.Context) (uint64, error) {
+	callCtx, cancel := c.contextWithTimeout(ctx)
+	defer cancel()
+
+	batchID, err := c.client.GetLastExecutedEthBatchID(callCtx)
+	return batchID, c.convertError(ctx, callCtx, err)
+}
+
+// GetExecutedBatchIDs returns the Ethereum batch IDs from the provided range that were executed
+func (c *clientWithTimeout) GetExecutedBatchIDs(ctx context.Context, fromID uint64, toID uint64) ([]uint64, error) {
+	callCtx, cancel := c.contextWithTimeout(ctx)
+	defer cancel()
+
+	batchIDs, err := c.client.GetExecutedBatchIDs(callCtx, fromID, toID)
+	return batchIDs, c.convertError(ctx, callCtx, err)
+}
+
+// GetLastExecutedEthTxID returns the last executed Ethereum deposit ID
+func (c *clientWithTimeout) GetLastExecutedEthTxID(ctx context.Context) (uint64, error) {
+	callCtx, cancel := c.contextWithTimeout(ctx)
+	defer cancel()
+
+	txID, err := c.client.GetLastExecutedEthTxID(callCtx)
+	return txID, c.convertError(ctx, callCtx, err)
+}
+
+// GetCurrentNonce returns the current nonce of the Elrond chain
+func (c *clientWithTimeout) GetCurrentNonce(ctx context.Context) (uint64, error) {
+	callCtx, cancel := c.contextWithTimeout(ctx)
+	defer cancel()
+
+	nonce, err := c.client.GetCurrentNonce(callCtx)
+	return nonce, c.convertError(ctx, callCtx, err)
+}
+
+// GetQuorum returns the quorum set on the multisig contract
+func (c *clientWithTimeout) GetQuorum(ctx context.Context) (int, error) {
+	callCtx, cancel := c.contextWithTimeout(ctx)
+	defer cancel()
+
+	quorum, err := c.client.GetQuorum(callCtx)
+	return quorum, c.convertError(ctx, callCtx, err)
+}
+
+// GetRelayers returns the relayers whitelisted on the multisig contract
+func (c *clientWithTimeout) GetRelayers(ctx context.Context) ([][]byte, error) {
+	callCtx, cancel := c.contextWithTimeout(ctx)
+	defer cancel()
+
+	relayers, err := c.client.GetRelayers(callCtx)
+	return relayers, c.convertError(ctx, callCtx, err)
+}
+
+// ProposeSetStatus will trigger the proposal of the ESDT safe set current transaction batch status operation
+func (c *clientWithTimeout) ProposeSetStatus(ctx context.Context, batch *clients.TransferBatch) (string, error) {
+	callCtx, cancel := c.contextWithTimeout(ctx)
+	defer cancel()
+
+	hash, err := c.client.ProposeSetStatus(callCtx, batch)
+	return hash, c.convertError(ctx, callCtx, err)
+}
+
+// ProposeTransfer will trigger the propose transfer operation
+func (c *clientWithTimeout) ProposeTransfer(ctx context.Context, batch *clients.TransferBatch) (string, error) {
+	callCtx, cancel := c.contextWithTimeout(ctx)
+	defer cancel()
+
+	hash, err := c.client.ProposeTransfer(callCtx, batch)
+	return hash, c.convertError(ctx, callCtx, err)
+}
+
+// Sign will trigger the execution of a sign operation
+func (c *clientWithTimeout) Sign(ctx context.Context, actionID uint64) (string, error) {
+	callCtx, cancel := c.contextWithTimeout(ctx)
+	defer cancel()
+
+	hash, err := c.client.Sign(callCtx, actionID)
+	return hash, c.convertError(ctx, callCtx, err)
+}
+
+// WasSigned returns true if the action was already signed by the current relayer
+func (c *clientWithTimeout) WasSigned(ctx context.Context, actionID uint64) (bool, error) {
+	callCtx, cancel := c.contextWithTimeout(ctx)
+	defer cancel()
+
+	wasSigned, err := c.client.WasSigned(callCtx, actionID)
+	return wasSigned, c.convertError(ctx, callCtx, err)
+}
+
+// PerformAction will trigger the execution of the provided action ID
+func (c *clientWithTimeout) PerformAction(ctx context.Context, actionID uint64, batch *clients.TransferBatch) (string, error) {
+	callCtx, cancel := c.contextWithTimeout(ctx)
+	defer cancel()
+
+	hash, err := c.client.PerformAction(callCtx, actionID, batch)
+	return hash, c.convertError(ctx, callCtx, err)
+}
+
+// EstimateActionGas returns the gas limit needed by the provided action type for the given batch
+func (c *clientWithTimeout) EstimateActionGas(ctx context.Context, actionType clients.ElrondActionType, batch *clients.TransferBatch) (uint64, error) {
+	callCtx, cancel := c.contextWithTimeout(ctx)
+	defer cancel()
+
+	gasLimit, err := c.client.EstimateActionGas(callCtx, actionType, batch)
+	return gasLimit, c.convertError(ctx, callCtx, err)
+}
+
+// CheckClientAvailability will check the client availability and will set the metric accordingly
+func (c *clientWithTimeout) CheckClientAvailability(ctx context.Context) error {
+	callCtx, cancel := c.contextWithTimeout(ctx)
+	defer cancel()
+
+	err := c.client.CheckClientAvailability(callCtx)
+	return c.convertError(ctx, callCtx, err)
+}
+
+// Close closes the wrapped client
+func (c *clientWithTimeout) Close() error {
+	return c.client.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (c *clientWithTimeout) IsInterfaceNil() bool {
+	return c == nil
+}
@@ -0,0 +1,144 @@
+package elrond
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsClientWithTimeout() ArgsClientWithTimeout {
+	return ArgsClientWithTimeout{
+		Client:      &bridgeTests.ElrondClientStub{},
+		CallTimeout: time.Second,
+	}
+}
+
+func TestNewClientWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsClientWithTimeout()
+		args.Client = nil
+
+		c, err := NewClientWithTimeout(args)
+		assert.True(t, check.IfNil(c))
+		assert.Equal(t, ethElrond.ErrNilElrondClient, err)
+	})
+	t.Run("invalid call timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsClientWithTimeout()
+		args.CallTimeout = minCallTimeout - 1
+
+		c, err := NewClientWithTimeout(args)
+		assert.True(t, check.IfNil(c))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "CallTimeout"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := NewClientWithTimeout(createMockArgsClientWithTimeout())
+		assert.False(t, check.IfNil(c))
+		assert.Nil(t, err)
+	})
+}
+
+func TestClientWithTimeout_Calls(t *testing.T) {
+	t.Parallel()
+
+	t.Run("call finishing in time should return the wrapped values", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsClientWithTimeout()
+		args.Client = &bridgeTests.ElrondClientStub{
+			GetCurrentNonceCalled: func(ctx context.Context) (uint64, error) {
+				_, hasDeadline := ctx.Deadline()
+				assert.True(t, hasDeadline)
+
+				return 37, nil
+			},
+		}
+		c, _ := NewClientWithTimeout(args)
+
+		nonce, err := c.GetCurrentNonce(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(37), nonce)
+	})
+	t.Run("wrapped error should be returned as it is", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsClientWithTimeout()
+		args.Client = &bridgeTests.ElrondClientStub{
+			GetPendingCalled: func(ctx context.Context) (*clients.TransferBatch, error) {
+				return nil, expectedErr
+			},
+		}
+		c, _ := NewClientWithTimeout(args)
+
+		batch, err := c.GetPending(context.Background())
+		assert.Nil(t, batch)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("call exceeding the timeout should return ErrCallTimeout", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsClientWithTimeout()
+		args.CallTimeout = time.Millisecond * 10
+		args.Client = &bridgeTests.ElrondClientStub{
+			QuorumReachedCalled: func(ctx context.Context, actionID uint64) (bool, error) {
+				<-ctx.Done()
+				return false, ctx.Err()
+			},
+		}
+		c, _ := NewClientWithTimeout(args)
+
+		reached, err := c.QuorumReached(context.Background(), 1)
+		assert.False(t, reached)
+		assert.True(t, errors.Is(err, ErrCallTimeout))
+	})
+	t.Run("canceled parent context should not return ErrCallTimeout", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsClientWithTimeout()
+		args.Client = &bridgeTests.ElrondClientStub{
+			CheckClientAvailabilityCalled: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+		}
+		c, _ := NewClientWithTimeout(args)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := c.CheckClientAvailability(ctx)
+		assert.Equal(t, context.Canceled, err)
+	})
+	t.Run("Close should call the wrapped client", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockArgsClientWithTimeout()
+		args.Client = &bridgeTests.ElrondClientStub{
+			CloseCalled: func() error {
+				wasCalled = true
+				return nil
+			},
+		}
+		c, _ := NewClientWithTimeout(args)
+
+		assert.Nil(t, c.Close())
+		assert.True(t, wasCalled)
+	})
+}
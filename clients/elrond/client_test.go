@@ -9,6 +9,7 @@ import (
 	"math/big"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond"
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
@@ -720,6 +721,160 @@ func TestClient_PerformAction(t *testing.T) {
 	})
 }
 
+func TestClient_EstimateActionGas(t *testing.T) {
+	t.Parallel()
+
+	batch := createMockBatch()
+	actionID := uint64(662528)
+	createMockClientWithEstimation := func(estimatedGas uint64, estimationErr error, estimatedDataFields *[]string) *client {
+		args := createMockClientArgs()
+		args.Proxy = createMockProxy([][]byte{big.NewInt(int64(actionID)).Bytes()})
+		args.EstimateGasUsingProxy = true
+		c, _ := NewClient(args)
+		c.txHandler = &bridgeTests.TxHandlerStub{
+			EstimateTransactionGasCalled: func(ctx context.Context, builder builders.TxDataBuilder) (uint64, error) {
+				dataField, err := builder.ToDataString()
+				assert.Nil(t, err)
+				*estimatedDataFields = append(*estimatedDataFields, dataField)
+
+				return estimatedGas, estimationErr
+			},
+		}
+
+		return c
+	}
+
+	t.Run("nil batch", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := NewClient(createMockClientArgs())
+
+		gasLimit, err := c.EstimateActionGas(context.Background(), clients.ProposeTransferAction, nil)
+		assert.Zero(t, gasLimit)
+		assert.Equal(t, clients.ErrNilBatch, err)
+	})
+	t.Run("unknown action type", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := NewClient(createMockClientArgs())
+
+		gasLimit, err := c.EstimateActionGas(context.Background(), "unknown", batch)
+		assert.Zero(t, gasLimit)
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+	})
+	t.Run("estimation disabled should use the gas map without querying the proxy", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.Proxy = createMockProxy([][]byte{big.NewInt(int64(actionID)).Bytes()})
+		c, _ := NewClient(args)
+		c.txHandler = &bridgeTests.TxHandlerStub{
+			EstimateTransactionGasCalled: func(ctx context.Context, builder builders.TxDataBuilder) (uint64, error) {
+				assert.Fail(t, "should have not estimated the gas")
+				return 0, nil
+			},
+		}
+
+		expectedGasLimits := map[clients.ElrondActionType]uint64{
+			clients.ProposeTransferAction:  c.gasMapConfig.ProposeTransferBase + uint64(len(batch.Deposits))*c.gasMapConfig.ProposeTransferForEach,
+			clients.ProposeSetStatusAction: c.gasMapConfig.ProposeStatusBase + uint64(len(batch.Deposits))*c.gasMapConfig.ProposeStatusForEach,
+			clients.PerformTransferAction:  c.gasMapConfig.PerformActionBase + uint64(len(batch.Statuses))*c.gasMapConfig.PerformActionForEach,
+			clients.PerformSetStatusAction: c.gasMapConfig.PerformActionBase + uint64(len(batch.Statuses))*c.gasMapConfig.PerformActionForEach,
+		}
+		for actionType, expectedGasLimit := range expectedGasLimits {
+			gasLimit, err := c.EstimateActionGas(context.Background(), actionType, batch)
+			assert.Nil(t, err)
+			assert.Equal(t, expectedGasLimit, gasLimit, actionType)
+		}
+	})
+	t.Run("estimation enabled should return the proxy estimation", func(t *testing.T) {
+		t.Parallel()
+
+		estimatedDataFields := make([]string, 0)
+		c := createMockClientWithEstimation(123456, nil, &estimatedDataFields)
+
+		for _, actionType := range []clients.ElrondActionType{clients.ProposeSetStatusAction, clients.PerformTransferAction, clients.PerformSetStatusAction} {
+			gasLimit, err := c.EstimateActionGas(context.Background(), actionType, batch)
+			assert.Nil(t, err)
+			assert.Equal(t, uint64(123456), gasLimit, actionType)
+		}
+
+		performDataField := performActionFuncName + "@" + hex.EncodeToString(big.NewInt(int64(actionID)).Bytes())
+		expectedDataFields := []string{
+			strings.Join([]string{
+				proposeSetStatusFuncName,
+				hex.EncodeToString(big.NewInt(int64(batch.ID)).Bytes()),
+				hex.EncodeToString([]byte{clients.Rejected}),
+				hex.EncodeToString([]byte{clients.Executed}),
+			}, "@"),
+			performDataField,
+			performDataField,
+		}
+		assert.Equal(t, expectedDataFields, estimatedDataFields)
+	})
+	t.Run("estimation failure should fall back on the gas map", func(t *testing.T) {
+		t.Parallel()
+
+		estimatedDataFields := make([]string, 0)
+		c := createMockClientWithEstimation(0, errors.New("estimation error"), &estimatedDataFields)
+
+		gasLimit, err := c.EstimateActionGas(context.Background(), clients.ProposeTransferAction, batch)
+		assert.Nil(t, err)
+		expectedGasLimit := c.gasMapConfig.ProposeTransferBase + uint64(len(batch.Deposits))*c.gasMapConfig.ProposeTransferForEach
+		assert.Equal(t, expectedGasLimit, gasLimit)
+		assert.Equal(t, 1, len(estimatedDataFields))
+	})
+	t.Run("action ID fetch error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockClientArgs()
+		args.EstimateGasUsingProxy = true
+		args.Proxy = &interactors.ElrondProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				return nil, expectedErr
+			},
+		}
+		c, _ := NewClient(args)
+
+		gasLimit, err := c.EstimateActionGas(context.Background(), clients.PerformSetStatusAction, batch)
+		assert.Zero(t, gasLimit)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("propose and perform should send with the estimated gas limit", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.Proxy = createMockProxy(make([][]byte, 0))
+		args.EstimateGasUsingProxy = true
+		c, _ := NewClient(args)
+		numEstimations := 0
+		numSent := 0
+		c.txHandler = &bridgeTests.TxHandlerStub{
+			EstimateTransactionGasCalled: func(ctx context.Context, builder builders.TxDataBuilder) (uint64, error) {
+				numEstimations++
+				return 654321, nil
+			},
+			SendTransactionReturnHashCalled: func(ctx context.Context, builder builders.TxDataBuilder, gasLimit uint64) (string, error) {
+				numSent++
+				assert.Equal(t, uint64(654321), gasLimit)
+
+				return "hash", nil
+			},
+		}
+
+		_, err := c.ProposeTransfer(context.Background(), batch)
+		assert.Nil(t, err)
+		_, err = c.ProposeSetStatus(context.Background(), batch)
+		assert.Nil(t, err)
+		_, err = c.PerformAction(context.Background(), actionID, batch)
+		assert.Nil(t, err)
+
+		assert.Equal(t, 3, numSent)
+		assert.Equal(t, 3, numEstimations)
+	})
+}
+
 func TestClient_Close(t *testing.T) {
 	t.Parallel()
 
@@ -740,6 +895,101 @@ func TestClient_Close(t *testing.T) {
 	assert.True(t, closeCalled)
 }
 
+func TestClient_GetRelayers(t *testing.T) {
+	t.Parallel()
+
+	providedRelayers := [][]byte{bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{2}, 32)}
+	args := createMockClientArgs()
+	args.Proxy = &interactors.ElrondProxyStub{
+		ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+			assert.Equal(t, getAllStakedRelayersFuncName, vmRequest.FuncName)
+
+			return &data.VmValuesResponseData{
+				Data: &vm.VMOutputApi{
+					ReturnCode: okCodeAfterExecution,
+					ReturnData: providedRelayers,
+				},
+			}, nil
+		},
+	}
+	c, _ := NewClient(args)
+
+	relayers, err := c.GetRelayers(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, providedRelayers, relayers)
+}
+
+func TestClient_GetQuorum(t *testing.T) {
+	t.Parallel()
+
+	createArgs := func(numCalls *int, quorum *int, err error) ClientArgs {
+		args := createMockClientArgs()
+		args.Proxy = &interactors.ElrondProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				*numCalls++
+				assert.Equal(t, getQuorumFuncName, vmRequest.FuncName)
+
+				return &data.VmValuesResponseData{
+					Data: &vm.VMOutputApi{
+						ReturnCode: okCodeAfterExecution,
+						ReturnData: [][]byte{big.NewInt(int64(*quorum)).Bytes()},
+					},
+				}, err
+			},
+		}
+
+		return args
+	}
+
+	t.Run("query errors", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		numCalls := 0
+		quorum := 3
+		c, _ := NewClient(createArgs(&numCalls, &quorum, expectedErr))
+
+		result, err := c.GetQuorum(context.Background())
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.Equal(t, 0, result)
+	})
+	t.Run("should cache the quorum", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		quorum := 3
+		c, _ := NewClient(createArgs(&numCalls, &quorum, nil))
+
+		result, err := c.GetQuorum(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 3, result)
+
+		quorum = 4
+		result, err = c.GetQuorum(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 3, result)
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("should refresh the quorum after the cache expired", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		quorum := 3
+		c, _ := NewClient(createArgs(&numCalls, &quorum, nil))
+		c.quorumCacheDuration = time.Millisecond
+
+		result, _ := c.GetQuorum(context.Background())
+		assert.Equal(t, 3, result)
+
+		quorum = 4
+		time.Sleep(time.Millisecond * 5)
+		result, err := c.GetQuorum(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 4, result)
+		assert.Equal(t, 2, numCalls)
+	})
+}
+
 func TestClient_CheckClientAvailability(t *testing.T) {
 	t.Parallel()
 
@@ -790,7 +1040,8 @@ func TestClient_CheckClientAvailability(t *testing.T) {
 		for i := 0; i < 10; i++ {
 			message := fmt.Sprintf("nonce %d fetched for %d times in a row", currentNonce, args.AllowDelta+uint64(i+1))
 			err := c.CheckClientAvailability(context.Background())
-			assert.Nil(t, err)
+			assert.True(t, errors.Is(err, clients.ErrClientStalled))
+			assert.True(t, strings.Contains(err.Error(), message))
 			checkStatusHandler(t, statusHandler, ethElrond.Unavailable, message)
 		}
 	})
@@ -810,7 +1061,8 @@ func TestClient_CheckClientAvailability(t *testing.T) {
 		for i := 0; i < 10; i++ {
 			message := fmt.Sprintf("nonce %d fetched for %d times in a row", currentNonce, args.AllowDelta+uint64(i+1))
 			err := c.CheckClientAvailability(context.Background())
-			assert.Nil(t, err)
+			assert.True(t, errors.Is(err, clients.ErrClientStalled))
+			assert.True(t, strings.Contains(err.Error(), message))
 			checkStatusHandler(t, statusHandler, ethElrond.Unavailable, message)
 		}
 
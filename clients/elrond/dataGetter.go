@@ -33,8 +33,12 @@ const (
 	signedFuncName                                            = "signed"
 	getAllStakedRelayersFuncName                              = "getAllStakedRelayers"
 	isPausedFuncName                                          = "isPaused"
+	getQuorumFuncName                                         = "getQuorum"
 )
 
+// maxExecutedBatchIDsRange limits the number of batch IDs that can be requested at once
+const maxExecutedBatchIDsRange = 10000
+
 // ArgsDataGetter is the arguments DTO used in the NewDataGetter constructor
 type ArgsDataGetter struct {
 	MultisigContractAddress core.AddressHandler
@@ -380,6 +384,30 @@ func (dg *elrondClientDataGetter) GetLastExecutedEthBatchID(ctx context.Context)
 	return dg.executeQueryUint64FromBuilder(ctx, builder)
 }
 
+// GetExecutedBatchIDs returns the Ethereum batch IDs from the [fromID, toID] range that were executed on Elrond.
+// As the Ethereum batches are executed in order, all the IDs up to the last executed batch ID are executed so a
+// single query is needed for the whole range
+func (dg *elrondClientDataGetter) GetExecutedBatchIDs(ctx context.Context, fromID uint64, toID uint64) ([]uint64, error) {
+	if fromID > toID {
+		return nil, fmt.Errorf("%w, from: %d, to: %d", errInvalidBatchIDsRange, fromID, toID)
+	}
+	if toID-fromID >= maxExecutedBatchIDsRange {
+		return nil, fmt.Errorf("%w, from: %d, to: %d, maximum range: %d", errInvalidBatchIDsRange, fromID, toID, maxExecutedBatchIDsRange)
+	}
+
+	lastExecutedBatchID, err := dg.GetLastExecutedEthBatchID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	executedBatchIDs := make([]uint64, 0)
+	for batchID := fromID; batchID <= toID && batchID <= lastExecutedBatchID; batchID++ {
+		executedBatchIDs = append(executedBatchIDs, batchID)
+	}
+
+	return executedBatchIDs, nil
+}
+
 // GetLastExecutedEthTxID returns the last executed Ethereum deposit ID
 func (dg *elrondClientDataGetter) GetLastExecutedEthTxID(ctx context.Context) (uint64, error) {
 	builder := dg.createDefaultVmQueryBuilder().Function(getLastExecutedEthTxId)
@@ -411,6 +439,14 @@ func (dg *elrondClientDataGetter) IsPaused(ctx context.Context) (bool, error) {
 	return dg.executeQueryBoolFromBuilder(ctx, builder)
 }
 
+// GetQuorum returns the quorum set in the multisig contract
+func (dg *elrondClientDataGetter) GetQuorum(ctx context.Context) (uint64, error) {
+	builder := dg.createDefaultVmQueryBuilder()
+	builder.Function(getQuorumFuncName)
+
+	return dg.executeQueryUint64FromBuilder(ctx, builder)
+}
+
 func getStatusFromBuff(buff []byte) (byte, error) {
 	if len(buff) == 0 {
 		return 0, errMalformedBatchResponse
@@ -973,6 +973,82 @@ func TestDataGetter_GetLastExecutedEthBatchID(t *testing.T) {
 	assert.Equal(t, val.Uint64(), result)
 }
 
+func TestDataGetter_GetExecutedBatchIDs(t *testing.T) {
+	t.Parallel()
+
+	createDataGetterWithLastExecutedBatchID := func(lastExecutedBatchID uint64, proxyCalled *bool) *elrondClientDataGetter {
+		args := createMockArgsDataGetter()
+		args.Proxy = &interactors.ElrondProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				*proxyCalled = true
+				assert.Equal(t, getLastExecutedEthBatchIdFuncName, vmRequest.FuncName)
+
+				return &data.VmValuesResponseData{
+					Data: &vm.VMOutputApi{
+						ReturnCode: okCodeAfterExecution,
+						ReturnData: [][]byte{big.NewInt(int64(lastExecutedBatchID)).Bytes()},
+					},
+				}, nil
+			},
+		}
+		dg, _ := NewDataGetter(args)
+
+		return dg
+	}
+
+	t.Run("invalid range should error", func(t *testing.T) {
+		t.Parallel()
+
+		proxyCalled := false
+		dg := createDataGetterWithLastExecutedBatchID(10, &proxyCalled)
+
+		result, err := dg.GetExecutedBatchIDs(context.Background(), 5, 4)
+		assert.True(t, errors.Is(err, errInvalidBatchIDsRange))
+		assert.Nil(t, result)
+		assert.False(t, proxyCalled)
+
+		result, err = dg.GetExecutedBatchIDs(context.Background(), 1, maxExecutedBatchIDsRange+1)
+		assert.True(t, errors.Is(err, errInvalidBatchIDsRange))
+		assert.Nil(t, result)
+		assert.False(t, proxyCalled)
+	})
+	t.Run("query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsDataGetter()
+		args.Proxy = &interactors.ElrondProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				return nil, expectedErr
+			},
+		}
+		dg, _ := NewDataGetter(args)
+
+		result, err := dg.GetExecutedBatchIDs(context.Background(), 1, 10)
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, result)
+	})
+	t.Run("should return the executed batch IDs from the range", func(t *testing.T) {
+		t.Parallel()
+
+		proxyCalled := false
+		dg := createDataGetterWithLastExecutedBatchID(7, &proxyCalled)
+
+		result, err := dg.GetExecutedBatchIDs(context.Background(), 5, 10)
+		assert.Nil(t, err)
+		assert.True(t, proxyCalled)
+		assert.Equal(t, []uint64{5, 6, 7}, result)
+
+		result, err = dg.GetExecutedBatchIDs(context.Background(), 2, 4)
+		assert.Nil(t, err)
+		assert.Equal(t, []uint64{2, 3, 4}, result)
+
+		result, err = dg.GetExecutedBatchIDs(context.Background(), 8, 10)
+		assert.Nil(t, err)
+		assert.Empty(t, result)
+	})
+}
+
 func TestDataGetter_GetLastExecutedEthTxID(t *testing.T) {
 	t.Parallel()
 
@@ -1072,6 +1148,34 @@ func TestDataGetter_GetAllStakedRelayers(t *testing.T) {
 	assert.Equal(t, providedRelayers, result)
 }
 
+func TestDataGetter_GetQuorum(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsDataGetter()
+	val := big.NewInt(7)
+	args.Proxy = &interactors.ElrondProxyStub{
+		ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+			assert.Equal(t, args.RelayerAddress.AddressAsBech32String(), vmRequest.CallerAddr)
+			assert.Equal(t, args.MultisigContractAddress.AddressAsBech32String(), vmRequest.Address)
+			assert.Equal(t, getQuorumFuncName, vmRequest.FuncName)
+			assert.Nil(t, vmRequest.Args)
+
+			return &data.VmValuesResponseData{
+				Data: &vm.VMOutputApi{
+					ReturnCode: okCodeAfterExecution,
+					ReturnData: [][]byte{val.Bytes()},
+				},
+			}, nil
+		},
+	}
+
+	dg, _ := NewDataGetter(args)
+
+	result, err := dg.GetQuorum(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, val.Uint64(), result)
+}
+
 func TestElrondClientDataGetter_GetShardCurrentNonce(t *testing.T) {
 	t.Parallel()
 
@@ -16,7 +16,12 @@ var (
 	errNilRoleProvider          = errors.New("nil role provider")
 	errRelayerNotWhitelisted    = errors.New("relayer not whitelisted")
 	errNilNodeStatusResponse    = errors.New("nil node status response")
+	errInvalidBatchIDsRange     = errors.New("invalid batch IDs range")
+	errGasEstimationFailed      = errors.New("gas estimation failed")
 
 	// ErrNoPendingBatchAvailable signals that no pending batch is available
 	ErrNoPendingBatchAvailable = errors.New("no pending batch available")
+
+	// ErrCallTimeout signals that an Elrond client call did not finish in the allotted time
+	ErrCallTimeout = errors.New("elrond client call timeout")
 )
@@ -17,6 +17,7 @@ type ElrondProxy interface {
 	GetAccount(ctx context.Context, address core.AddressHandler) (*data.Account, error)
 	GetNetworkStatus(ctx context.Context, shardID uint32) (*data.NetworkStatus, error)
 	GetShardOfAddress(ctx context.Context, bech32Address string) (uint32, error)
+	RequestTransactionCost(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error)
 	IsInterfaceNil() bool
 }
 
@@ -30,11 +31,13 @@ type NonceTransactionsHandler interface {
 // TokensMapper can convert a token bytes from one chain to another
 type TokensMapper interface {
 	ConvertToken(ctx context.Context, sourceBytes []byte) ([]byte, error)
+	ConvertTokenReverse(ctx context.Context, convertedBytes []byte) ([]byte, error)
 	IsInterfaceNil() bool
 }
 
 type txHandler interface {
 	SendTransactionReturnHash(ctx context.Context, builder builders.TxDataBuilder, gasLimit uint64) (string, error)
+	EstimateTransactionGas(ctx context.Context, builder builders.TxDataBuilder) (uint64, error)
 	Close() error
 }
 
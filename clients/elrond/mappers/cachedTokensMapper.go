@@ -0,0 +1,168 @@
+package mappers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+const minCacheTTL = time.Second
+const minCacheMaxSize = 1
+
+// ArgsCachedTokensMapper is the DTO used to create a new cached tokens mapper instance
+type ArgsCachedTokensMapper struct {
+	Mapper  TokensMapper
+	TTL     time.Duration
+	MaxSize int
+}
+
+type cachedToken struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// tokensCache holds the conversions of one direction
+type tokensCache struct {
+	values map[string]*cachedToken
+}
+
+type cachedTokensMapper struct {
+	mapper  TokensMapper
+	ttl     time.Duration
+	maxSize int
+	timeNow func() time.Time
+
+	mut          sync.Mutex
+	directCache  *tokensCache
+	reverseCache *tokensCache
+}
+
+// NewCachedTokensMapper returns a tokens mapper that wraps the provided mapper and serves the conversions from memory
+// for the provided TTL
+func NewCachedTokensMapper(args ArgsCachedTokensMapper) (*cachedTokensMapper, error) {
+	err := checkArgsCachedTokensMapper(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cachedTokensMapper{
+		mapper:       args.Mapper,
+		ttl:          args.TTL,
+		maxSize:      args.MaxSize,
+		timeNow:      time.Now,
+		directCache:  newTokensCache(),
+		reverseCache: newTokensCache(),
+	}, nil
+}
+
+func checkArgsCachedTokensMapper(args ArgsCachedTokensMapper) error {
+	if check.IfNil(args.Mapper) {
+		return errNilTokensMapper
+	}
+	if args.TTL < minCacheTTL {
+		return fmt.Errorf("%w for TTL, minimum: %v, got: %v", clients.ErrInvalidValue, minCacheTTL, args.TTL)
+	}
+	if args.MaxSize < minCacheMaxSize {
+		return fmt.Errorf("%w for MaxSize, minimum: %d, got: %d", clients.ErrInvalidValue, minCacheMaxSize, args.MaxSize)
+	}
+
+	return nil
+}
+
+// ConvertToken returns the cached conversion of the provided token or asks the wrapped mapper if the conversion
+// is not cached or expired
+func (mapper *cachedTokensMapper) ConvertToken(ctx context.Context, sourceBytes []byte) ([]byte, error) {
+	return mapper.convert(ctx, mapper.directCache, mapper.mapper.ConvertToken, sourceBytes)
+}
+
+// ConvertTokenReverse returns the cached reverse conversion of the provided token or asks the wrapped mapper if the
+// conversion is not cached or expired
+func (mapper *cachedTokensMapper) ConvertTokenReverse(ctx context.Context, convertedBytes []byte) ([]byte, error) {
+	return mapper.convert(ctx, mapper.reverseCache, mapper.mapper.ConvertTokenReverse, convertedBytes)
+}
+
+func (mapper *cachedTokensMapper) convert(
+	ctx context.Context,
+	cache *tokensCache,
+	converter func(ctx context.Context, value []byte) ([]byte, error),
+	value []byte,
+) ([]byte, error) {
+	mapper.mut.Lock()
+	result, found := cache.get(value, mapper.timeNow())
+	mapper.mut.Unlock()
+	if found {
+		return result, nil
+	}
+
+	result, err := converter(ctx, value)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper.mut.Lock()
+	cache.put(value, result, mapper.timeNow(), mapper.ttl, mapper.maxSize)
+	mapper.mut.Unlock()
+
+	return result, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mapper *cachedTokensMapper) IsInterfaceNil() bool {
+	return mapper == nil
+}
+
+func newTokensCache() *tokensCache {
+	return &tokensCache{
+		values: make(map[string]*cachedToken),
+	}
+}
+
+func (cache *tokensCache) get(key []byte, now time.Time) ([]byte, bool) {
+	token, found := cache.values[string(key)]
+	if !found {
+		return nil, false
+	}
+	if !now.Before(token.expiresAt) {
+		delete(cache.values, string(key))
+		return nil, false
+	}
+
+	return token.value, true
+}
+
+func (cache *tokensCache) put(key []byte, value []byte, now time.Time, ttl time.Duration, maxSize int) {
+	_, exists := cache.values[string(key)]
+	if !exists && len(cache.values) >= maxSize {
+		cache.evict(now)
+	}
+
+	cache.values[string(key)] = &cachedToken{
+		value:     value,
+		expiresAt: now.Add(ttl),
+	}
+}
+
+// evict removes the expired entries. If none expired, the entry closest to its expiry is removed
+func (cache *tokensCache) evict(now time.Time) {
+	numValues := len(cache.values)
+	oldestKey := ""
+	oldestExpiry := time.Time{}
+	for key, token := range cache.values {
+		if !now.Before(token.expiresAt) {
+			delete(cache.values, key)
+			continue
+		}
+		if oldestExpiry.IsZero() || token.expiresAt.Before(oldestExpiry) {
+			oldestKey = key
+			oldestExpiry = token.expiresAt
+		}
+	}
+
+	if len(cache.values) == numValues {
+		delete(cache.values, oldestKey)
+	}
+}
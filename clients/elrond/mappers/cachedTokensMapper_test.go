@@ -0,0 +1,162 @@
+package mappers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsCachedTokensMapper() ArgsCachedTokensMapper {
+	return ArgsCachedTokensMapper{
+		Mapper:  &bridgeTests.TokensMapperStub{},
+		TTL:     time.Minute,
+		MaxSize: 2,
+	}
+}
+
+func TestNewCachedTokensMapper(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil mapper should error", func(t *testing.T) {
+		args := createMockArgsCachedTokensMapper()
+		args.Mapper = nil
+
+		mapper, err := NewCachedTokensMapper(args)
+		assert.True(t, check.IfNil(mapper))
+		assert.Equal(t, errNilTokensMapper, err)
+	})
+	t.Run("invalid TTL should error", func(t *testing.T) {
+		args := createMockArgsCachedTokensMapper()
+		args.TTL = minCacheTTL - time.Nanosecond
+
+		mapper, err := NewCachedTokensMapper(args)
+		assert.True(t, check.IfNil(mapper))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "TTL"))
+	})
+	t.Run("invalid max size should error", func(t *testing.T) {
+		args := createMockArgsCachedTokensMapper()
+		args.MaxSize = 0
+
+		mapper, err := NewCachedTokensMapper(args)
+		assert.True(t, check.IfNil(mapper))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "MaxSize"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		mapper, err := NewCachedTokensMapper(createMockArgsCachedTokensMapper())
+		assert.False(t, check.IfNil(mapper))
+		assert.Nil(t, err)
+	})
+}
+
+func TestCachedTokensMapper_ConvertToken(t *testing.T) {
+	t.Parallel()
+
+	createMapper := func(numCalls map[string]int) (*cachedTokensMapper, *time.Time) {
+		args := createMockArgsCachedTokensMapper()
+		args.Mapper = &bridgeTests.TokensMapperStub{
+			ConvertTokenCalled: func(ctx context.Context, sourceBytes []byte) ([]byte, error) {
+				numCalls[string(sourceBytes)]++
+				if string(sourceBytes) == "unknown" {
+					return nil, errUnknownToken
+				}
+
+				return append([]byte("converted "), sourceBytes...), nil
+			},
+			ConvertTokenReverseCalled: func(ctx context.Context, convertedBytes []byte) ([]byte, error) {
+				numCalls["reverse "+string(convertedBytes)]++
+				return []byte("source"), nil
+			},
+		}
+		mapper, err := NewCachedTokensMapper(args)
+		require.Nil(t, err)
+
+		now := time.Unix(1000, 0)
+		mapper.timeNow = func() time.Time {
+			return now
+		}
+
+		return mapper, &now
+	}
+
+	t.Run("repeated conversions should be served from cache", func(t *testing.T) {
+		numCalls := make(map[string]int)
+		mapper, _ := createMapper(numCalls)
+
+		for i := 0; i < 3; i++ {
+			converted, err := mapper.ConvertToken(context.Background(), []byte("token"))
+			assert.Nil(t, err)
+			assert.Equal(t, []byte("converted token"), converted)
+		}
+		assert.Equal(t, 1, numCalls["token"])
+
+		for i := 0; i < 3; i++ {
+			source, err := mapper.ConvertTokenReverse(context.Background(), []byte("token"))
+			assert.Nil(t, err)
+			assert.Equal(t, []byte("source"), source)
+		}
+		assert.Equal(t, 1, numCalls["reverse token"])
+	})
+	t.Run("errors should not be cached", func(t *testing.T) {
+		numCalls := make(map[string]int)
+		mapper, _ := createMapper(numCalls)
+
+		for i := 0; i < 2; i++ {
+			converted, err := mapper.ConvertToken(context.Background(), []byte("unknown"))
+			assert.Equal(t, errUnknownToken, err)
+			assert.Nil(t, converted)
+		}
+		assert.Equal(t, 2, numCalls["unknown"])
+	})
+	t.Run("expired conversions should be fetched again", func(t *testing.T) {
+		numCalls := make(map[string]int)
+		mapper, now := createMapper(numCalls)
+
+		_, _ = mapper.ConvertToken(context.Background(), []byte("token"))
+		*now = now.Add(mapper.ttl - time.Nanosecond)
+		_, _ = mapper.ConvertToken(context.Background(), []byte("token"))
+		assert.Equal(t, 1, numCalls["token"])
+
+		*now = now.Add(time.Nanosecond)
+		_, _ = mapper.ConvertToken(context.Background(), []byte("token"))
+		assert.Equal(t, 2, numCalls["token"])
+	})
+	t.Run("full cache should evict the oldest conversion", func(t *testing.T) {
+		numCalls := make(map[string]int)
+		mapper, now := createMapper(numCalls)
+
+		_, _ = mapper.ConvertToken(context.Background(), []byte("token1"))
+		*now = now.Add(time.Second)
+		_, _ = mapper.ConvertToken(context.Background(), []byte("token2"))
+		*now = now.Add(time.Second)
+		_, _ = mapper.ConvertToken(context.Background(), []byte("token3"))
+		assert.Equal(t, 2, len(mapper.directCache.values))
+
+		_, _ = mapper.ConvertToken(context.Background(), []byte("token2"))
+		_, _ = mapper.ConvertToken(context.Background(), []byte("token3"))
+		assert.Equal(t, 1, numCalls["token2"])
+		assert.Equal(t, 1, numCalls["token3"])
+
+		_, _ = mapper.ConvertToken(context.Background(), []byte("token1"))
+		assert.Equal(t, 2, numCalls["token1"])
+	})
+	t.Run("full cache should evict the expired conversions first", func(t *testing.T) {
+		numCalls := make(map[string]int)
+		mapper, now := createMapper(numCalls)
+
+		_, _ = mapper.ConvertToken(context.Background(), []byte("token1"))
+		_, _ = mapper.ConvertToken(context.Background(), []byte("token2"))
+		*now = now.Add(mapper.ttl)
+		_, _ = mapper.ConvertToken(context.Background(), []byte("token3"))
+		assert.Equal(t, 1, len(mapper.directCache.values))
+	})
+}
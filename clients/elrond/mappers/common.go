@@ -0,0 +1,20 @@
+package mappers
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+)
+
+func getFirstToken(ctx context.Context, getter func(ctx context.Context, value []byte) ([][]byte, error), value []byte) ([]byte, error) {
+	response, err := getter(ctx, value)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(response) == 0 {
+		return nil, fmt.Errorf("%w for provided %s", errUnknownToken, hex.EncodeToString(value))
+	}
+
+	return response[0], nil
+}
@@ -2,15 +2,14 @@ package mappers
 
 import (
 	"context"
-	"encoding/hex"
-	"fmt"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 )
 
 type elrondToErc20 struct {
-	dg DataGetter
+	dg             DataGetter
+	reverseMapping *reverseMapping
 }
 
 // NewElrondToErc20Mapper returns a new instance of erc20ToElrond
@@ -20,23 +19,39 @@ func NewElrondToErc20Mapper(dg DataGetter) (*elrondToErc20, error) {
 	}
 
 	return &elrondToErc20{
-		dg: dg,
+		dg:             dg,
+		reverseMapping: newReverseMapping(),
 	}, nil
 }
 
-// ConvertToken will return erd token id given a specific erc20 address
+// ConvertToken will return erc20 address given a specific erd token id
 func (mapper *elrondToErc20) ConvertToken(ctx context.Context, sourceBytes []byte) ([]byte, error) {
-
-	response, err := mapper.dg.GetERC20AddressForTokenId(ctx, sourceBytes)
+	convertedBytes, err := getFirstToken(ctx, mapper.dg.GetERC20AddressForTokenId, sourceBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(response) == 0 {
-		return nil, fmt.Errorf("%w for provided %s", errUnknownToken, hex.EncodeToString(sourceBytes))
+	mapper.reverseMapping.put(sourceBytes, convertedBytes)
+
+	return convertedBytes, nil
+}
+
+// ConvertTokenReverse will return erd token id given a specific erc20 address. The conversions already resolved by ConvertToken
+// are served without querying the data getter
+func (mapper *elrondToErc20) ConvertTokenReverse(ctx context.Context, convertedBytes []byte) ([]byte, error) {
+	sourceBytes, found := mapper.reverseMapping.get(convertedBytes)
+	if found {
+		return sourceBytes, nil
 	}
 
-	return response[0], nil
+	sourceBytes, err := getFirstToken(ctx, mapper.dg.GetTokenIdForErc20Address, convertedBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper.reverseMapping.put(sourceBytes, convertedBytes)
+
+	return sourceBytes, nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
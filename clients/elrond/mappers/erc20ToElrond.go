@@ -2,15 +2,14 @@ package mappers
 
 import (
 	"context"
-	"encoding/hex"
-	"fmt"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 )
 
 type erc20ToElrond struct {
-	dg DataGetter
+	dg             DataGetter
+	reverseMapping *reverseMapping
 }
 
 // NewErc20ToElrondMapper returns a new instance of erc20ToElrond
@@ -20,23 +19,39 @@ func NewErc20ToElrondMapper(dg DataGetter) (*erc20ToElrond, error) {
 	}
 
 	return &erc20ToElrond{
-		dg: dg,
+		dg:             dg,
+		reverseMapping: newReverseMapping(),
 	}, nil
 }
 
 // ConvertToken will return erd token id given a specific erc20 address
 func (mapper *erc20ToElrond) ConvertToken(ctx context.Context, sourceBytes []byte) ([]byte, error) {
-
-	response, err := mapper.dg.GetTokenIdForErc20Address(ctx, sourceBytes)
+	convertedBytes, err := getFirstToken(ctx, mapper.dg.GetTokenIdForErc20Address, sourceBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(response) == 0 {
-		return nil, fmt.Errorf("%w for provided %s", errUnknownToken, hex.EncodeToString(sourceBytes))
+	mapper.reverseMapping.put(sourceBytes, convertedBytes)
+
+	return convertedBytes, nil
+}
+
+// ConvertTokenReverse will return erc20 address given a specific erd token id. The conversions already resolved by ConvertToken
+// are served without querying the data getter
+func (mapper *erc20ToElrond) ConvertTokenReverse(ctx context.Context, convertedBytes []byte) ([]byte, error) {
+	sourceBytes, found := mapper.reverseMapping.get(convertedBytes)
+	if found {
+		return sourceBytes, nil
 	}
 
-	return response[0], nil
+	sourceBytes, err := getFirstToken(ctx, mapper.dg.GetERC20AddressForTokenId, convertedBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper.reverseMapping.put(sourceBytes, convertedBytes)
+
+	return sourceBytes, nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -3,3 +3,5 @@ package mappers
 import "errors"
 
 var errUnknownToken = errors.New("unknown token")
+
+var errNilTokensMapper = errors.New("nil tokens mapper")
@@ -8,3 +8,10 @@ type DataGetter interface {
 	GetERC20AddressForTokenId(ctx context.Context, tokenId []byte) ([][]byte, error)
 	IsInterfaceNil() bool
 }
+
+// TokensMapper can convert a token bytes from one chain to another and back
+type TokensMapper interface {
+	ConvertToken(ctx context.Context, sourceBytes []byte) ([]byte, error)
+	ConvertTokenReverse(ctx context.Context, convertedBytes []byte) ([]byte, error)
+	IsInterfaceNil() bool
+}
@@ -99,3 +99,85 @@ func TestConvertToken(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertTokenReverse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ElrondToErc20: dataGetter returns error", func(t *testing.T) {
+		expectedError := errors.New("expected error")
+		dg := &bridgeTests.DataGetterStub{
+			GetTokenIdForErc20AddressCalled: func(ctx context.Context, erc20Address []byte) ([][]byte, error) {
+				return nil, expectedError
+			}}
+		mapper, _ := NewElrondToErc20Mapper(dg)
+
+		_, err := mapper.ConvertTokenReverse(context.Background(), []byte("erc20Address"))
+		assert.Equal(t, expectedError, err)
+	})
+	t.Run("ElrondToErc20: unknown token", func(t *testing.T) {
+		mapper, _ := NewElrondToErc20Mapper(&bridgeTests.DataGetterStub{})
+
+		_, err := mapper.ConvertTokenReverse(context.Background(), []byte("erc20Address"))
+		assert.True(t, errors.Is(err, errUnknownToken))
+	})
+	t.Run("ElrondToErc20: should query the data getter", func(t *testing.T) {
+		numCalls := 0
+		dg := &bridgeTests.DataGetterStub{
+			GetTokenIdForErc20AddressCalled: func(ctx context.Context, erc20Address []byte) ([][]byte, error) {
+				numCalls++
+				return [][]byte{[]byte("erdAddress")}, nil
+			}}
+		mapper, _ := NewElrondToErc20Mapper(dg)
+
+		erdAddressReturned, err := mapper.ConvertTokenReverse(context.Background(), []byte("erc20Address"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("erdAddress"), erdAddressReturned)
+		_, _ = mapper.ConvertTokenReverse(context.Background(), []byte("erc20Address"))
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("ElrondToErc20: already converted token should not query the data getter", func(t *testing.T) {
+		dg := &bridgeTests.DataGetterStub{
+			GetERC20AddressForTokenIdCalled: func(ctx context.Context, tokenId []byte) ([][]byte, error) {
+				return [][]byte{[]byte("erc20Address")}, nil
+			},
+			GetTokenIdForErc20AddressCalled: func(ctx context.Context, erc20Address []byte) ([][]byte, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			}}
+		mapper, _ := NewElrondToErc20Mapper(dg)
+
+		_, err := mapper.ConvertToken(context.Background(), []byte("erdAddress"))
+		assert.Nil(t, err)
+		erdAddressReturned, err := mapper.ConvertTokenReverse(context.Background(), []byte("erc20Address"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("erdAddress"), erdAddressReturned)
+	})
+	t.Run("Erc20ToElrond: already converted token should not query the data getter", func(t *testing.T) {
+		dg := &bridgeTests.DataGetterStub{
+			GetTokenIdForErc20AddressCalled: func(ctx context.Context, erc20Address []byte) ([][]byte, error) {
+				return [][]byte{[]byte("erdAddress")}, nil
+			},
+			GetERC20AddressForTokenIdCalled: func(ctx context.Context, tokenId []byte) ([][]byte, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			}}
+		mapper, _ := NewErc20ToElrondMapper(dg)
+
+		_, err := mapper.ConvertToken(context.Background(), []byte("erc20Address"))
+		assert.Nil(t, err)
+		erc20AddressReturned, err := mapper.ConvertTokenReverse(context.Background(), []byte("erdAddress"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("erc20Address"), erc20AddressReturned)
+	})
+	t.Run("Erc20ToElrond: should query the data getter", func(t *testing.T) {
+		dg := &bridgeTests.DataGetterStub{
+			GetERC20AddressForTokenIdCalled: func(ctx context.Context, tokenId []byte) ([][]byte, error) {
+				return [][]byte{[]byte("erc20Address")}, nil
+			}}
+		mapper, _ := NewErc20ToElrondMapper(dg)
+
+		erc20AddressReturned, err := mapper.ConvertTokenReverse(context.Background(), []byte("erdAddress"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("erc20Address"), erc20AddressReturned)
+	})
+}
@@ -0,0 +1,30 @@
+package mappers
+
+import "sync"
+
+// reverseMapping holds the inverse of the conversions already resolved by a mapper
+type reverseMapping struct {
+	mut    sync.RWMutex
+	values map[string][]byte
+}
+
+func newReverseMapping() *reverseMapping {
+	return &reverseMapping{
+		values: make(map[string][]byte),
+	}
+}
+
+func (rm *reverseMapping) put(sourceBytes []byte, convertedBytes []byte) {
+	rm.mut.Lock()
+	rm.values[string(convertedBytes)] = append(make([]byte, 0, len(sourceBytes)), sourceBytes...)
+	rm.mut.Unlock()
+}
+
+func (rm *reverseMapping) get(convertedBytes []byte) ([]byte, bool) {
+	rm.mut.RLock()
+	defer rm.mut.RUnlock()
+
+	sourceBytes, found := rm.values[string(convertedBytes)]
+
+	return sourceBytes, found
+}
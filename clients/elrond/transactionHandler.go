@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 
 	crypto "github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-sdk-erdgo/builders"
@@ -70,6 +71,48 @@ func (txHandler *transactionHandler) signTransaction(ctx context.Context, builde
 	return tx, nil
 }
 
+// EstimateTransactionGas asks the proxy how much gas the transaction assembled from the provided builder would consume
+func (txHandler *transactionHandler) EstimateTransactionGas(ctx context.Context, builder builders.TxDataBuilder) (uint64, error) {
+	networkConfig, err := txHandler.proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	account, err := txHandler.proxy.GetAccount(ctx, txHandler.relayerAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	dataBytes, err := builder.ToDataBytes()
+	if err != nil {
+		return 0, err
+	}
+
+	tx := &data.Transaction{
+		ChainID:  networkConfig.ChainID,
+		Version:  networkConfig.MinTransactionVersion,
+		GasPrice: networkConfig.MinGasPrice,
+		Nonce:    account.Nonce,
+		Data:     dataBytes,
+		SndAddr:  txHandler.relayerAddress.AddressAsBech32String(),
+		RcvAddr:  txHandler.multisigAddressAsBech32,
+		Value:    "0",
+	}
+
+	cost, err := txHandler.proxy.RequestTransactionCost(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+	if len(cost.RetMessage) > 0 {
+		return 0, fmt.Errorf("%w: %s", errGasEstimationFailed, cost.RetMessage)
+	}
+	if cost.TxCost == 0 {
+		return 0, fmt.Errorf("%w: zero gas units estimated", errGasEstimationFailed)
+	}
+
+	return cost.TxCost, nil
+}
+
 // signTransactionWithPrivateKey signs a transaction with the client's private key
 func (txHandler *transactionHandler) signTransactionWithPrivateKey(tx *data.Transaction) error {
 	tx.Signature = ""
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
@@ -170,3 +171,123 @@ func TestTransactionHandler_SendTransactionReturnHash(t *testing.T) {
 		assert.True(t, sendWasCalled)
 	})
 }
+
+func TestTransactionHandler_EstimateTransactionGas(t *testing.T) {
+	t.Parallel()
+
+	builder := builders.NewTxDataBuilder().Function("function").ArgBytes([]byte("buff")).ArgInt64(22)
+
+	t.Run("get network configs errors", func(t *testing.T) {
+		expectedErr := errors.New("expected error in get network configs")
+		txHandlerInstance := createTransactionHandlerWithMockComponents()
+		txHandlerInstance.proxy = &interactors.ElrondProxyStub{
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				return nil, expectedErr
+			},
+		}
+
+		gasLimit, err := txHandlerInstance.EstimateTransactionGas(context.Background(), builder)
+		assert.Zero(t, gasLimit)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("get account errors", func(t *testing.T) {
+		expectedErr := errors.New("expected error in get account")
+		txHandlerInstance := createTransactionHandlerWithMockComponents()
+		txHandlerInstance.proxy = &interactors.ElrondProxyStub{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return nil, expectedErr
+			},
+		}
+
+		gasLimit, err := txHandlerInstance.EstimateTransactionGas(context.Background(), builder)
+		assert.Zero(t, gasLimit)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("builder errors", func(t *testing.T) {
+		txHandlerInstance := createTransactionHandlerWithMockComponents()
+		erroredBuilder := builders.NewTxDataBuilder().ArgAddress(nil)
+
+		gasLimit, err := txHandlerInstance.EstimateTransactionGas(context.Background(), erroredBuilder)
+		assert.Zero(t, gasLimit)
+		assert.NotNil(t, err)
+	})
+	t.Run("request transaction cost errors", func(t *testing.T) {
+		expectedErr := errors.New("expected error in request transaction cost")
+		txHandlerInstance := createTransactionHandlerWithMockComponents()
+		txHandlerInstance.proxy = &interactors.ElrondProxyStub{
+			RequestTransactionCostCalled: func(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
+				return nil, expectedErr
+			},
+		}
+
+		gasLimit, err := txHandlerInstance.EstimateTransactionGas(context.Background(), builder)
+		assert.Zero(t, gasLimit)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("estimation returned message", func(t *testing.T) {
+		txHandlerInstance := createTransactionHandlerWithMockComponents()
+		txHandlerInstance.proxy = &interactors.ElrondProxyStub{
+			RequestTransactionCostCalled: func(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
+				return &data.TxCostResponseData{RetMessage: "out of gas"}, nil
+			},
+		}
+
+		gasLimit, err := txHandlerInstance.EstimateTransactionGas(context.Background(), builder)
+		assert.Zero(t, gasLimit)
+		assert.True(t, errors.Is(err, errGasEstimationFailed))
+		assert.True(t, strings.Contains(err.Error(), "out of gas"))
+	})
+	t.Run("zero estimation", func(t *testing.T) {
+		txHandlerInstance := createTransactionHandlerWithMockComponents()
+
+		gasLimit, err := txHandlerInstance.EstimateTransactionGas(context.Background(), builder)
+		assert.Zero(t, gasLimit)
+		assert.True(t, errors.Is(err, errGasEstimationFailed))
+	})
+	t.Run("should work", func(t *testing.T) {
+		chainID := "chain ID"
+		minGasPrice := uint64(12234)
+		minTxVersion := uint32(122)
+		accountNonce := uint64(7712)
+		estimatedGas := uint64(4400000)
+
+		txHandlerInstance := createTransactionHandlerWithMockComponents()
+		txHandlerInstance.proxy = &interactors.ElrondProxyStub{
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				return &data.NetworkConfig{
+					ChainID:               chainID,
+					MinGasPrice:           minGasPrice,
+					MinTransactionVersion: minTxVersion,
+				}, nil
+			},
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				assert.Equal(t, relayerAddress, address.AddressAsBech32String())
+				return &data.Account{Nonce: accountNonce}, nil
+			},
+			RequestTransactionCostCalled: func(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
+				assert.Equal(t, relayerAddress, tx.SndAddr)
+				assert.Equal(t, testMultisigAddress, tx.RcvAddr)
+				assert.Equal(t, accountNonce, tx.Nonce)
+				assert.Equal(t, "0", tx.Value)
+				assert.Equal(t, "function@62756666@16", string(tx.Data))
+				assert.Empty(t, tx.Signature)
+				assert.Equal(t, chainID, tx.ChainID)
+				assert.Zero(t, tx.GasLimit)
+				assert.Equal(t, minGasPrice, tx.GasPrice)
+				assert.Equal(t, minTxVersion, tx.Version)
+
+				return &data.TxCostResponseData{TxCost: estimatedGas}, nil
+			},
+		}
+		txHandlerInstance.nonceTxHandler = &bridgeTests.NonceTransactionsHandlerStub{
+			GetNonceCalled: func(ctx context.Context, address core.AddressHandler) (uint64, error) {
+				assert.Fail(t, "should have not consumed a nonce")
+				return 0, nil
+			},
+		}
+
+		gasLimit, err := txHandlerInstance.EstimateTransactionGas(context.Background(), builder)
+		assert.Nil(t, err)
+		assert.Equal(t, estimatedGas, gasLimit)
+	})
+}
@@ -0,0 +1,15 @@
+package clients
+
+// ElrondActionType represents an Elrond multisig operation whose gas limit depends on the batch
+type ElrondActionType string
+
+const (
+	// ProposeTransferAction is the action type for proposing a transfer batch
+	ProposeTransferAction ElrondActionType = "propose transfer"
+	// ProposeSetStatusAction is the action type for proposing the statuses of a batch
+	ProposeSetStatusAction ElrondActionType = "propose set status"
+	// PerformTransferAction is the action type for performing a proposed transfer batch
+	PerformTransferAction ElrondActionType = "perform transfer"
+	// PerformSetStatusAction is the action type for performing the proposed statuses of a batch
+	PerformSetStatusAction ElrondActionType = "perform set status"
+)
@@ -29,4 +29,10 @@ var (
 
 	// ErrMultisigContractPaused signals that the multisig contract is paused
 	ErrMultisigContractPaused = errors.New("multisig contract paused")
+
+	// ErrBatchAlreadyExecuted signals that the batch was already executed
+	ErrBatchAlreadyExecuted = errors.New("batch already executed")
+
+	// ErrClientStalled signals that the client backend did not advance within the allowed delta
+	ErrClientStalled = errors.New("client stalled")
 )
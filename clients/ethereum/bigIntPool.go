@@ -0,0 +1,37 @@
+package ethereum
+
+import (
+	"math/big"
+	"sync"
+)
+
+// bigIntPool holds reusable big.Int instances for the transient computations done while generating the message hash.
+// Values obtained from this pool must not escape to the stored batch or to other components and must be released after usage
+var bigIntPool = sync.Pool{
+	New: func() interface{} {
+		return big.NewInt(0)
+	},
+}
+
+func newBigInt() *big.Int {
+	return big.NewInt(0)
+}
+
+func acquireBigInt() *big.Int {
+	return bigIntPool.Get().(*big.Int).SetUint64(0)
+}
+
+func releaseBigInts(values ...*big.Int) {
+	for _, value := range values {
+		if value == nil {
+			continue
+		}
+
+		bigIntPool.Put(value)
+	}
+}
+
+func (arg argListsBatch) release() {
+	releaseBigInts(arg.amounts...)
+	releaseBigInts(arg.nonces...)
+}
@@ -0,0 +1,117 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockTransferBatchWithDeposits(batchID uint64, numDeposits int) *clients.TransferBatch {
+	batch := &clients.TransferBatch{
+		ID:       batchID,
+		Deposits: make([]*clients.DepositTransfer, 0, numDeposits),
+		Statuses: make([]byte, numDeposits),
+	}
+	for i := 0; i < numDeposits; i++ {
+		batch.Deposits = append(batch.Deposits, &clients.DepositTransfer{
+			Nonce:               batchID*1000 + uint64(i),
+			ToBytes:             []byte(fmt.Sprintf("to%d", i)),
+			FromBytes:           []byte(fmt.Sprintf("from%d", i)),
+			TokenBytes:          []byte(fmt.Sprintf("token%d", i%3)),
+			ConvertedTokenBytes: []byte(fmt.Sprintf("ERC20token%d", i%3)),
+			Amount:              big.NewInt(int64(batchID*100 + uint64(i))),
+		})
+	}
+
+	return batch
+}
+
+func TestBigIntPool_ValuesAreNotAliasedAcrossBatches(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewEthereumClient(createMockEthereumClientArgs())
+	require.Nil(t, err)
+
+	batch1 := createMockTransferBatchWithDeposits(1, 10)
+	batch2 := createMockTransferBatchWithDeposits(2, 10)
+	originalBatch1 := batch1.Clone()
+	originalBatch2 := batch2.Clone()
+
+	argLists1, err := c.extractPooledList(batch1)
+	require.Nil(t, err)
+	for i, dt := range batch1.Deposits {
+		assert.False(t, dt.Amount == argLists1.amounts[i]) // pointer testing
+		assert.Equal(t, dt.Amount, argLists1.amounts[i])
+	}
+	argLists1.release()
+
+	argLists2, err := c.extractPooledList(batch2)
+	require.Nil(t, err)
+	defer argLists2.release()
+	for i, dt := range batch2.Deposits {
+		assert.False(t, dt.Amount == argLists2.amounts[i]) // pointer testing
+		assert.Equal(t, dt.Amount, argLists2.amounts[i])
+		assert.Equal(t, big.NewInt(0).SetUint64(dt.Nonce), argLists2.nonces[i])
+	}
+
+	// releasing and reusing the pooled values must not alter the batches
+	assert.Equal(t, originalBatch1, batch1)
+	assert.Equal(t, originalBatch2, batch2)
+}
+
+func TestBigIntPool_GenerateMessageHashIsStable(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewEthereumClient(createMockEthereumClientArgs())
+	require.Nil(t, err)
+
+	batch := createMockTransferBatchWithDeposits(1, 10)
+	originalBatch := batch.Clone()
+	hash1, err := c.GenerateMessageHash(context.Background(), batch)
+	require.Nil(t, err)
+
+	for i := 0; i < 10; i++ {
+		_, err = c.GenerateMessageHash(context.Background(), createMockTransferBatchWithDeposits(uint64(i+2), 20))
+		require.Nil(t, err)
+	}
+
+	hash2, err := c.GenerateMessageHash(context.Background(), batch)
+	require.Nil(t, err)
+	assert.Equal(t, hash1, hash2)
+	assert.Equal(t, originalBatch, batch)
+}
+
+func BenchmarkClient_GenerateMessageHash(b *testing.B) {
+	c, _ := NewEthereumClient(createMockEthereumClientArgs())
+	batch := createMockTransferBatchWithDeposits(1, 100)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = c.GenerateMessageHash(context.Background(), batch)
+	}
+}
+
+func BenchmarkClient_ExtractList(b *testing.B) {
+	c, _ := NewEthereumClient(createMockEthereumClientArgs())
+	batch := createMockTransferBatchWithDeposits(1, 100)
+
+	b.Run("allocated", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = c.extractList(batch)
+		}
+	})
+	b.Run("pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			argLists, _ := c.extractPooledList(batch)
+			argLists.release()
+		}
+	})
+}
@@ -1,20 +1,28 @@
 package ethereum
 
 import (
+	"bytes"
 	"context"
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/bridges/ethElrond"
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients/ethereum/contract"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	elrondCore "github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	goEthereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
@@ -22,6 +30,12 @@ const (
 	messagePrefix   = "\u0019Ethereum Signed Message:\n32"
 	minQuorumValue  = uint64(1)
 	minAllowedDelta = 1
+
+	minRPCRetryDelay = time.Millisecond
+
+	cancelTransactionGasLimit = uint64(21000)
+
+	executeTransferMethodName = "executeTransfer"
 )
 
 type argListsBatch struct {
@@ -33,36 +47,67 @@ type argListsBatch struct {
 
 // ArgsEthereumClient is the DTO used in the ethereum's client constructor
 type ArgsEthereumClient struct {
-	ClientWrapper           ClientWrapper
-	Erc20ContractsHandler   Erc20ContractsHolder
-	Log                     elrondCore.Logger
-	AddressConverter        core.AddressConverter
-	Broadcaster             Broadcaster
-	PrivateKey              *ecdsa.PrivateKey
-	TokensMapper            TokensMapper
-	SignatureHolder         SignaturesHolder
-	SafeContractAddress     common.Address
-	GasHandler              GasHandler
-	TransferGasLimitBase    uint64
-	TransferGasLimitForEach uint64
-	AllowDelta              uint64
+	ClientWrapper            ClientWrapper
+	Erc20ContractsHandler    Erc20ContractsHolder
+	Log                      elrondCore.Logger
+	AddressConverter         core.AddressConverter
+	Broadcaster              Broadcaster
+	PrivateKey               *ecdsa.PrivateKey
+	TokensMapper             TokensMapper
+	SignatureHolder          SignaturesHolder
+	SafeContractAddress      common.Address
+	GasHandler               GasHandler
+	TransferGasLimitBase     uint64
+	TransferGasLimitForEach  uint64
+	AllowDelta               uint64
+	SupportedTokens          []common.Address
+	MaxDepositsPerBatch      uint64
+	MaxBatchAgeInBlocks      uint64
+	CheckExecutedBeforeSend  bool
+	RPCMaxRetries            uint64
+	RPCRetryDelay            time.Duration
+	TransferMetrics          core.TransferMetrics
+	QuorumCheckMode          core.EthQuorumCheckMode
+	TokenFeeModels           map[common.Address]TokenFeeModel
+	FinalityBlocks           uint64
+	ExecutionConfirmations   uint64
+	SortDepositsByNonce      bool
+	MaximumGasPrice          *big.Int
+	MaxTransferAmounts       map[common.Address]*big.Int
+	PreviousRelayerAddresses []common.Address
 }
 
 type client struct {
-	clientWrapper           ClientWrapper
-	erc20ContractsHandler   Erc20ContractsHolder
-	log                     elrondCore.Logger
-	addressConverter        core.AddressConverter
-	broadcaster             Broadcaster
-	privateKey              *ecdsa.PrivateKey
-	publicKey               *ecdsa.PublicKey
-	tokensMapper            TokensMapper
-	signatureHolder         SignaturesHolder
-	safeContractAddress     common.Address
-	gasHandler              GasHandler
-	transferGasLimitBase    uint64
-	transferGasLimitForEach uint64
-	allowDelta              uint64
+	clientWrapper            ClientWrapper
+	erc20ContractsHandler    Erc20ContractsHolder
+	log                      elrondCore.Logger
+	addressConverter         core.AddressConverter
+	broadcaster              Broadcaster
+	privateKey               *ecdsa.PrivateKey
+	publicKey                *ecdsa.PublicKey
+	tokensMapper             TokensMapper
+	signatureHolder          SignaturesHolder
+	safeContractAddress      common.Address
+	gasHandler               GasHandler
+	transferGasLimitBase     uint64
+	transferGasLimitForEach  uint64
+	allowDelta               uint64
+	supportedTokens          map[common.Address]struct{}
+	maxDepositsPerBatch      uint64
+	maxBatchAgeInBlocks      uint64
+	checkExecutedBeforeSend  bool
+	rpcMaxRetries            uint64
+	rpcRetryDelay            time.Duration
+	nonceManager             *nonceManager
+	transferMetrics          core.TransferMetrics
+	quorumCheckMode          core.EthQuorumCheckMode
+	tokenFeeModels           map[common.Address]TokenFeeModel
+	finalityBlocks           uint64
+	executionConfirmations   uint64
+	sortDepositsByNonce      bool
+	maximumGasPrice          *big.Int
+	maxTransferAmounts       map[common.Address]*big.Int
+	previousRelayerAddresses []common.Address
 
 	lastBlockNumber          uint64
 	retriesAvailabilityCheck uint64
@@ -83,21 +128,50 @@ func NewEthereumClient(args ArgsEthereumClient) (*client, error) {
 	}
 
 	c := &client{
-		clientWrapper:           args.ClientWrapper,
-		erc20ContractsHandler:   args.Erc20ContractsHandler,
-		log:                     args.Log,
-		addressConverter:        args.AddressConverter,
-		broadcaster:             args.Broadcaster,
-		privateKey:              args.PrivateKey,
-		publicKey:               publicKeyECDSA,
-		tokensMapper:            args.TokensMapper,
-		signatureHolder:         args.SignatureHolder,
-		safeContractAddress:     args.SafeContractAddress,
-		gasHandler:              args.GasHandler,
-		transferGasLimitBase:    args.TransferGasLimitBase,
-		transferGasLimitForEach: args.TransferGasLimitForEach,
-		allowDelta:              args.AllowDelta,
+		clientWrapper:            args.ClientWrapper,
+		erc20ContractsHandler:    args.Erc20ContractsHandler,
+		log:                      args.Log,
+		addressConverter:         args.AddressConverter,
+		broadcaster:              args.Broadcaster,
+		privateKey:               args.PrivateKey,
+		publicKey:                publicKeyECDSA,
+		tokensMapper:             args.TokensMapper,
+		signatureHolder:          args.SignatureHolder,
+		safeContractAddress:      args.SafeContractAddress,
+		gasHandler:               args.GasHandler,
+		transferGasLimitBase:     args.TransferGasLimitBase,
+		transferGasLimitForEach:  args.TransferGasLimitForEach,
+		allowDelta:               args.AllowDelta,
+		supportedTokens:          make(map[common.Address]struct{}, len(args.SupportedTokens)),
+		maxDepositsPerBatch:      args.MaxDepositsPerBatch,
+		maxBatchAgeInBlocks:      args.MaxBatchAgeInBlocks,
+		checkExecutedBeforeSend:  args.CheckExecutedBeforeSend,
+		rpcMaxRetries:            args.RPCMaxRetries,
+		rpcRetryDelay:            args.RPCRetryDelay,
+		transferMetrics:          args.TransferMetrics,
+		quorumCheckMode:          args.QuorumCheckMode,
+		tokenFeeModels:           make(map[common.Address]TokenFeeModel, len(args.TokenFeeModels)),
+		finalityBlocks:           args.FinalityBlocks,
+		executionConfirmations:   args.ExecutionConfirmations,
+		sortDepositsByNonce:      args.SortDepositsByNonce,
+		maximumGasPrice:          big.NewInt(0).Set(args.MaximumGasPrice),
+		maxTransferAmounts:       make(map[common.Address]*big.Int, len(args.MaxTransferAmounts)),
+		previousRelayerAddresses: make([]common.Address, len(args.PreviousRelayerAddresses)),
+	}
+	copy(c.previousRelayerAddresses, args.PreviousRelayerAddresses)
+	if len(c.quorumCheckMode) == 0 {
+		c.quorumCheckMode = core.EthQuorumCheckOff
+	}
+	for _, token := range args.SupportedTokens {
+		c.supportedTokens[token] = struct{}{}
 	}
+	for token, feeModel := range args.TokenFeeModels {
+		c.tokenFeeModels[token] = feeModel
+	}
+	for token, maxAmount := range args.MaxTransferAmounts {
+		c.maxTransferAmounts[token] = big.NewInt(0).Set(maxAmount)
+	}
+	c.nonceManager = newNonceManager(c.getPendingNonce, c.getNonce)
 
 	c.log.Info("NewEthereumClient",
 		"relayer address", crypto.PubkeyToAddress(*publicKeyECDSA),
@@ -144,18 +218,63 @@ func checkArgs(args ArgsEthereumClient) error {
 		return fmt.Errorf("%w for args.AllowedDelta, got: %d, minimum: %d",
 			clients.ErrInvalidValue, args.AllowDelta, minAllowedDelta)
 	}
+	if args.RPCMaxRetries > 0 && args.RPCRetryDelay < minRPCRetryDelay {
+		return fmt.Errorf("%w for args.RPCRetryDelay, got: %v, minimum: %v",
+			clients.ErrInvalidValue, args.RPCRetryDelay, minRPCRetryDelay)
+	}
+	if check.IfNil(args.TransferMetrics) {
+		return errNilTransferMetrics
+	}
+	switch args.QuorumCheckMode {
+	case "", core.EthQuorumCheckOff, core.EthQuorumCheckRaise, core.EthQuorumCheckError:
+	default:
+		return fmt.Errorf("%w: %q", errInvalidQuorumCheckMode, args.QuorumCheckMode)
+	}
+	for token, feeModel := range args.TokenFeeModels {
+		if check.IfNil(feeModel) {
+			return fmt.Errorf("%w for ERC20 token %s", errNilTokenFeeModel, token.String())
+		}
+	}
+	if args.MaximumGasPrice == nil {
+		return errNilMaximumGasPrice
+	}
+	if args.MaximumGasPrice.Sign() < 0 {
+		return fmt.Errorf("%w for args.MaximumGasPrice, got: %s", clients.ErrInvalidValue, args.MaximumGasPrice.String())
+	}
+	for token, maxAmount := range args.MaxTransferAmounts {
+		if maxAmount == nil || maxAmount.Sign() <= 0 {
+			return fmt.Errorf("%w for args.MaxTransferAmounts, ERC20 token %s", clients.ErrInvalidValue, token.String())
+		}
+	}
 	return nil
 }
 
 // GetBatch returns the batch (if existing) from the Ethereum contract by providing the nonce
 func (c *client) GetBatch(ctx context.Context, nonce uint64) (*clients.TransferBatch, error) {
-	c.log.Info("Getting batch", "nonce", nonce)
+	readBlockNumber, err := c.finalizedBlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	isFinalityAdjusted := readBlockNumber != nil
+	if isFinalityAdjusted {
+		c.batchLogger(ctx).Info("Getting batch", "nonce", nonce,
+			"finality adjusted", isFinalityAdjusted, "read block", readBlockNumber.Uint64())
+	} else {
+		c.batchLogger(ctx).Info("Getting batch", "nonce", nonce,
+			"finality adjusted", isFinalityAdjusted)
+	}
 	nonceAsBigInt := big.NewInt(0).SetUint64(nonce)
-	batch, err := c.clientWrapper.GetBatch(ctx, nonceAsBigInt)
+
+	batch, err := c.clientWrapper.GetBatch(ctx, nonceAsBigInt, readBlockNumber)
 	if err != nil {
 		return nil, err
 	}
-	deposits, err := c.clientWrapper.GetBatchDeposits(ctx, nonceAsBigInt)
+	if c.maxDepositsPerBatch > 0 && uint64(batch.DepositsCount) > c.maxDepositsPerBatch {
+		return nil, fmt.Errorf("%w, batch.DepositsCount: %d, maximum: %d",
+			errTooManyDepositsInBatch, batch.DepositsCount, c.maxDepositsPerBatch)
+	}
+	c.warnIfStaleBatch(ctx, batch)
+	deposits, err := c.clientWrapper.GetBatchDeposits(ctx, nonceAsBigInt, readBlockNumber)
 	if err != nil {
 		return nil, err
 	}
@@ -165,8 +284,9 @@ func (c *client) GetBatch(ctx context.Context, nonce uint64) (*clients.TransferB
 	}
 
 	transferBatch := &clients.TransferBatch{
-		ID:       batch.Nonce.Uint64(),
-		Deposits: make([]*clients.DepositTransfer, 0, batch.DepositsCount),
+		ID:          batch.Nonce.Uint64(),
+		Deposits:    make([]*clients.DepositTransfer, 0, batch.DepositsCount),
+		BlockNumber: batch.BlockNumber,
 	}
 	cachedTokens := make(map[string][]byte)
 	for i := range deposits {
@@ -199,42 +319,223 @@ func (c *client) GetBatch(ctx context.Context, nonce uint64) (*clients.TransferB
 		transferBatch.Deposits = append(transferBatch.Deposits, depositTransfer)
 	}
 
+	if c.sortDepositsByNonce {
+		err = sortAndCheckDepositNonces(transferBatch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	transferBatch.Statuses = make([]byte, len(transferBatch.Deposits))
 
 	return transferBatch, nil
 }
 
-// WasExecuted returns true if the batch ID was executed
+// sortAndCheckDepositNonces orders the deposits of the provided batch by nonce, keeping the contract order for equal
+// nonces, and returns an error if the resulting nonces are not consecutive
+func sortAndCheckDepositNonces(batch *clients.TransferBatch) error {
+	sort.SliceStable(batch.Deposits, func(i, j int) bool {
+		return batch.Deposits[i].Nonce < batch.Deposits[j].Nonce
+	})
+
+	for i := 1; i < len(batch.Deposits); i++ {
+		previousNonce := batch.Deposits[i-1].Nonce
+		currentNonce := batch.Deposits[i].Nonce
+		if currentNonce == previousNonce {
+			return fmt.Errorf("%w, batch ID: %d, deposit nonce: %d", errDuplicatedDepositNonce, batch.ID, currentNonce)
+		}
+		if currentNonce != previousNonce+1 {
+			return fmt.Errorf("%w, batch ID: %d, deposit nonce %d is followed by %d",
+				errDepositNoncesGap, batch.ID, previousNonce, currentNonce)
+		}
+	}
+
+	return nil
+}
+
+// VerifyBatchStillValid re-reads the batch from the chain and returns false if the canonical batch differs from the
+// provided one, which happens when a chain reorganization replaced the block the batch was read from
+func (c *client) VerifyBatchStillValid(ctx context.Context, batch *clients.TransferBatch) (bool, error) {
+	if batch == nil {
+		return false, clients.ErrNilBatch
+	}
+
+	canonicalBatch, err := c.GetBatch(ctx, batch.ID)
+	if err != nil {
+		return false, err
+	}
+	if !isSameBatch(batch, canonicalBatch) {
+		batchLog := c.batchLogger(ctx)
+		batchLog.Warn("batch changed on chain, possible reorg",
+			"stored batch", batch.Summary(), "stored batch block", batch.BlockNumber,
+			"canonical batch", canonicalBatch.Summary(), "canonical batch block", canonicalBatch.BlockNumber)
+		batchLog.Debug("batch changed on chain, possible reorg",
+			"stored batch", batch.String(), "canonical batch", canonicalBatch.String())
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func isSameBatch(batch *clients.TransferBatch, other *clients.TransferBatch) bool {
+	if batch.ID != other.ID || batch.BlockNumber != other.BlockNumber {
+		return false
+	}
+	if len(batch.Deposits) != len(other.Deposits) {
+		return false
+	}
+
+	for i, deposit := range batch.Deposits {
+		otherDeposit := other.Deposits[i]
+		if deposit.Nonce != otherDeposit.Nonce {
+			return false
+		}
+		if !bytes.Equal(deposit.ToBytes, otherDeposit.ToBytes) ||
+			!bytes.Equal(deposit.FromBytes, otherDeposit.FromBytes) ||
+			!bytes.Equal(deposit.TokenBytes, otherDeposit.TokenBytes) {
+			return false
+		}
+		if deposit.Amount == nil || otherDeposit.Amount == nil || deposit.Amount.Cmp(otherDeposit.Amount) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// finalizedBlockNumber returns the block the contract reads should be done on, that is the latest block minus the
+// configured number of finality blocks. A nil value, meaning the latest block, is returned when no finality is configured
+func (c *client) finalizedBlockNumber(ctx context.Context) (*big.Int, error) {
+	if c.finalityBlocks == 0 {
+		return nil, nil
+	}
+
+	latestBlockNumber, err := c.blockNumberWithRetries(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w in client.GetBatch, BlockNumber call", err)
+	}
+	if latestBlockNumber < c.finalityBlocks {
+		return nil, fmt.Errorf("%w, latest block: %d, finality blocks: %d",
+			errNotEnoughBlocksForFinality, latestBlockNumber, c.finalityBlocks)
+	}
+
+	return big.NewInt(0).SetUint64(latestBlockNumber - c.finalityBlocks), nil
+}
+
+// warnIfStaleBatch logs a warning if the batch was created more than maxBatchAgeInBlocks blocks ago. The batch is
+// still processed as the following batches can not be fetched before this one is executed. A zero
+// maxBatchAgeInBlocks disables the check, batches without a block number are not checked
+func (c *client) warnIfStaleBatch(ctx context.Context, batch contract.Batch) {
+	if c.maxBatchAgeInBlocks == 0 || batch.BlockNumber == 0 {
+		return
+	}
+
+	currentBlockNumber, err := c.blockNumberWithRetries(ctx)
+	if err != nil {
+		c.batchLogger(ctx).Debug("can not check the batch age", "nonce", batch.Nonce, "error", err)
+		return
+	}
+	if currentBlockNumber <= batch.BlockNumber {
+		return
+	}
+
+	age := currentBlockNumber - batch.BlockNumber
+	if age > c.maxBatchAgeInBlocks {
+		c.batchLogger(ctx).Warn("processing stale batch", "nonce", batch.Nonce,
+			"batch block", batch.BlockNumber, "current block", currentBlockNumber,
+			"age in blocks", age, "maximum age in blocks", c.maxBatchAgeInBlocks)
+	}
+}
+
+// confirmedBlockNumber returns the block the execution checks should be done on, that is the latest block minus the
+// configured number of execution confirmations. A nil value, meaning the latest block, is returned when no
+// confirmations are configured
+func (c *client) confirmedBlockNumber(ctx context.Context) (*big.Int, error) {
+	if c.executionConfirmations == 0 {
+		return nil, nil
+	}
+
+	latestBlockNumber, err := c.blockNumberWithRetries(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w in client.WasExecuted, BlockNumber call", err)
+	}
+	if latestBlockNumber < c.executionConfirmations {
+		return nil, fmt.Errorf("%w, latest block: %d, execution confirmations: %d",
+			errNotEnoughBlocksForConfirmations, latestBlockNumber, c.executionConfirmations)
+	}
+
+	return big.NewInt(0).SetUint64(latestBlockNumber - c.executionConfirmations), nil
+}
+
+// WasExecuted returns true if the batch ID was executed at least executionConfirmations blocks ago, so a reorg
+// of the most recent blocks can not revert the reported execution
 func (c *client) WasExecuted(ctx context.Context, batchID uint64) (bool, error) {
-	return c.clientWrapper.WasBatchExecuted(ctx, big.NewInt(0).SetUint64(batchID))
+	blockNumber, err := c.confirmedBlockNumber(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return c.wasBatchExecutedWithRetries(ctx, big.NewInt(0).SetUint64(batchID), blockNumber)
 }
 
 // BroadcastSignatureForMessageHash will send the signature for the provided message hash
-func (c *client) BroadcastSignatureForMessageHash(msgHash common.Hash) {
+func (c *client) BroadcastSignatureForMessageHash(msgHash common.Hash) error {
 	signature, err := crypto.Sign(msgHash.Bytes(), c.privateKey)
 	if err != nil {
-		c.log.Error("error generating signature", "msh hash", msgHash, "error", err)
-		return
+		return fmt.Errorf("%w while generating the signature for message hash %s", err, msgHash.String())
+	}
+	err = c.verifyOwnSignature(msgHash, signature)
+	if err != nil {
+		c.log.Error("generated an invalid signature", "msg hash", msgHash, "error", err)
+		return err
 	}
 
 	c.broadcaster.BroadcastSignature(signature, msgHash.Bytes())
+
+	return nil
 }
 
-// GenerateMessageHash will generate the message hash based on the provided batch
-func (c *client) GenerateMessageHash(batch *clients.TransferBatch) (common.Hash, error) {
+// verifyOwnSignature checks that the provided signature recovers to this relayer's address, catching any
+// mismatch between the private key and the relayer's address before the contract rejects the signature
+func (c *client) verifyOwnSignature(msgHash common.Hash, signature []byte) error {
+	publicKey, err := crypto.SigToPub(msgHash.Bytes(), signature)
+	if err != nil {
+		return fmt.Errorf("%w, can not recover the signer: %s", errSignatureVerificationFailed, err.Error())
+	}
+
+	signer := crypto.PubkeyToAddress(*publicKey)
+	relayerAddress := crypto.PubkeyToAddress(*c.publicKey)
+	if signer != relayerAddress {
+		return fmt.Errorf("%w, recovered signer: %s, relayer address: %s",
+			errSignatureVerificationFailed, signer.String(), relayerAddress.String())
+	}
+
+	return nil
+}
+
+// GenerateMessageHash will generate the message hash based on the provided batch. A done context stops the generation
+func (c *client) GenerateMessageHash(ctx context.Context, batch *clients.TransferBatch) (common.Hash, error) {
+	if ctx.Err() != nil {
+		return common.Hash{}, ctx.Err()
+	}
 	if batch == nil {
 		return common.Hash{}, clients.ErrNilBatch
 	}
+	if len(batch.Deposits) == 0 {
+		return common.Hash{}, fmt.Errorf("%w, batch ID: %d", errEmptyBatch, batch.ID)
+	}
 
 	args, err := generateTransferArgs()
 	if err != nil {
 		return common.Hash{}, err
 	}
 
-	argLists, err := c.extractList(batch)
+	// the packed arguments are copied in the returned bytes, so the pooled values do not escape this function
+	argLists, err := c.extractPooledList(batch)
 	if err != nil {
 		return common.Hash{}, err
 	}
+	defer argLists.release()
 
 	pack, err := args.Pack(argLists.recipients, argLists.tokens, argLists.amounts, argLists.nonces, big.NewInt(0).SetUint64(batch.ID), "ExecuteBatchedTransfer")
 	if err != nil {
@@ -277,7 +578,24 @@ func generateTransferArgs() (abi.Arguments, error) {
 }
 
 func (c *client) extractList(batch *clients.TransferBatch) (argListsBatch, error) {
+	return c.extractListWithAllocator(batch, newBigInt)
+}
+
+// extractPooledList returns the argument lists built with values taken from the pool. The caller must release them
+func (c *client) extractPooledList(batch *clients.TransferBatch) (argListsBatch, error) {
+	return c.extractListWithAllocator(batch, acquireBigInt)
+}
+
+func (c *client) extractListWithAllocator(batch *clients.TransferBatch, allocator func() *big.Int) (argListsBatch, error) {
 	arg := argListsBatch{}
+	err := c.checkSupportedTokens(batch)
+	if err != nil {
+		return arg, err
+	}
+	err = c.checkTransferAmounts(batch)
+	if err != nil {
+		return arg, err
+	}
 
 	for _, dt := range batch.Deposits {
 		recipient := common.BytesToAddress(dt.ToBytes)
@@ -286,16 +604,55 @@ func (c *client) extractList(batch *clients.TransferBatch) (argListsBatch, error
 		token := common.BytesToAddress(dt.ConvertedTokenBytes)
 		arg.tokens = append(arg.tokens, token)
 
-		amount := big.NewInt(0).Set(dt.Amount)
+		amount := allocator().Set(dt.Amount)
 		arg.amounts = append(arg.amounts, amount)
 
-		nonce := big.NewInt(0).SetUint64(dt.Nonce)
+		nonce := allocator().SetUint64(dt.Nonce)
 		arg.nonces = append(arg.nonces, nonce)
 	}
 
 	return arg, nil
 }
 
+// checkSupportedTokens returns an error if a deposit uses an ERC20 token outside the supported tokens set.
+// All tokens are allowed if the supported tokens set is empty
+func (c *client) checkSupportedTokens(batch *clients.TransferBatch) error {
+	if len(c.supportedTokens) == 0 {
+		return nil
+	}
+
+	for _, dt := range batch.Deposits {
+		token := common.BytesToAddress(dt.ConvertedTokenBytes)
+		_, isSupported := c.supportedTokens[token]
+		if !isSupported {
+			return fmt.Errorf("%w: ERC20 token %s for deposit nonce %d in batch %d, source token %s",
+				errUnsupportedToken, token.String(), dt.Nonce, batch.ID, dt.DisplayableToken)
+		}
+	}
+
+	return nil
+}
+
+// checkTransferAmounts returns an error if a deposit amount is not positive or exceeds the maximum amount
+// configured for its ERC20 token. Tokens without a configured maximum are only checked for positive amounts
+func (c *client) checkTransferAmounts(batch *clients.TransferBatch) error {
+	for _, dt := range batch.Deposits {
+		if dt.Amount == nil || dt.Amount.Sign() <= 0 {
+			return fmt.Errorf("%w: %v for deposit nonce %d in batch %d",
+				errInvalidTransferAmount, dt.Amount, dt.Nonce, batch.ID)
+		}
+
+		token := common.BytesToAddress(dt.ConvertedTokenBytes)
+		maxAmount, found := c.maxTransferAmounts[token]
+		if found && dt.Amount.Cmp(maxAmount) > 0 {
+			return fmt.Errorf("%w: %s for deposit nonce %d in batch %d, ERC20 token %s, maximum: %s",
+				errTransferAmountAboveMaximum, dt.Amount.String(), dt.Nonce, batch.ID, token.String(), maxAmount.String())
+		}
+	}
+
+	return nil
+}
+
 // ExecuteTransfer will initiate and send the transaction from the transfer batch struct
 func (c *client) ExecuteTransfer(
 	ctx context.Context,
@@ -306,25 +663,105 @@ func (c *client) ExecuteTransfer(
 	if batch == nil {
 		return "", clients.ErrNilBatch
 	}
+	if len(batch.Deposits) == 0 {
+		return "", fmt.Errorf("%w, batch ID: %d", errEmptyBatch, batch.ID)
+	}
 
-	isPaused, err := c.clientWrapper.IsPaused(ctx)
+	argLists, err := c.extractList(batch)
+	if err != nil {
+		return "", err
+	}
+
+	isPaused, err := c.IsPaused(ctx)
 	if err != nil {
 		return "", fmt.Errorf("%w in client.ExecuteTransfer", err)
 	}
 	if isPaused {
+		c.batchLogger(ctx).Warn("the multisig contract is paused, skipping the execute transfer transaction",
+			"batch ID", batch.ID)
 		return "", fmt.Errorf("%w in client.ExecuteTransfer", clients.ErrMultisigContractPaused)
 	}
+	// with execution confirmations, WasExecuted does not see the recent executions so the latest block is always
+	// checked, otherwise the relayers would resend a batch that was just executed
+	if c.checkExecutedBeforeSend || c.executionConfirmations > 0 {
+		// the latest block is checked as a recent, not yet confirmed, execution would make the transfer fail as well
+		wasExecuted, errWasExecuted := c.wasBatchExecutedWithRetries(ctx, big.NewInt(0).SetUint64(batch.ID), nil)
+		if errWasExecuted != nil {
+			return "", fmt.Errorf("%w in client.ExecuteTransfer", errWasExecuted)
+		}
+		if wasExecuted {
+			return "", fmt.Errorf("%w in client.ExecuteTransfer, batch ID: %d", clients.ErrBatchAlreadyExecuted, batch.ID)
+		}
+	}
 
-	c.log.Info("executing transfer " + batch.String())
+	quorum, err = c.checkQuorum(ctx, quorum)
+	if err != nil {
+		return "", err
+	}
 
-	fromAddress := crypto.PubkeyToAddress(*c.publicKey)
+	log := c.batchLogger(ctx)
+	log.Info("executing transfer " + batch.Summary())
+	log.Trace("executing transfer " + batch.String())
 
-	nonce, err := c.getNonce(ctx, fromAddress)
+	nonce, err := c.nonceManager.reserveNonce(ctx)
 	if err != nil {
 		return "", err
 	}
 
-	chainId, err := c.clientWrapper.ChainID(ctx)
+	txHash, err := c.executeTransferWithNonce(ctx, msgHash, batch, quorum, argLists, nonce)
+	if err != nil {
+		c.nonceManager.releaseNonce(nonce)
+		c.transferMetrics.IncTransfersFailed()
+		return "", err
+	}
+	c.nonceManager.markNonceSent()
+
+	c.transferMetrics.IncTransfersSent()
+
+	return txHash, nil
+}
+
+// EncodeExecuteTransferCalldata returns the ABI encoded input of the executeTransfer call for the provided batch and
+// signatures, exactly as it would be sent to the multisig contract. Nothing is broadcast, so operators can decode
+// and verify the payload before a transfer is sent
+func (c *client) EncodeExecuteTransferCalldata(ctx context.Context, batch *clients.TransferBatch, signatures [][]byte) ([]byte, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if batch == nil {
+		return nil, clients.ErrNilBatch
+	}
+	if len(batch.Deposits) == 0 {
+		return nil, fmt.Errorf("%w, batch ID: %d", errEmptyBatch, batch.ID)
+	}
+
+	argLists, err := c.extractList(batch)
+	if err != nil {
+		return nil, err
+	}
+
+	bridgeABI, err := contract.BridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	batchID := big.NewInt(0).SetUint64(batch.ID)
+
+	return bridgeABI.Pack(executeTransferMethodName, argLists.tokens, argLists.recipients, argLists.amounts,
+		argLists.nonces, batchID, signatures)
+}
+
+func (c *client) executeTransferWithNonce(
+	ctx context.Context,
+	msgHash common.Hash,
+	batch *clients.TransferBatch,
+	quorum int,
+	argLists argListsBatch,
+	nonce uint64,
+) (string, error) {
+	log := c.batchLogger(ctx)
+
+	chainId, err := c.chainIDWithRetries(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -334,32 +771,42 @@ func (c *client) ExecuteTransfer(
 		return "", err
 	}
 
-	gasPrice, err := c.gasHandler.GetCurrentGasPrice()
+	gasPrice, err := c.gasHandler.GetCurrentGasPriceWithContext(ctx)
 	if err != nil {
 		return "", err
 	}
+	if gasPrice == nil {
+		return "", fmt.Errorf("%w in client.ExecuteTransfer", errNilGasPrice)
+	}
+	if gasPrice.Sign() < 0 {
+		return "", fmt.Errorf("%w in client.ExecuteTransfer, got: %s", errInvalidGasPrice, gasPrice.String())
+	}
+	if gasPrice.Sign() == 0 {
+		log.Warn("gas handler returned a zero gas price, is the gas station disabled?")
+	}
 
-	auth.Nonce = big.NewInt(nonce)
+	auth.Nonce = big.NewInt(0).SetUint64(nonce)
 	auth.Value = big.NewInt(0)
 	auth.GasLimit = c.transferGasLimitBase + uint64(len(batch.Deposits))*c.transferGasLimitForEach
 	auth.Context = ctx
 	auth.GasPrice = gasPrice
 
-	signatures := c.signatureHolder.Signatures(msgHash.Bytes())
+	signatures, err := c.filterAuthorizedSignatures(ctx, msgHash, c.signatureHolder.Signatures(msgHash.Bytes()))
+	if err != nil {
+		return "", fmt.Errorf("%w in client.ExecuteTransfer", err)
+	}
+	if len(signatures) == 0 && quorum > 0 {
+		return "", fmt.Errorf("%w, quorum: %d", errNoSignaturesCollected, quorum)
+	}
 	if len(signatures) < quorum {
 		return "", fmt.Errorf("%w num signatures: %d, quorum: %d", errQuorumNotReached, len(signatures), quorum)
 	}
 	if len(signatures) > quorum {
-		c.log.Debug("reducing the size of the signatures set",
+		log.Debug("reducing the size of the signatures set",
 			"quorum", quorum, "total signatures", len(signatures))
 		signatures = signatures[:quorum]
 	}
 
-	argLists, err := c.extractList(batch)
-	if err != nil {
-		return "", err
-	}
-
 	err = c.checkAvailableTokens(ctx, argLists.tokens, argLists.amounts)
 	if err != nil {
 		return "", err
@@ -378,12 +825,173 @@ func (c *client) ExecuteTransfer(
 		return "", err
 	}
 
+	gasPriceValue, _ := big.NewFloat(0).SetInt(gasPrice).Float64()
+	c.transferMetrics.ObserveExecutionGasPrice(gasPriceValue)
+
 	txHash := tx.Hash().String()
-	c.log.Info("Executed transfer transaction", "batchID", batchID, "hash", txHash)
+	log.Info("Executed transfer transaction", "batchID", batchID, "hash", txHash)
 
 	return txHash, err
 }
 
+// CancelTransaction replaces the transaction sent with the provided nonce, if still pending, with a 0-value
+// transaction sent by the relayer to itself with the provided gas price. The gas price should be higher than the
+// one used by the stuck transaction and can not exceed the maximum allowed gas price. After the cancel transaction is
+// sent, the local nonce is re-synchronized with the chain pending nonce, so the next transfer will not reuse the
+// canceled nonce. There is no operator entry point on purpose: the REST API is read-only and not authenticated, so it
+// can not expose an action signing and sending transactions with the relayer key
+func (c *client) CancelTransaction(ctx context.Context, nonce uint64, gasPrice *big.Int) (string, error) {
+	if gasPrice == nil {
+		return "", fmt.Errorf("%w in client.CancelTransaction", errNilGasPrice)
+	}
+	if gasPrice.Sign() <= 0 {
+		return "", fmt.Errorf("%w in client.CancelTransaction, got: %s", errInvalidGasPrice, gasPrice.String())
+	}
+	if gasPrice.Cmp(c.maximumGasPrice) > 0 {
+		return "", fmt.Errorf("%w in client.CancelTransaction, got: %s, maximum: %s",
+			errGasPriceAboveMaximum, gasPrice.String(), c.maximumGasPrice.String())
+	}
+
+	chainId, err := c.chainIDWithRetries(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	minimumForFee := big.NewInt(0).SetUint64(cancelTransactionGasLimit)
+	minimumForFee.Mul(minimumForFee, gasPrice)
+	err = c.checkRelayerFundsForFee(ctx, minimumForFee)
+	if err != nil {
+		return "", err
+	}
+
+	relayerAddress := crypto.PubkeyToAddress(*c.publicKey)
+	tx := types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		Gas:      cancelTransactionGasLimit,
+		To:       &relayerAddress,
+		Value:    big.NewInt(0),
+	})
+	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(chainId), c.privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	err = c.clientWrapper.SendTransaction(ctx, signedTx)
+	if err != nil {
+		return "", err
+	}
+
+	txHash := signedTx.Hash().String()
+	c.log.Info("sent cancel transaction", "nonce", nonce, "gas price", gasPrice.String(), "hash", txHash)
+
+	err = c.nonceManager.reset(ctx)
+	if err != nil {
+		c.log.Warn("could not re-synchronize the nonce after the cancel transaction", "hash", txHash, "error", err)
+	}
+
+	return txHash, nil
+}
+
+// checkQuorum cross-checks the provided quorum against the one required by the multisig contract. A lower provided
+// quorum is either raised to the contract value or rejected, depending on the configured mode, as a batch sent with
+// fewer signatures than the contract requires is guaranteed to revert. The contract is queried right before sending,
+// as the quorum provided by the caller might have been read before a contract quorum change
+func (c *client) checkQuorum(ctx context.Context, quorum int) (int, error) {
+	if c.quorumCheckMode == core.EthQuorumCheckOff {
+		return quorum, nil
+	}
+
+	contractQuorum, err := c.clientWrapper.Quorum(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%w in client.ExecuteTransfer, Quorum call", err)
+	}
+	if !contractQuorum.IsInt64() {
+		return 0, fmt.Errorf("%w in client.ExecuteTransfer, contract quorum: %s", clients.ErrInvalidValue, contractQuorum.String())
+	}
+
+	onChainQuorum := int(contractQuorum.Int64())
+	if onChainQuorum == quorum {
+		return quorum, nil
+	}
+
+	c.batchLogger(ctx).Warn("provided quorum differs from the contract quorum",
+		"provided quorum", quorum, "contract quorum", onChainQuorum)
+	if quorum > onChainQuorum {
+		return quorum, nil
+	}
+	if c.quorumCheckMode == core.EthQuorumCheckError {
+		return 0, fmt.Errorf("%w in client.ExecuteTransfer, provided: %d, contract: %d",
+			errQuorumBelowContractQuorum, quorum, onChainQuorum)
+	}
+
+	return onChainQuorum, nil
+}
+
+// authorizedSigners returns the addresses whose signatures are accepted: the relayers whitelisted in the contract
+// and the previous relayer addresses, still accepted during a relayer key rotation. The value is true for the
+// relayers whitelisted in the contract
+func (c *client) authorizedSigners(ctx context.Context) (map[common.Address]bool, error) {
+	relayers, err := c.clientWrapper.GetRelayers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w while fetching the relayers", err)
+	}
+
+	authorizedSigners := make(map[common.Address]bool, len(relayers)+len(c.previousRelayerAddresses))
+	for _, address := range c.previousRelayerAddresses {
+		authorizedSigners[address] = false
+	}
+	for _, relayer := range relayers {
+		authorizedSigners[relayer] = true
+	}
+
+	return authorizedSigners, nil
+}
+
+// filterAuthorizedSignatures recovers the signer of each provided signature and keeps only one signature for each
+// authorized signer, so duplicated or foreign signatures will not be counted towards the quorum. The signatures of
+// the relayers whitelisted in the contract are placed before the ones of the previous relayer addresses, so the
+// latter are only used to complete the quorum
+func (c *client) filterAuthorizedSignatures(ctx context.Context, msgHash common.Hash, signatures [][]byte) ([][]byte, error) {
+	authorizedSigners, err := c.authorizedSigners(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	log := c.batchLogger(ctx)
+	signers := make(map[common.Address]struct{}, len(signatures))
+	filteredSignatures := make([][]byte, 0, len(signatures))
+	previousRelayersSignatures := make([][]byte, 0)
+	for _, signature := range signatures {
+		publicKey, errRecover := crypto.SigToPub(msgHash.Bytes(), signature)
+		if errRecover != nil {
+			log.Debug("dropping signature, can not recover the signer", "error", errRecover)
+			continue
+		}
+
+		signer := crypto.PubkeyToAddress(*publicKey)
+		isContractRelayer, isAuthorized := authorizedSigners[signer]
+		if !isAuthorized {
+			log.Debug("dropping signature from an unauthorized signer", "signer", signer.String())
+			continue
+		}
+		_, isDuplicated := signers[signer]
+		if isDuplicated {
+			log.Debug("dropping duplicated signature", "signer", signer.String())
+			continue
+		}
+
+		signers[signer] = struct{}{}
+		if !isContractRelayer {
+			previousRelayersSignatures = append(previousRelayersSignatures, signature)
+			continue
+		}
+		filteredSignatures = append(filteredSignatures, signature)
+	}
+
+	return append(filteredSignatures, previousRelayersSignatures...), nil
+}
+
 // CheckClientAvailability will check the client availability and set the metric accordingly
 func (c *client) CheckClientAvailability(ctx context.Context) error {
 	c.mut.Lock()
@@ -408,7 +1016,7 @@ func (c *client) CheckClientAvailability(ctx context.Context) error {
 		message := fmt.Sprintf("block %d fetched for %d times in a row", currentBlock, c.retriesAvailabilityCheck)
 		c.setStatusForAvailabilityCheck(ethElrond.Unavailable, message, currentBlock)
 
-		return nil
+		return fmt.Errorf("%w: %s", clients.ErrClientStalled, message)
 	}
 
 	c.setStatusForAvailabilityCheck(ethElrond.Available, "", currentBlock)
@@ -441,12 +1049,23 @@ func (c *client) getCumulatedTransfers(tokens []common.Address, amounts []*big.I
 			transfers[token] = existing
 		}
 
-		existing.Add(existing, amounts[i])
+		existing.Add(existing, c.requiredBalance(token, amounts[i]))
 	}
 
 	return transfers
 }
 
+// requiredBalance returns the balance the safe contract needs for transferring the provided amount. Tokens without
+// a fee model require exactly the transferred amount
+func (c *client) requiredBalance(token common.Address, amount *big.Int) *big.Int {
+	feeModel, found := c.tokenFeeModels[token]
+	if !found {
+		return amount
+	}
+
+	return feeModel.RequiredBalance(amount)
+}
+
 func (c *client) checkCumulatedTransfers(ctx context.Context, transfers map[common.Address]*big.Int) error {
 	for erc20Address, value := range transfers {
 		existingBalance, err := c.erc20ContractsHandler.BalanceOf(ctx, erc20Address, c.safeContractAddress)
@@ -490,15 +1109,33 @@ func (c *client) checkRelayerFundsForFee(ctx context.Context, transferFee *big.I
 	return nil
 }
 
-func (c *client) getNonce(ctx context.Context, fromAddress common.Address) (int64, error) {
-	blockNonce, err := c.clientWrapper.BlockNumber(ctx)
+func (c *client) getNonce(ctx context.Context) (uint64, error) {
+	blockNonce, err := c.blockNumberWithRetries(ctx)
 	if err != nil {
 		return 0, fmt.Errorf("%w in getNonce, BlockNumber call", err)
 	}
 
-	nonce, err := c.clientWrapper.NonceAt(ctx, fromAddress, big.NewInt(int64(blockNonce)))
+	fromAddress := crypto.PubkeyToAddress(*c.publicKey)
+
+	return c.nonceAtWithRetries(ctx, fromAddress, big.NewInt(0).SetUint64(blockNonce))
+}
+
+func (c *client) getPendingNonce(ctx context.Context) (uint64, error) {
+	fromAddress := crypto.PubkeyToAddress(*c.publicKey)
+
+	return c.pendingNonceAtWithRetries(ctx, fromAddress)
+}
+
+// ResetNonce drops the locally reserved nonces and re-synchronizes the account nonce with the chain pending nonce.
+// It should be used to recover after a transaction that reserved a nonce was dropped before being included on chain
+func (c *client) ResetNonce(ctx context.Context) error {
+	return c.nonceManager.reset(ctx)
+}
 
-	return int64(nonce), err
+// ResetNonceToMined drops the locally reserved nonces and re-synchronizes the account nonce with the nonce of the last
+// mined transaction, so the next transfer replaces the oldest pending transaction
+func (c *client) ResetNonceToMined(ctx context.Context) error {
+	return c.nonceManager.resetToMinedNonce(ctx)
 }
 
 // GetTransactionsStatuses will return the transactions statuses from the batch
@@ -506,14 +1143,46 @@ func (c *client) GetTransactionsStatuses(ctx context.Context, batchId uint64) ([
 	return c.clientWrapper.GetStatusesAfterExecution(ctx, big.NewInt(0).SetUint64(batchId))
 }
 
+// IsTransactionReverted returns true if the receipt of the provided transaction hash signals a failed execution.
+// A transaction without a receipt is considered pending, so it is not reported as reverted
+func (c *client) IsTransactionReverted(ctx context.Context, txHash string) (bool, error) {
+	receipt, err := c.clientWrapper.TransactionReceipt(ctx, common.HexToHash(txHash))
+	if errors.Is(err, goEthereum.NotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return receipt.Status == types.ReceiptStatusFailed, nil
+}
+
+// WasTransactionMined returns true if the provided transaction hash has a receipt, regardless of its status
+func (c *client) WasTransactionMined(ctx context.Context, txHash string) (bool, error) {
+	_, err := c.clientWrapper.TransactionReceipt(ctx, common.HexToHash(txHash))
+	if errors.Is(err, goEthereum.NotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// IsPaused returns true if the multisig contract is paused
+func (c *client) IsPaused(ctx context.Context) (bool, error) {
+	return c.clientWrapper.IsPaused(ctx)
+}
+
 // GetQuorumSize returns the size of the quorum
 func (c *client) GetQuorumSize(ctx context.Context) (*big.Int, error) {
 	return c.clientWrapper.Quorum(ctx)
 }
 
-// IsQuorumReached returns true if the number of signatures is at least the size of quorum
+// IsQuorumReached returns true if the number of signatures is at least the size of quorum. The signatures are
+// filtered the same way as in ExecuteTransfer, so only the ones that will be sent are counted
 func (c *client) IsQuorumReached(ctx context.Context, msgHash common.Hash) (bool, error) {
-	signatures := c.signatureHolder.Signatures(msgHash.Bytes())
 	quorum, err := c.clientWrapper.Quorum(ctx)
 	if err != nil {
 		return false, fmt.Errorf("%w in IsQuorumReached, Quorum call", err)
@@ -521,10 +1190,25 @@ func (c *client) IsQuorumReached(ctx context.Context, msgHash common.Hash) (bool
 	if quorum.Uint64() < minQuorumValue {
 		return false, fmt.Errorf("%w in IsQuorumReached, minQuorum %d, got: %s", clients.ErrInvalidValue, minQuorumValue, quorum.String())
 	}
+	signatures, err := c.filterAuthorizedSignatures(ctx, msgHash, c.signatureHolder.Signatures(msgHash.Bytes()))
+	if err != nil {
+		return false, fmt.Errorf("%w in IsQuorumReached", err)
+	}
 
 	return len(signatures) >= int(quorum.Int64()), nil
 }
 
+// batchLogger returns the client logger decorated with the batch ID held by the provided context, if the
+// logger supports it
+func (c *client) batchLogger(ctx context.Context) elrondCore.Logger {
+	log, ok := c.log.(logger.Logger)
+	if !ok {
+		return c.log
+	}
+
+	return core.NewLoggerWithBatchID(ctx, log)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (c *client) IsInterfaceNil() bool {
 	return c == nil
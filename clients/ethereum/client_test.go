@@ -18,11 +18,13 @@ import (
 	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	goEthereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var expectedAmounts = []*big.Int{big.NewInt(20), big.NewInt(40)}
@@ -56,6 +58,10 @@ func createMockEthereumClientArgs() ArgsEthereumClient {
 		TransferGasLimitBase:    50,
 		TransferGasLimitForEach: 20,
 		AllowDelta:              5,
+		MaxDepositsPerBatch:     10,
+		TransferMetrics:         &testsCommon.TransferMetricsStub{},
+		QuorumCheckMode:         bridgeCore.EthQuorumCheckOff,
+		MaximumGasPrice:         big.NewInt(1000),
 	}
 }
 
@@ -90,6 +96,23 @@ func createMockTransferBatch() *clients.TransferBatch {
 	}
 }
 
+func createSignaturesAndRelayers(tb testing.TB, msgHash common.Hash, numRelayers int) ([][]byte, []common.Address) {
+	signatures := make([][]byte, 0, numRelayers)
+	relayers := make([]common.Address, 0, numRelayers)
+	for i := 0; i < numRelayers; i++ {
+		sk, err := crypto.GenerateKey()
+		require.Nil(tb, err)
+
+		signature, err := crypto.Sign(msgHash.Bytes(), sk)
+		require.Nil(tb, err)
+
+		signatures = append(signatures, signature)
+		relayers = append(relayers, crypto.PubkeyToAddress(sk.PublicKey))
+	}
+
+	return signatures, relayers
+}
+
 func TestNewEthereumClient(t *testing.T) {
 	t.Parallel()
 
@@ -193,6 +216,77 @@ func TestNewEthereumClient(t *testing.T) {
 		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
 		assert.True(t, strings.Contains(err.Error(), "for args.AllowedDelta"))
 	})
+	t.Run("invalid RPCRetryDelay should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.RPCMaxRetries = 1
+		args.RPCRetryDelay = 0
+
+		c, err := NewEthereumClient(args)
+
+		assert.True(t, check.IfNil(c))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "for args.RPCRetryDelay"))
+	})
+	t.Run("zero MaxDepositsPerBatch should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.MaxDepositsPerBatch = 0
+
+		c, err := NewEthereumClient(args)
+
+		assert.False(t, check.IfNil(c))
+		assert.Nil(t, err)
+	})
+	t.Run("nil transfer metrics should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.TransferMetrics = nil
+
+		c, err := NewEthereumClient(args)
+
+		assert.True(t, check.IfNil(c))
+		assert.Equal(t, errNilTransferMetrics, err)
+	})
+	t.Run("nil maximum gas price should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.MaximumGasPrice = nil
+
+		c, err := NewEthereumClient(args)
+
+		assert.True(t, check.IfNil(c))
+		assert.Equal(t, errNilMaximumGasPrice, err)
+	})
+	t.Run("negative maximum gas price should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.MaximumGasPrice = big.NewInt(-1)
+
+		c, err := NewEthereumClient(args)
+
+		assert.True(t, check.IfNil(c))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "for args.MaximumGasPrice"))
+	})
+	t.Run("invalid maximum transfer amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		token := testsCommon.CreateRandomEthereumAddress()
+		args := createMockEthereumClientArgs()
+		args.MaxTransferAmounts = map[common.Address]*big.Int{token: big.NewInt(0)}
+
+		c, err := NewEthereumClient(args)
+
+		assert.True(t, check.IfNil(c))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "for args.MaxTransferAmounts, ERC20 token "+token.String()))
+	})
 	t.Run("should work", func(t *testing.T) {
 		args := createMockEthereumClientArgs()
 		c, err := NewEthereumClient(args)
@@ -211,7 +305,7 @@ func TestClient_GetBatch(t *testing.T) {
 
 	t.Run("error while getting batch", func(t *testing.T) {
 		c.clientWrapper = &bridgeTests.EthereumClientWrapperStub{
-			GetBatchCalled: func(ctx context.Context, batchNonce *big.Int) (contract.Batch, error) {
+			GetBatchCalled: func(ctx context.Context, batchNonce *big.Int, _ *big.Int) (contract.Batch, error) {
 				return contract.Batch{}, expectedErr
 			},
 		}
@@ -221,13 +315,13 @@ func TestClient_GetBatch(t *testing.T) {
 	})
 	t.Run("error while getting deposits", func(t *testing.T) {
 		c.clientWrapper = &bridgeTests.EthereumClientWrapperStub{
-			GetBatchCalled: func(ctx context.Context, batchNonce *big.Int) (contract.Batch, error) {
+			GetBatchCalled: func(ctx context.Context, batchNonce *big.Int, _ *big.Int) (contract.Batch, error) {
 				return contract.Batch{
 					Nonce:         batchNonce,
 					DepositsCount: 2,
 				}, nil
 			},
-			GetBatchDepositsCalled: func(ctx context.Context, batchNonce *big.Int) ([]contract.Deposit, error) {
+			GetBatchDepositsCalled: func(ctx context.Context, batchNonce *big.Int, _ *big.Int) ([]contract.Deposit, error) {
 				return nil, expectedErr
 			},
 		}
@@ -235,15 +329,185 @@ func TestClient_GetBatch(t *testing.T) {
 		assert.Nil(t, batch)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("too many deposits should error", func(t *testing.T) {
+		getBatchDepositsCalled := false
+		c.clientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			GetBatchCalled: func(ctx context.Context, batchNonce *big.Int, _ *big.Int) (contract.Batch, error) {
+				return contract.Batch{
+					Nonce:         batchNonce,
+					DepositsCount: uint16(args.MaxDepositsPerBatch + 1),
+				}, nil
+			},
+			GetBatchDepositsCalled: func(ctx context.Context, batchNonce *big.Int, _ *big.Int) ([]contract.Deposit, error) {
+				getBatchDepositsCalled = true
+				return nil, nil
+			},
+		}
+		batch, err := c.GetBatch(context.Background(), 1)
+		assert.Nil(t, batch)
+		assert.True(t, errors.Is(err, errTooManyDepositsInBatch))
+		assert.True(t, strings.Contains(err.Error(), "batch.DepositsCount: 11, maximum: 10"))
+		assert.False(t, getBatchDepositsCalled)
+	})
+	t.Run("maximum number of deposits should work", func(t *testing.T) {
+		deposits := make([]contract.Deposit, args.MaxDepositsPerBatch)
+		for i := range deposits {
+			deposits[i] = contract.Deposit{
+				Nonce:  big.NewInt(int64(i)),
+				Amount: big.NewInt(1),
+			}
+		}
+		c.clientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			GetBatchCalled: func(ctx context.Context, batchNonce *big.Int, _ *big.Int) (contract.Batch, error) {
+				return contract.Batch{
+					Nonce:         batchNonce,
+					DepositsCount: uint16(args.MaxDepositsPerBatch),
+				}, nil
+			},
+			GetBatchDepositsCalled: func(ctx context.Context, batchNonce *big.Int, _ *big.Int) ([]contract.Deposit, error) {
+				return deposits, nil
+			},
+		}
+		batch, err := c.GetBatch(context.Background(), 1)
+		assert.Nil(t, err)
+		assert.Equal(t, int(args.MaxDepositsPerBatch), len(batch.Deposits))
+	})
+	t.Run("zero maximum should not limit the number of deposits", func(t *testing.T) {
+		argsNoLimit := createMockEthereumClientArgs()
+		argsNoLimit.MaxDepositsPerBatch = 0
+		cNoLimit, _ := NewEthereumClient(argsNoLimit)
+
+		numDeposits := args.MaxDepositsPerBatch + 1
+		deposits := make([]contract.Deposit, numDeposits)
+		for i := range deposits {
+			deposits[i] = contract.Deposit{
+				Nonce:  big.NewInt(int64(i)),
+				Amount: big.NewInt(1),
+			}
+		}
+		cNoLimit.clientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			GetBatchCalled: func(ctx context.Context, batchNonce *big.Int, _ *big.Int) (contract.Batch, error) {
+				return contract.Batch{
+					Nonce:         batchNonce,
+					DepositsCount: uint16(numDeposits),
+				}, nil
+			},
+			GetBatchDepositsCalled: func(ctx context.Context, batchNonce *big.Int, _ *big.Int) ([]contract.Deposit, error) {
+				return deposits, nil
+			},
+		}
+		batch, err := cNoLimit.GetBatch(context.Background(), 1)
+		assert.Nil(t, err)
+		assert.Equal(t, int(numDeposits), len(batch.Deposits))
+	})
+	t.Run("stale batch should be returned with a warning", func(t *testing.T) {
+		t.Parallel()
+
+		createClient := func(maxBatchAgeInBlocks uint64, currentBlock uint64, numWarnings *int) *client {
+			argsClient := createMockEthereumClientArgs()
+			argsClient.MaxBatchAgeInBlocks = maxBatchAgeInBlocks
+			argsClient.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+				GetBatchCalled: func(ctx context.Context, batchNonce *big.Int, _ *big.Int) (contract.Batch, error) {
+					return contract.Batch{
+						Nonce:       batchNonce,
+						BlockNumber: 1000,
+					}, nil
+				},
+				GetBatchDepositsCalled: func(ctx context.Context, batchNonce *big.Int, _ *big.Int) ([]contract.Deposit, error) {
+					return make([]contract.Deposit, 0), nil
+				},
+				BlockNumberCalled: func(ctx context.Context) (uint64, error) {
+					return currentBlock, nil
+				},
+			}
+			ethClient, _ := NewEthereumClient(argsClient)
+			ethClient.log = &testsCommon.LoggerStub{
+				WarnCalled: func(message string, args ...interface{}) {
+					*numWarnings++
+				},
+			}
+
+			return ethClient
+		}
+
+		numWarnings := 0
+		batch, err := createClient(100, 1101, &numWarnings).GetBatch(context.Background(), 1)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1000), batch.BlockNumber)
+		assert.Equal(t, 1, numWarnings)
+
+		numWarnings = 0
+		batch, err = createClient(100, 1100, &numWarnings).GetBatch(context.Background(), 1)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1000), batch.BlockNumber)
+		assert.Equal(t, 0, numWarnings)
+
+		numWarnings = 0
+		batch, err = createClient(0, 1000000, &numWarnings).GetBatch(context.Background(), 1)
+		assert.Nil(t, err)
+		assert.NotNil(t, batch)
+		assert.Equal(t, 0, numWarnings)
+	})
+	t.Run("finality blocks", func(t *testing.T) {
+		t.Parallel()
+
+		createClient := func(finalityBlocks uint64, currentBlock uint64, readBlocks *[]*big.Int) *client {
+			argsClient := createMockEthereumClientArgs()
+			argsClient.FinalityBlocks = finalityBlocks
+			argsClient.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+				GetBatchCalled: func(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) (contract.Batch, error) {
+					*readBlocks = append(*readBlocks, blockNumber)
+					return contract.Batch{
+						Nonce: batchNonce,
+					}, nil
+				},
+				GetBatchDepositsCalled: func(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) ([]contract.Deposit, error) {
+					*readBlocks = append(*readBlocks, blockNumber)
+					return make([]contract.Deposit, 0), nil
+				},
+				BlockNumberCalled: func(ctx context.Context) (uint64, error) {
+					return currentBlock, nil
+				},
+			}
+			ethClient, _ := NewEthereumClient(argsClient)
+
+			return ethClient
+		}
+
+		readBlocks := make([]*big.Int, 0)
+		batch, err := createClient(12, 1000, &readBlocks).GetBatch(context.Background(), 1)
+		assert.Nil(t, err)
+		assert.NotNil(t, batch)
+		assert.Equal(t, []*big.Int{big.NewInt(988), big.NewInt(988)}, readBlocks)
+
+		readBlocks = make([]*big.Int, 0)
+		batch, err = createClient(12, 12, &readBlocks).GetBatch(context.Background(), 1)
+		assert.Nil(t, err)
+		assert.NotNil(t, batch)
+		assert.Equal(t, []*big.Int{big.NewInt(0), big.NewInt(0)}, readBlocks)
+
+		readBlocks = make([]*big.Int, 0)
+		batch, err = createClient(12, 11, &readBlocks).GetBatch(context.Background(), 1)
+		assert.Nil(t, batch)
+		assert.True(t, errors.Is(err, errNotEnoughBlocksForFinality))
+		assert.True(t, strings.Contains(err.Error(), "latest block: 11, finality blocks: 12"))
+		assert.Empty(t, readBlocks)
+
+		readBlocks = make([]*big.Int, 0)
+		batch, err = createClient(0, 1000, &readBlocks).GetBatch(context.Background(), 1)
+		assert.Nil(t, err)
+		assert.NotNil(t, batch)
+		assert.Equal(t, []*big.Int{nil, nil}, readBlocks)
+	})
 	t.Run("deposits mismatch - with 0", func(t *testing.T) {
 		c.clientWrapper = &bridgeTests.EthereumClientWrapperStub{
-			GetBatchCalled: func(ctx context.Context, batchNonce *big.Int) (contract.Batch, error) {
+			GetBatchCalled: func(ctx context.Context, batchNonce *big.Int, _ *big.Int) (contract.Batch, error) {
 				return contract.Batch{
 					Nonce:         batchNonce,
 					DepositsCount: 2,
 				}, nil
 			},
-			GetBatchDepositsCalled: func(ctx context.Context, batchNonce *big.Int) ([]contract.Deposit, error) {
+			GetBatchDepositsCalled: func(ctx context.Context, batchNonce *big.Int, _ *big.Int) ([]contract.Deposit, error) {
 				return make([]contract.Deposit, 0), nil
 			},
 		}
@@ -254,13 +518,13 @@ func TestClient_GetBatch(t *testing.T) {
 	})
 	t.Run("deposits mismatch - with non zero value", func(t *testing.T) {
 		c.clientWrapper = &bridgeTests.EthereumClientWrapperStub{
-			GetBatchCalled: func(ctx context.Context, batchNonce *big.Int) (contract.Batch, error) {
+			GetBatchCalled: func(ctx context.Context, batchNonce *big.Int, _ *big.Int) (contract.Batch, error) {
 				return contract.Batch{
 					Nonce:         batchNonce,
 					DepositsCount: 2,
 				}, nil
 			},
-			GetBatchDepositsCalled: func(ctx context.Context, batchNonce *big.Int) ([]contract.Deposit, error) {
+			GetBatchDepositsCalled: func(ctx context.Context, batchNonce *big.Int, _ *big.Int) ([]contract.Deposit, error) {
 				return []contract.Deposit{
 					{
 						Nonce: big.NewInt(22),
@@ -283,15 +547,15 @@ func TestClient_GetBatch(t *testing.T) {
 		recipient2 := testsCommon.CreateRandomElrondAddress()
 
 		c.clientWrapper = &bridgeTests.EthereumClientWrapperStub{
-			GetBatchCalled: func(ctx context.Context, batchNonce *big.Int) (contract.Batch, error) {
+			GetBatchCalled: func(ctx context.Context, batchNonce *big.Int, _ *big.Int) (contract.Batch, error) {
 				return contract.Batch{
 					Nonce:                  big.NewInt(112243),
-					BlockNumber:            0,
+					BlockNumber:            7788,
 					LastUpdatedBlockNumber: 0,
 					DepositsCount:          2,
 				}, nil
 			},
-			GetBatchDepositsCalled: func(ctx context.Context, batchNonce *big.Int) ([]contract.Deposit, error) {
+			GetBatchDepositsCalled: func(ctx context.Context, batchNonce *big.Int, _ *big.Int) ([]contract.Deposit, error) {
 				return []contract.Deposit{
 					{
 						Nonce:        big.NewInt(10),
@@ -312,7 +576,8 @@ func TestClient_GetBatch(t *testing.T) {
 		}
 
 		expectedBatch := &clients.TransferBatch{
-			ID: 112243,
+			ID:          112243,
+			BlockNumber: 7788,
 			Deposits: []*clients.DepositTransfer{
 				{
 					Nonce:               10,
@@ -347,19 +612,197 @@ func TestClient_GetBatch(t *testing.T) {
 
 }
 
+func TestClient_GetBatchSortDepositsByNonce(t *testing.T) {
+	t.Parallel()
+
+	createClient := func(sortDepositsByNonce bool, nonces ...int64) *client {
+		deposits := make([]contract.Deposit, 0, len(nonces))
+		for i, nonce := range nonces {
+			deposits = append(deposits, contract.Deposit{
+				Nonce:  big.NewInt(nonce),
+				Amount: big.NewInt(int64(i + 1)),
+			})
+		}
+
+		args := createMockEthereumClientArgs()
+		args.SortDepositsByNonce = sortDepositsByNonce
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			GetBatchCalled: func(ctx context.Context, batchNonce *big.Int, _ *big.Int) (contract.Batch, error) {
+				return contract.Batch{
+					Nonce:         batchNonce,
+					DepositsCount: uint16(len(deposits)),
+				}, nil
+			},
+			GetBatchDepositsCalled: func(ctx context.Context, batchNonce *big.Int, _ *big.Int) ([]contract.Deposit, error) {
+				return deposits, nil
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		return c
+	}
+	depositNonces := func(batch *clients.TransferBatch) []uint64 {
+		nonces := make([]uint64, 0, len(batch.Deposits))
+		for _, deposit := range batch.Deposits {
+			nonces = append(nonces, deposit.Nonce)
+		}
+
+		return nonces
+	}
+
+	t.Run("sorting disabled should keep the contract order", func(t *testing.T) {
+		t.Parallel()
+
+		batch, err := createClient(false, 5, 3, 4, 3, 9).GetBatch(context.Background(), 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []uint64{5, 3, 4, 3, 9}, depositNonces(batch))
+	})
+	t.Run("shuffled nonces should be sorted", func(t *testing.T) {
+		t.Parallel()
+
+		batch, err := createClient(true, 7, 5, 8, 6).GetBatch(context.Background(), 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []uint64{5, 6, 7, 8}, depositNonces(batch))
+		// the deposits data should move along with their nonces
+		assert.Equal(t, big.NewInt(2), batch.Deposits[0].Amount)
+		assert.Equal(t, big.NewInt(1), batch.Deposits[2].Amount)
+		assert.Equal(t, 4, len(batch.Statuses))
+	})
+	t.Run("gapped nonces should error", func(t *testing.T) {
+		t.Parallel()
+
+		batch, err := createClient(true, 7, 5, 9, 6).GetBatch(context.Background(), 1)
+		assert.Nil(t, batch)
+		assert.True(t, errors.Is(err, errDepositNoncesGap))
+		assert.True(t, strings.Contains(err.Error(), "batch ID: 1, deposit nonce 7 is followed by 9"))
+	})
+	t.Run("duplicated nonces should error", func(t *testing.T) {
+		t.Parallel()
+
+		batch, err := createClient(true, 6, 5, 6).GetBatch(context.Background(), 1)
+		assert.Nil(t, batch)
+		assert.True(t, errors.Is(err, errDuplicatedDepositNonce))
+		assert.True(t, strings.Contains(err.Error(), "batch ID: 1, deposit nonce: 6"))
+	})
+	t.Run("single deposit should work", func(t *testing.T) {
+		t.Parallel()
+
+		batch, err := createClient(true, 42).GetBatch(context.Background(), 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []uint64{42}, depositNonces(batch))
+	})
+}
+
+func TestClient_VerifyBatchStillValid(t *testing.T) {
+	t.Parallel()
+
+	from := testsCommon.CreateRandomEthereumAddress()
+	token := testsCommon.CreateRandomEthereumAddress()
+	recipient := testsCommon.CreateRandomElrondAddress()
+	createClient := func(blockNumber uint64, amount int64) *client {
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			GetBatchCalled: func(ctx context.Context, batchNonce *big.Int, _ *big.Int) (contract.Batch, error) {
+				return contract.Batch{
+					Nonce:         big.NewInt(112243),
+					BlockNumber:   blockNumber,
+					DepositsCount: 1,
+				}, nil
+			},
+			GetBatchDepositsCalled: func(ctx context.Context, batchNonce *big.Int, _ *big.Int) ([]contract.Deposit, error) {
+				return []contract.Deposit{
+					{
+						Nonce:        big.NewInt(10),
+						TokenAddress: token,
+						Amount:       big.NewInt(amount),
+						Depositor:    from,
+						Recipient:    recipient.AddressSlice(),
+					},
+				}, nil
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		return c
+	}
+
+	t.Run("nil batch should error", func(t *testing.T) {
+		c := createClient(7788, 20)
+
+		isValid, err := c.VerifyBatchStillValid(context.Background(), nil)
+		assert.False(t, isValid)
+		assert.Equal(t, clients.ErrNilBatch, err)
+	})
+	t.Run("error while getting batch should error", func(t *testing.T) {
+		c := createClient(7788, 20)
+		expectedErr := errors.New("expected error")
+		c.clientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			GetBatchCalled: func(ctx context.Context, batchNonce *big.Int, _ *big.Int) (contract.Batch, error) {
+				return contract.Batch{}, expectedErr
+			},
+		}
+
+		isValid, err := c.VerifyBatchStillValid(context.Background(), &clients.TransferBatch{ID: 112243})
+		assert.False(t, isValid)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("unchanged batch should be valid", func(t *testing.T) {
+		c := createClient(7788, 20)
+		batch, err := c.GetBatch(context.Background(), 112243)
+		require.Nil(t, err)
+
+		isValid, err := c.VerifyBatchStillValid(context.Background(), batch)
+		assert.True(t, isValid)
+		assert.Nil(t, err)
+	})
+	t.Run("batch included in another block should not be valid", func(t *testing.T) {
+		batch, err := createClient(7788, 20).GetBatch(context.Background(), 112243)
+		require.Nil(t, err)
+
+		isValid, err := createClient(7789, 20).VerifyBatchStillValid(context.Background(), batch)
+		assert.False(t, isValid)
+		assert.Nil(t, err)
+	})
+	t.Run("batch with changed deposits should not be valid", func(t *testing.T) {
+		batch, err := createClient(7788, 20).GetBatch(context.Background(), 112243)
+		require.Nil(t, err)
+
+		isValid, err := createClient(7788, 21).VerifyBatchStillValid(context.Background(), batch)
+		assert.False(t, isValid)
+		assert.Nil(t, err)
+	})
+}
+
 func TestClient_GenerateMessageHash(t *testing.T) {
 	t.Parallel()
 
 	args := createMockEthereumClientArgs()
 	batch := createMockTransferBatch()
 
+	t.Run("done context should error", func(t *testing.T) {
+		c, _ := NewEthereumClient(args)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		h, err := c.GenerateMessageHash(ctx, batch)
+
+		assert.Equal(t, common.Hash{}, h)
+		assert.Equal(t, context.Canceled, err)
+	})
 	t.Run("nil batch should error", func(t *testing.T) {
 		c, _ := NewEthereumClient(args)
-		h, err := c.GenerateMessageHash(nil)
+		h, err := c.GenerateMessageHash(context.Background(), nil)
 
 		assert.Equal(t, common.Hash{}, h)
 		assert.True(t, errors.Is(err, clients.ErrNilBatch))
 	})
+	t.Run("empty batch should error", func(t *testing.T) {
+		c, _ := NewEthereumClient(args)
+		h, err := c.GenerateMessageHash(context.Background(), &clients.TransferBatch{ID: 3})
+
+		assert.Equal(t, common.Hash{}, h)
+		assert.True(t, errors.Is(err, errEmptyBatch))
+		assert.True(t, strings.Contains(err.Error(), "batch ID: 3"))
+	})
 	t.Run("should work", func(t *testing.T) {
 		c, _ := NewEthereumClient(args)
 		argLists, _ := c.extractList(batch)
@@ -368,10 +811,115 @@ func TestClient_GenerateMessageHash(t *testing.T) {
 		assert.Equal(t, expectedRecipients, argLists.recipients)
 		assert.Equal(t, expectedNonces, argLists.nonces)
 
-		h, err := c.GenerateMessageHash(batch)
+		h, err := c.GenerateMessageHash(context.Background(), batch)
 		assert.Nil(t, err)
 		assert.Equal(t, "c68190e0a3b8d7c6bd966272a11d618ceddc4b38662b0a1610621f4d30ec07ca", hex.EncodeToString(h.Bytes()))
 	})
+	t.Run("unsupported token should error", func(t *testing.T) {
+		argsSupportedTokens := createMockEthereumClientArgs()
+		argsSupportedTokens.SupportedTokens = []common.Address{common.BytesToAddress([]byte("ERC20token1"))}
+		c, _ := NewEthereumClient(argsSupportedTokens)
+
+		h, err := c.GenerateMessageHash(context.Background(), batch)
+		assert.Equal(t, common.Hash{}, h)
+		assert.True(t, errors.Is(err, errUnsupportedToken))
+	})
+}
+
+func TestClient_ExtractListWithSupportedTokens(t *testing.T) {
+	t.Parallel()
+
+	batch := createMockTransferBatch()
+	token1 := common.BytesToAddress([]byte("ERC20token1"))
+	token2 := common.BytesToAddress([]byte("ERC20token2"))
+
+	t.Run("empty supported tokens should allow all tokens", func(t *testing.T) {
+		c, _ := NewEthereumClient(createMockEthereumClientArgs())
+		argLists, err := c.extractList(batch)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedTokens, argLists.tokens)
+	})
+	t.Run("unlisted token should error", func(t *testing.T) {
+		args := createMockEthereumClientArgs()
+		args.SupportedTokens = []common.Address{token1}
+		c, _ := NewEthereumClient(args)
+
+		argLists, err := c.extractList(batch)
+		assert.True(t, errors.Is(err, errUnsupportedToken))
+		assert.True(t, strings.Contains(err.Error(), token2.String()))
+		assert.True(t, strings.Contains(err.Error(), "deposit nonce 30"))
+		assert.Empty(t, argLists.tokens)
+	})
+	t.Run("all tokens listed should work", func(t *testing.T) {
+		args := createMockEthereumClientArgs()
+		args.SupportedTokens = []common.Address{token1, token2}
+		c, _ := NewEthereumClient(args)
+
+		argLists, err := c.extractList(batch)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedTokens, argLists.tokens)
+	})
+}
+
+func TestClient_ExtractListWithTransferAmountsChecks(t *testing.T) {
+	t.Parallel()
+
+	token1 := common.BytesToAddress([]byte("ERC20token1"))
+	token2 := common.BytesToAddress([]byte("ERC20token2"))
+	testInvalidAmount := func(t *testing.T, amount *big.Int) {
+		batch := createMockTransferBatch()
+		batch.Deposits[1].Amount = amount
+		c, _ := NewEthereumClient(createMockEthereumClientArgs())
+
+		argLists, err := c.extractList(batch)
+		assert.True(t, errors.Is(err, errInvalidTransferAmount))
+		assert.True(t, strings.Contains(err.Error(), "deposit nonce 30 in batch 332"))
+		assert.Empty(t, argLists.amounts)
+	}
+
+	t.Run("zero amount should error", func(t *testing.T) {
+		testInvalidAmount(t, big.NewInt(0))
+	})
+	t.Run("negative amount should error", func(t *testing.T) {
+		testInvalidAmount(t, big.NewInt(-1))
+	})
+	t.Run("nil amount should error", func(t *testing.T) {
+		testInvalidAmount(t, nil)
+	})
+	t.Run("amount above the token maximum should error", func(t *testing.T) {
+		args := createMockEthereumClientArgs()
+		args.MaxTransferAmounts = map[common.Address]*big.Int{
+			token1: big.NewInt(100),
+			token2: big.NewInt(39),
+		}
+		c, _ := NewEthereumClient(args)
+
+		argLists, err := c.extractList(createMockTransferBatch())
+		assert.True(t, errors.Is(err, errTransferAmountAboveMaximum))
+		assert.True(t, strings.Contains(err.Error(), "40 for deposit nonce 30 in batch 332"))
+		assert.True(t, strings.Contains(err.Error(), token2.String()+", maximum: 39"))
+		assert.Empty(t, argLists.amounts)
+	})
+	t.Run("amounts up to the token maximum should work", func(t *testing.T) {
+		args := createMockEthereumClientArgs()
+		args.MaxTransferAmounts = map[common.Address]*big.Int{
+			token2: big.NewInt(40),
+		}
+		c, _ := NewEthereumClient(args)
+
+		argLists, err := c.extractList(createMockTransferBatch())
+		assert.Nil(t, err)
+		assert.Equal(t, expectedAmounts, argLists.amounts)
+	})
+	t.Run("invalid amount should prevent signing", func(t *testing.T) {
+		batch := createMockTransferBatch()
+		batch.Deposits[0].Amount = big.NewInt(0)
+		c, _ := NewEthereumClient(createMockEthereumClientArgs())
+
+		h, err := c.GenerateMessageHash(context.Background(), batch)
+		assert.Equal(t, common.Hash{}, h)
+		assert.True(t, errors.Is(err, errInvalidTransferAmount))
+	})
 }
 
 func TestClient_BroadcastSignatureForMessageHash(t *testing.T) {
@@ -390,10 +938,39 @@ func TestClient_BroadcastSignatureForMessageHash(t *testing.T) {
 		},
 	}
 
-	c, _ := NewEthereumClient(args)
-	c.BroadcastSignatureForMessageHash(hash)
-
-	assert.True(t, broadcastCalled)
+	t.Run("should work", func(t *testing.T) {
+		c, _ := NewEthereumClient(args)
+		err := c.BroadcastSignatureForMessageHash(hash)
+
+		assert.Nil(t, err)
+		assert.True(t, broadcastCalled)
+	})
+	t.Run("signing error should not broadcast", func(t *testing.T) {
+		broadcastCalled = false
+		c, _ := NewEthereumClient(args)
+		invalidPrivateKey := *c.privateKey
+		invalidPrivateKey.D = big.NewInt(0)
+		c.privateKey = &invalidPrivateKey
+
+		err := c.BroadcastSignatureForMessageHash(hash)
+
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), hash.String()))
+		assert.False(t, broadcastCalled)
+	})
+	t.Run("signature not matching the relayer address should not broadcast", func(t *testing.T) {
+		broadcastCalled = false
+		c, _ := NewEthereumClient(args)
+		otherPrivateKey, err := crypto.GenerateKey()
+		require.Nil(t, err)
+		c.privateKey = otherPrivateKey
+
+		err = c.BroadcastSignatureForMessageHash(hash)
+
+		assert.True(t, errors.Is(err, errSignatureVerificationFailed))
+		assert.True(t, strings.Contains(err.Error(), crypto.PubkeyToAddress(otherPrivateKey.PublicKey).String()))
+		assert.False(t, broadcastCalled)
+	})
 }
 
 func TestClient_WasExecuted(t *testing.T) {
@@ -402,7 +979,7 @@ func TestClient_WasExecuted(t *testing.T) {
 	wasCalled := false
 	args := createMockEthereumClientArgs()
 	args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
-		WasBatchExecutedCalled: func(ctx context.Context, batchNonce *big.Int) (bool, error) {
+		WasBatchExecutedCalled: func(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) (bool, error) {
 			wasCalled = true
 			return true, nil
 		},
@@ -415,14 +992,111 @@ func TestClient_WasExecuted(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestClient_WasExecutedWithConfirmations(t *testing.T) {
+	t.Parallel()
+
+	// the batch is executed in block 100
+	executionBlock := uint64(100)
+	createClient := func(latestBlock uint64, confirmations uint64, queriedBlock **big.Int) *client {
+		args := createMockEthereumClientArgs()
+		args.ExecutionConfirmations = confirmations
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			BlockNumberCalled: func(ctx context.Context) (uint64, error) {
+				return latestBlock, nil
+			},
+			WasBatchExecutedCalled: func(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) (bool, error) {
+				*queriedBlock = blockNumber
+				if blockNumber == nil {
+					return latestBlock >= executionBlock, nil
+				}
+
+				return blockNumber.Uint64() >= executionBlock, nil
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		return c
+	}
+
+	t.Run("no confirmations should check the latest block", func(t *testing.T) {
+		t.Parallel()
+
+		var queriedBlock *big.Int
+		c := createClient(executionBlock, 0, &queriedBlock)
+
+		wasExecuted, err := c.WasExecuted(context.Background(), 1)
+		assert.Nil(t, err)
+		assert.True(t, wasExecuted)
+		assert.Nil(t, queriedBlock)
+	})
+	t.Run("execution not buried enough should return false", func(t *testing.T) {
+		t.Parallel()
+
+		var queriedBlock *big.Int
+		c := createClient(executionBlock+4, 5, &queriedBlock)
+
+		wasExecuted, err := c.WasExecuted(context.Background(), 1)
+		assert.Nil(t, err)
+		assert.False(t, wasExecuted)
+		assert.Equal(t, big.NewInt(99), queriedBlock)
+	})
+	t.Run("execution buried enough should return true", func(t *testing.T) {
+		t.Parallel()
+
+		var queriedBlock *big.Int
+		c := createClient(executionBlock+5, 5, &queriedBlock)
+
+		wasExecuted, err := c.WasExecuted(context.Background(), 1)
+		assert.Nil(t, err)
+		assert.True(t, wasExecuted)
+		assert.Equal(t, big.NewInt(100), queriedBlock)
+	})
+	t.Run("not enough blocks should error", func(t *testing.T) {
+		t.Parallel()
+
+		var queriedBlock *big.Int
+		c := createClient(4, 5, &queriedBlock)
+
+		wasExecuted, err := c.WasExecuted(context.Background(), 1)
+		assert.False(t, wasExecuted)
+		assert.True(t, errors.Is(err, errNotEnoughBlocksForConfirmations))
+		assert.True(t, strings.Contains(err.Error(), "latest block: 4, execution confirmations: 5"))
+		assert.Nil(t, queriedBlock)
+	})
+	t.Run("block number error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockEthereumClientArgs()
+		args.ExecutionConfirmations = 5
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			BlockNumberCalled: func(ctx context.Context) (uint64, error) {
+				return 0, expectedErr
+			},
+			WasBatchExecutedCalled: func(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) (bool, error) {
+				assert.Fail(t, "should have not been called")
+				return false, nil
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		wasExecuted, err := c.WasExecuted(context.Background(), 1)
+		assert.False(t, wasExecuted)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+}
+
 func TestClient_ExecuteTransfer(t *testing.T) {
 	t.Parallel()
 
-	args := createMockEthereumClientArgs()
 	batch := createMockTransferBatch()
-	signatures := make([][]byte, 10)
-	for i := range signatures {
-		signatures[i] = []byte(fmt.Sprintf("sig %d", i))
+	signatures, relayers := createSignaturesAndRelayers(t, common.Hash{}, 10)
+	getRelayersHandler := func(ctx context.Context) ([]common.Address, error) {
+		return relayers, nil
+	}
+	args := createMockEthereumClientArgs()
+	args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+		GetRelayersCalled: getRelayersHandler,
 	}
 
 	t.Run("nil batch", func(t *testing.T) {
@@ -431,6 +1105,42 @@ func TestClient_ExecuteTransfer(t *testing.T) {
 		assert.Equal(t, "", hash)
 		assert.True(t, errors.Is(err, clients.ErrNilBatch))
 	})
+	t.Run("empty batch should error before any on-chain call", func(t *testing.T) {
+		argsEmptyBatch := createMockEthereumClientArgs()
+		argsEmptyBatch.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			IsPausedCalled: func(ctx context.Context) (bool, error) {
+				assert.Fail(t, "should have not been called")
+				return false, nil
+			},
+			ExecuteTransferCalled: func(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, signatures [][]byte) (*types.Transaction, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		c, _ := NewEthereumClient(argsEmptyBatch)
+		hash, err := c.ExecuteTransfer(context.Background(), common.Hash{}, &clients.TransferBatch{ID: 3}, 10)
+		assert.Equal(t, "", hash)
+		assert.True(t, errors.Is(err, errEmptyBatch))
+		assert.True(t, strings.Contains(err.Error(), "batch ID: 3"))
+	})
+	t.Run("unsupported token should error before any on-chain call", func(t *testing.T) {
+		argsSupportedTokens := createMockEthereumClientArgs()
+		argsSupportedTokens.SupportedTokens = []common.Address{common.BytesToAddress([]byte("ERC20token1"))}
+		argsSupportedTokens.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			IsPausedCalled: func(ctx context.Context) (bool, error) {
+				assert.Fail(t, "should have not been called")
+				return false, nil
+			},
+			ExecuteTransferCalled: func(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, signatures [][]byte) (*types.Transaction, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		c, _ := NewEthereumClient(argsSupportedTokens)
+		hash, err := c.ExecuteTransfer(context.Background(), common.Hash{}, batch, 10)
+		assert.Equal(t, "", hash)
+		assert.True(t, errors.Is(err, errUnsupportedToken))
+	})
 	t.Run("check if the contract is paused fails", func(t *testing.T) {
 		expectedErr := errors.New("expected error is paused")
 		c, _ := NewEthereumClient(args)
@@ -443,9 +1153,64 @@ func TestClient_ExecuteTransfer(t *testing.T) {
 		assert.Equal(t, "", hash)
 		assert.True(t, errors.Is(err, expectedErr))
 	})
-	t.Run("contract is paused should error", func(t *testing.T) {
+	t.Run("check if the batch was executed fails", func(t *testing.T) {
+		expectedErr := errors.New("expected error was executed")
+		argsCheckExecuted := createMockEthereumClientArgs()
+		argsCheckExecuted.CheckExecutedBeforeSend = true
+		argsCheckExecuted.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			WasBatchExecutedCalled: func(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) (bool, error) {
+				return false, expectedErr
+			},
+		}
+		c, _ := NewEthereumClient(argsCheckExecuted)
+		hash, err := c.ExecuteTransfer(context.Background(), common.Hash{}, batch, 10)
+		assert.Equal(t, "", hash)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("already executed batch should error before sending", func(t *testing.T) {
+		argsCheckExecuted := createMockEthereumClientArgs()
+		argsCheckExecuted.CheckExecutedBeforeSend = true
+		argsCheckExecuted.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			WasBatchExecutedCalled: func(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) (bool, error) {
+				assert.Equal(t, batch.ID, batchNonce.Uint64())
+				return true, nil
+			},
+			ExecuteTransferCalled: func(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, signatures [][]byte) (*types.Transaction, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		c, _ := NewEthereumClient(argsCheckExecuted)
+		hash, err := c.ExecuteTransfer(context.Background(), common.Hash{}, batch, 10)
+		assert.Equal(t, "", hash)
+		assert.True(t, errors.Is(err, clients.ErrBatchAlreadyExecuted))
+	})
+	t.Run("execution confirmations should check the latest block even if the check is disabled", func(t *testing.T) {
+		argsConfirmations := createMockEthereumClientArgs()
+		argsConfirmations.CheckExecutedBeforeSend = false
+		argsConfirmations.ExecutionConfirmations = 5
+		argsConfirmations.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			WasBatchExecutedCalled: func(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) (bool, error) {
+				assert.Nil(t, blockNumber)
+				return true, nil
+			},
+			ExecuteTransferCalled: func(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, signatures [][]byte) (*types.Transaction, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		c, _ := NewEthereumClient(argsConfirmations)
+		hash, err := c.ExecuteTransfer(context.Background(), common.Hash{}, batch, 10)
+		assert.Equal(t, "", hash)
+		assert.True(t, errors.Is(err, clients.ErrBatchAlreadyExecuted))
+	})
+	t.Run("disabled check should not query the batch execution", func(t *testing.T) {
 		c, _ := NewEthereumClient(args)
 		c.clientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			WasBatchExecutedCalled: func(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) (bool, error) {
+				assert.Fail(t, "should have not been called")
+				return true, nil
+			},
 			IsPausedCalled: func(ctx context.Context) (bool, error) {
 				return true, nil
 			},
@@ -454,23 +1219,29 @@ func TestClient_ExecuteTransfer(t *testing.T) {
 		assert.Equal(t, "", hash)
 		assert.True(t, errors.Is(err, clients.ErrMultisigContractPaused))
 	})
-	t.Run("get block number fails", func(t *testing.T) {
-		expectedErr := errors.New("expected error get block number")
+	t.Run("contract is paused should error", func(t *testing.T) {
 		c, _ := NewEthereumClient(args)
 		c.clientWrapper = &bridgeTests.EthereumClientWrapperStub{
-			BlockNumberCalled: func(ctx context.Context) (uint64, error) {
-				return 0, expectedErr
+			IsPausedCalled: func(ctx context.Context) (bool, error) {
+				return true, nil
+			},
+		}
+		wasWarned := false
+		c.log = &testsCommon.LoggerStub{
+			WarnCalled: func(message string, args ...interface{}) {
+				wasWarned = true
 			},
 		}
 		hash, err := c.ExecuteTransfer(context.Background(), common.Hash{}, batch, 10)
 		assert.Equal(t, "", hash)
-		assert.True(t, errors.Is(err, expectedErr))
+		assert.True(t, errors.Is(err, clients.ErrMultisigContractPaused))
+		assert.True(t, wasWarned)
 	})
-	t.Run("get nonce fails", func(t *testing.T) {
-		expectedErr := errors.New("expected error get nonce")
+	t.Run("get pending nonce fails", func(t *testing.T) {
+		expectedErr := errors.New("expected error get pending nonce")
 		c, _ := NewEthereumClient(args)
 		c.clientWrapper = &bridgeTests.EthereumClientWrapperStub{
-			NonceAtCalled: func(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+			PendingNonceAtCalled: func(ctx context.Context, account common.Address) (uint64, error) {
 				return 0, expectedErr
 			},
 		}
@@ -494,7 +1265,7 @@ func TestClient_ExecuteTransfer(t *testing.T) {
 		expectedErr := errors.New("expected error get current gas price")
 		c, _ := NewEthereumClient(args)
 		c.gasHandler = &testsCommon.GasHandlerStub{
-			GetCurrentGasPriceCalled: func() (*big.Int, error) {
+			GetCurrentGasPriceWithContextCalled: func(ctx context.Context) (*big.Int, error) {
 				return nil, expectedErr
 			},
 		}
@@ -502,6 +1273,68 @@ func TestClient_ExecuteTransfer(t *testing.T) {
 		assert.Equal(t, "", hash)
 		assert.True(t, errors.Is(err, expectedErr))
 	})
+	t.Run("get current gas price should receive the transfer context", func(t *testing.T) {
+		type contextKey struct{}
+		ctx := context.WithValue(context.Background(), contextKey{}, "value")
+		c, _ := NewEthereumClient(args)
+		c.gasHandler = &testsCommon.GasHandlerStub{
+			GetCurrentGasPriceWithContextCalled: func(providedCtx context.Context) (*big.Int, error) {
+				assert.Equal(t, "value", providedCtx.Value(contextKey{}))
+				return nil, providedCtx.Err()
+			},
+		}
+		cancelledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		hash, err := c.ExecuteTransfer(cancelledCtx, common.Hash{}, batch, 10)
+		assert.Equal(t, "", hash)
+		assert.NotNil(t, err)
+	})
+	t.Run("nil gas price should error", func(t *testing.T) {
+		c, _ := NewEthereumClient(args)
+		c.gasHandler = &testsCommon.GasHandlerStub{
+			GetCurrentGasPriceWithContextCalled: func(ctx context.Context) (*big.Int, error) {
+				return nil, nil
+			},
+		}
+		hash, err := c.ExecuteTransfer(context.Background(), common.Hash{}, batch, 10)
+		assert.Equal(t, "", hash)
+		assert.True(t, errors.Is(err, errNilGasPrice))
+	})
+	t.Run("negative gas price should error", func(t *testing.T) {
+		c, _ := NewEthereumClient(args)
+		c.gasHandler = &testsCommon.GasHandlerStub{
+			GetCurrentGasPriceWithContextCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(-1), nil
+			},
+		}
+		hash, err := c.ExecuteTransfer(context.Background(), common.Hash{}, batch, 10)
+		assert.Equal(t, "", hash)
+		assert.True(t, errors.Is(err, errInvalidGasPrice))
+		assert.True(t, strings.Contains(err.Error(), "got: -1"))
+	})
+	t.Run("zero gas price should warn and continue", func(t *testing.T) {
+		c, _ := NewEthereumClient(args)
+		c.gasHandler = &testsCommon.GasHandlerStub{
+			GetCurrentGasPriceWithContextCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(0), nil
+			},
+		}
+		wasWarned := false
+		c.log = &testsCommon.LoggerStub{
+			WarnCalled: func(message string, args ...interface{}) {
+				wasWarned = true
+			},
+		}
+		c.signatureHolder = &testsCommon.SignaturesHolderStub{
+			SignaturesCalled: func(messageHash []byte) [][]byte {
+				return signatures[:9]
+			},
+		}
+		hash, err := c.ExecuteTransfer(context.Background(), common.Hash{}, batch, 10)
+		assert.Equal(t, "", hash)
+		assert.True(t, errors.Is(err, errQuorumNotReached))
+		assert.True(t, wasWarned)
+	})
 	t.Run("not enough quorum", func(t *testing.T) {
 		c, _ := NewEthereumClient(args)
 		c.signatureHolder = &testsCommon.SignaturesHolderStub{
@@ -514,14 +1347,28 @@ func TestClient_ExecuteTransfer(t *testing.T) {
 		assert.True(t, errors.Is(err, errQuorumNotReached))
 		assert.True(t, strings.Contains(err.Error(), "num signatures: 9, quorum: 10"))
 	})
+	t.Run("no signatures collected", func(t *testing.T) {
+		c, _ := NewEthereumClient(args)
+		c.signatureHolder = &testsCommon.SignaturesHolderStub{
+			SignaturesCalled: func(messageHash []byte) [][]byte {
+				return make([][]byte, 0)
+			},
+		}
+		hash, err := c.ExecuteTransfer(context.Background(), common.Hash{}, batch, 10)
+		assert.Equal(t, "", hash)
+		assert.True(t, errors.Is(err, errNoSignaturesCollected))
+		assert.False(t, errors.Is(err, errQuorumNotReached))
+		assert.True(t, strings.Contains(err.Error(), "quorum: 10"))
+	})
 	t.Run("not enough balance for fees", func(t *testing.T) {
 		gasPrice := big.NewInt(1000000000)
 		t.Parallel()
 		c, _ := NewEthereumClient(args)
-		c.gasHandler = &testsCommon.GasHandlerStub{GetCurrentGasPriceCalled: func() (*big.Int, error) {
+		c.gasHandler = &testsCommon.GasHandlerStub{GetCurrentGasPriceWithContextCalled: func(ctx context.Context) (*big.Int, error) {
 			return gasPrice, nil
 		}}
 		c.clientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			GetRelayersCalled: getRelayersHandler,
 			BalanceAtCalled: func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
 				return gasPrice, nil
 			},
@@ -621,14 +1468,25 @@ func TestClient_ExecuteTransfer(t *testing.T) {
 			},
 		}
 		c.clientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			GetRelayersCalled: getRelayersHandler,
 			ExecuteTransferCalled: func(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, sigs [][]byte) (*types.Transaction, error) {
 				return nil, expectedErr
 			},
 		}
+		numFailed := 0
+		c.transferMetrics = &testsCommon.TransferMetricsStub{
+			IncTransfersFailedCalled: func() {
+				numFailed++
+			},
+			IncTransfersSentCalled: func() {
+				assert.Fail(t, "should have not been called")
+			},
+		}
 
 		hash, err := c.ExecuteTransfer(context.Background(), common.Hash{}, batch, 9)
 		assert.Equal(t, "", hash)
 		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, numFailed)
 	})
 	t.Run("should work - same number of signatures as quorum", func(t *testing.T) {
 		c, _ := NewEthereumClient(args)
@@ -644,6 +1502,7 @@ func TestClient_ExecuteTransfer(t *testing.T) {
 		}
 		wasCalled := false
 		c.clientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			GetRelayersCalled: getRelayersHandler,
 			ExecuteTransferCalled: func(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, sigs [][]byte) (*types.Transaction, error) {
 				assert.Equal(t, expectedTokens, tokens)
 				assert.Equal(t, expectedRecipients, recipients)
@@ -658,12 +1517,35 @@ func TestClient_ExecuteTransfer(t *testing.T) {
 				}
 				return types.NewTx(txData), nil
 			},
+			BalanceAtCalled: func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+				return big.NewInt(1000000), nil
+			},
+		}
+		c.gasHandler = &testsCommon.GasHandlerStub{
+			GetCurrentGasPriceWithContextCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(1234), nil
+			},
+		}
+		numExecuted := 0
+		observedGasPrice := float64(0)
+		c.transferMetrics = &testsCommon.TransferMetricsStub{
+			IncTransfersSentCalled: func() {
+				numExecuted++
+			},
+			IncTransfersFailedCalled: func() {
+				assert.Fail(t, "should have not been called")
+			},
+			ObserveExecutionGasPriceCalled: func(gasPrice float64) {
+				observedGasPrice = gasPrice
+			},
 		}
 
 		hash, err := c.ExecuteTransfer(context.Background(), common.Hash{}, batch, 9)
 		assert.Equal(t, "0xc5b2c658f5fa236c598a6e7fbf7f21413dc42e2a41dd982eb772b30707cba2eb", hash)
 		assert.Nil(t, err)
 		assert.True(t, wasCalled)
+		assert.Equal(t, 1, numExecuted)
+		assert.Equal(t, float64(1234), observedGasPrice)
 	})
 	t.Run("should work - more signatures should trim", func(t *testing.T) {
 		c, _ := NewEthereumClient(args)
@@ -679,6 +1561,7 @@ func TestClient_ExecuteTransfer(t *testing.T) {
 		}
 		wasCalled := false
 		c.clientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			GetRelayersCalled: getRelayersHandler,
 			ExecuteTransferCalled: func(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, sigs [][]byte) (*types.Transaction, error) {
 				assert.Equal(t, expectedTokens, tokens)
 				assert.Equal(t, expectedRecipients, recipients)
@@ -702,117 +1585,880 @@ func TestClient_ExecuteTransfer(t *testing.T) {
 	})
 }
 
-func TestClient_GetTransactionsStatuses(t *testing.T) {
+func TestClient_ExecuteTransferShouldFilterSignatures(t *testing.T) {
 	t.Parallel()
 
-	expectedStatuses := []byte{1, 2, 3}
-	expectedBatchID := big.NewInt(2232)
-	args := createMockEthereumClientArgs()
-	args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
-		GetStatusesAfterExecutionCalled: func(ctx context.Context, batchID *big.Int) ([]byte, error) {
-			assert.Equal(t, expectedBatchID, batchID)
-			return expectedStatuses, nil
-		},
-	}
-
-	c, _ := NewEthereumClient(args)
-
-	statuses, err := c.GetTransactionsStatuses(context.Background(), expectedBatchID.Uint64())
-	assert.Nil(t, err)
-	assert.Equal(t, expectedStatuses, statuses)
-}
-
-func TestClient_GetQuorumSize(t *testing.T) {
-	t.Parallel()
+	msgHash := common.HexToHash("0x5c2a3d4e")
+	batch := createMockTransferBatch()
+	signatures, relayers := createSignaturesAndRelayers(t, msgHash, 5)
+	foreignSignatures, _ := createSignaturesAndRelayers(t, msgHash, 3)
+	createClient := func(providedSignatures [][]byte, executedSignatures *[][]byte) *client {
+		args := createMockEthereumClientArgs()
+		args.SignatureHolder = &testsCommon.SignaturesHolderStub{
+			SignaturesCalled: func(messageHash []byte) [][]byte {
+				return providedSignatures
+			},
+		}
+		args.Erc20ContractsHandler = &bridgeTests.ERC20ContractsHolderStub{
+			BalanceOfCalled: func(ctx context.Context, erc20Address common.Address, address common.Address) (*big.Int, error) {
+				return big.NewInt(10000), nil
+			},
+		}
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			GetRelayersCalled: func(ctx context.Context) ([]common.Address, error) {
+				return relayers, nil
+			},
+			ExecuteTransferCalled: func(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, sigs [][]byte) (*types.Transaction, error) {
+				*executedSignatures = sigs
+				return types.NewTx(&types.LegacyTx{}), nil
+			},
+		}
+		c, _ := NewEthereumClient(args)
 
-	args := createMockEthereumClientArgs()
-	providedValue := big.NewInt(6453)
-	args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
-		QuorumCalled: func(ctx context.Context) (*big.Int, error) {
-			return providedValue, nil
-		},
+		return c
 	}
-	c, _ := NewEthereumClient(args)
-
-	quorum, err := c.GetQuorumSize(context.Background())
-	assert.Nil(t, err)
-	assert.Equal(t, providedValue, quorum)
-}
-
-func TestClient_IsQuorumReached(t *testing.T) {
-	t.Parallel()
 
-	t.Run("quorum errors", func(t *testing.T) {
+	t.Run("get relayers errors", func(t *testing.T) {
 		t.Parallel()
 
-		expectedErr := errors.New("expected error")
-		args := createMockEthereumClientArgs()
-		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
-			QuorumCalled: func(ctx context.Context) (*big.Int, error) {
+		expectedErr := errors.New("expected error get relayers")
+		var executedSignatures [][]byte
+		c := createClient(signatures, &executedSignatures)
+		c.clientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			GetRelayersCalled: func(ctx context.Context) ([]common.Address, error) {
 				return nil, expectedErr
 			},
 		}
-		c, _ := NewEthereumClient(args)
 
-		isReached, err := c.IsQuorumReached(context.Background(), common.Hash{})
-		assert.False(t, isReached)
+		hash, err := c.ExecuteTransfer(context.Background(), msgHash, batch, 3)
+		assert.Equal(t, "", hash)
 		assert.True(t, errors.Is(err, expectedErr))
+		assert.Nil(t, executedSignatures)
 	})
-	t.Run("quorum returns less than minimum allowed", func(t *testing.T) {
+	t.Run("duplicated signatures should not count towards quorum", func(t *testing.T) {
 		t.Parallel()
 
-		args := createMockEthereumClientArgs()
-		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
-			QuorumCalled: func(ctx context.Context) (*big.Int, error) {
-				return big.NewInt(0), nil
-			},
-		}
-		c, _ := NewEthereumClient(args)
+		var executedSignatures [][]byte
+		providedSignatures := [][]byte{signatures[0], signatures[1], signatures[0], signatures[1]}
+		c := createClient(providedSignatures, &executedSignatures)
 
-		isReached, err := c.IsQuorumReached(context.Background(), common.Hash{})
-		assert.False(t, isReached)
-		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
-		assert.True(t, strings.Contains(err.Error(), "in IsQuorumReached, minQuorum"))
+		hash, err := c.ExecuteTransfer(context.Background(), msgHash, batch, 3)
+		assert.Equal(t, "", hash)
+		assert.True(t, errors.Is(err, errQuorumNotReached))
+		assert.True(t, strings.Contains(err.Error(), "num signatures: 2, quorum: 3"))
+		assert.Nil(t, executedSignatures)
 	})
-	t.Run("quorum values comparison", func(t *testing.T) {
+	t.Run("foreign and invalid signatures should not count towards quorum", func(t *testing.T) {
 		t.Parallel()
 
-		signatures := make([][]byte, 0)
-		args := createMockEthereumClientArgs()
-		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
-			QuorumCalled: func(ctx context.Context) (*big.Int, error) {
-				return big.NewInt(3), nil
-			},
-		}
-		args.SignatureHolder = &testsCommon.SignaturesHolderStub{
-			SignaturesCalled: func(messageHash []byte) [][]byte {
-				return signatures
+		var executedSignatures [][]byte
+		providedSignatures := append([][]byte{signatures[0], []byte("invalid signature")}, foreignSignatures...)
+		c := createClient(providedSignatures, &executedSignatures)
+
+		hash, err := c.ExecuteTransfer(context.Background(), msgHash, batch, 3)
+		assert.Equal(t, "", hash)
+		assert.True(t, errors.Is(err, errQuorumNotReached))
+		assert.True(t, strings.Contains(err.Error(), "num signatures: 1, quorum: 3"))
+		assert.Nil(t, executedSignatures)
+	})
+	t.Run("only foreign signatures should count as no signatures collected", func(t *testing.T) {
+		t.Parallel()
+
+		var executedSignatures [][]byte
+		c := createClient(foreignSignatures, &executedSignatures)
+
+		hash, err := c.ExecuteTransfer(context.Background(), msgHash, batch, 3)
+		assert.Equal(t, "", hash)
+		assert.True(t, errors.Is(err, errNoSignaturesCollected))
+		assert.Nil(t, executedSignatures)
+	})
+	t.Run("mixed signatures should execute with the valid ones", func(t *testing.T) {
+		t.Parallel()
+
+		var executedSignatures [][]byte
+		providedSignatures := [][]byte{
+			foreignSignatures[0],
+			signatures[0],
+			signatures[0],
+			[]byte("invalid signature"),
+			signatures[2],
+			foreignSignatures[1],
+			signatures[2],
+			signatures[4],
+		}
+		c := createClient(providedSignatures, &executedSignatures)
+
+		hash, err := c.ExecuteTransfer(context.Background(), msgHash, batch, 3)
+		assert.Nil(t, err)
+		assert.NotEqual(t, "", hash)
+		assert.Equal(t, [][]byte{signatures[0], signatures[2], signatures[4]}, executedSignatures)
+	})
+	t.Run("previous relayer address signatures should complete the quorum", func(t *testing.T) {
+		t.Parallel()
+
+		previousSignatures, previousAddresses := createSignaturesAndRelayers(t, msgHash, 2)
+		var executedSignatures [][]byte
+		providedSignatures := [][]byte{previousSignatures[0], signatures[0], previousSignatures[1], signatures[1]}
+		c := createClient(providedSignatures, &executedSignatures)
+		c.previousRelayerAddresses = previousAddresses
+
+		hash, err := c.ExecuteTransfer(context.Background(), msgHash, batch, 3)
+		assert.Nil(t, err)
+		assert.NotEqual(t, "", hash)
+		assert.Equal(t, [][]byte{signatures[0], signatures[1], previousSignatures[0]}, executedSignatures)
+	})
+}
+
+func TestClient_ExecuteTransferQuorumCheck(t *testing.T) {
+	t.Parallel()
+
+	msgHash := common.HexToHash("0x7a3b")
+	batch := createMockTransferBatch()
+	signatures, relayers := createSignaturesAndRelayers(t, msgHash, 5)
+	createClient := func(mode bridgeCore.EthQuorumCheckMode, contractQuorum *big.Int, contractQuorumErr error, executedSignatures *[][]byte) *client {
+		args := createMockEthereumClientArgs()
+		args.QuorumCheckMode = mode
+		args.SignatureHolder = &testsCommon.SignaturesHolderStub{
+			SignaturesCalled: func(messageHash []byte) [][]byte {
+				return signatures
+			},
+		}
+		args.Erc20ContractsHandler = &bridgeTests.ERC20ContractsHolderStub{
+			BalanceOfCalled: func(ctx context.Context, erc20Address common.Address, address common.Address) (*big.Int, error) {
+				return big.NewInt(10000), nil
+			},
+		}
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			GetRelayersCalled: func(ctx context.Context) ([]common.Address, error) {
+				return relayers, nil
+			},
+			QuorumCalled: func(ctx context.Context) (*big.Int, error) {
+				return contractQuorum, contractQuorumErr
+			},
+			ExecuteTransferCalled: func(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, sigs [][]byte) (*types.Transaction, error) {
+				*executedSignatures = sigs
+				return types.NewTx(&types.LegacyTx{}), nil
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		return c
+	}
+
+	t.Run("invalid mode should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.QuorumCheckMode = "invalid"
+		c, err := NewEthereumClient(args)
+
+		assert.True(t, check.IfNil(c))
+		assert.True(t, errors.Is(err, errInvalidQuorumCheckMode))
+	})
+	t.Run("off mode should not query the contract", func(t *testing.T) {
+		t.Parallel()
+
+		var executedSignatures [][]byte
+		c := createClient(bridgeCore.EthQuorumCheckOff, nil, errors.New("should have not been called"), &executedSignatures)
+
+		hash, err := c.ExecuteTransfer(context.Background(), msgHash, batch, 2)
+		assert.Nil(t, err)
+		assert.NotEqual(t, "", hash)
+		assert.Equal(t, signatures[:2], executedSignatures)
+	})
+	t.Run("empty mode should default to off mode", func(t *testing.T) {
+		t.Parallel()
+
+		var executedSignatures [][]byte
+		c := createClient("", big.NewInt(4), nil, &executedSignatures)
+		assert.Equal(t, bridgeCore.EthQuorumCheckOff, c.quorumCheckMode)
+
+		hash, err := c.ExecuteTransfer(context.Background(), msgHash, batch, 2)
+		assert.Nil(t, err)
+		assert.NotEqual(t, "", hash)
+		assert.Equal(t, signatures[:2], executedSignatures)
+	})
+	t.Run("contract quorum query errors", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error quorum")
+		var executedSignatures [][]byte
+		c := createClient(bridgeCore.EthQuorumCheckRaise, nil, expectedErr, &executedSignatures)
+
+		hash, err := c.ExecuteTransfer(context.Background(), msgHash, batch, 2)
+		assert.Equal(t, "", hash)
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.Nil(t, executedSignatures)
+	})
+	t.Run("contract quorum changed after being read by the caller should be detected", func(t *testing.T) {
+		t.Parallel()
+
+		var executedSignatures [][]byte
+		contractQuorum := big.NewInt(2)
+		c := createClient(bridgeCore.EthQuorumCheckError, nil, nil, &executedSignatures)
+		stub := c.clientWrapper.(*bridgeTests.EthereumClientWrapperStub)
+		stub.QuorumCalled = func(ctx context.Context) (*big.Int, error) {
+			return big.NewInt(0).Set(contractQuorum), nil
+		}
+
+		quorum, err := c.GetQuorumSize(context.Background())
+		require.Nil(t, err)
+		contractQuorum.SetInt64(4)
+
+		hash, err := c.ExecuteTransfer(context.Background(), msgHash, batch, int(quorum.Int64()))
+		assert.Equal(t, "", hash)
+		assert.True(t, errors.Is(err, errQuorumBelowContractQuorum))
+		assert.True(t, strings.Contains(err.Error(), "provided: 2, contract: 4"))
+		assert.Nil(t, executedSignatures)
+	})
+	t.Run("raise mode should use the contract quorum", func(t *testing.T) {
+		t.Parallel()
+
+		var executedSignatures [][]byte
+		c := createClient(bridgeCore.EthQuorumCheckRaise, big.NewInt(4), nil, &executedSignatures)
+
+		hash, err := c.ExecuteTransfer(context.Background(), msgHash, batch, 2)
+		assert.Nil(t, err)
+		assert.NotEqual(t, "", hash)
+		assert.Equal(t, signatures[:4], executedSignatures)
+	})
+	t.Run("error mode should refuse a lower quorum", func(t *testing.T) {
+		t.Parallel()
+
+		var executedSignatures [][]byte
+		c := createClient(bridgeCore.EthQuorumCheckError, big.NewInt(4), nil, &executedSignatures)
+
+		hash, err := c.ExecuteTransfer(context.Background(), msgHash, batch, 2)
+		assert.Equal(t, "", hash)
+		assert.True(t, errors.Is(err, errQuorumBelowContractQuorum))
+		assert.True(t, strings.Contains(err.Error(), "provided: 2, contract: 4"))
+		assert.Nil(t, executedSignatures)
+	})
+	t.Run("higher provided quorum should be kept", func(t *testing.T) {
+		t.Parallel()
+
+		var executedSignatures [][]byte
+		c := createClient(bridgeCore.EthQuorumCheckError, big.NewInt(2), nil, &executedSignatures)
+
+		hash, err := c.ExecuteTransfer(context.Background(), msgHash, batch, 3)
+		assert.Nil(t, err)
+		assert.NotEqual(t, "", hash)
+		assert.Equal(t, signatures[:3], executedSignatures)
+	})
+	t.Run("matching quorum should work", func(t *testing.T) {
+		t.Parallel()
+
+		var executedSignatures [][]byte
+		c := createClient(bridgeCore.EthQuorumCheckError, big.NewInt(3), nil, &executedSignatures)
+
+		hash, err := c.ExecuteTransfer(context.Background(), msgHash, batch, 3)
+		assert.Nil(t, err)
+		assert.NotEqual(t, "", hash)
+		assert.Equal(t, signatures[:3], executedSignatures)
+	})
+}
+
+func TestClient_CheckAvailableTokensWithFeeModels(t *testing.T) {
+	t.Parallel()
+
+	feeToken := common.BytesToAddress([]byte("ERC20feeToken"))
+	regularToken := common.BytesToAddress([]byte("ERC20regularToken"))
+	tokens := []common.Address{feeToken, regularToken, feeToken}
+	amounts := []*big.Int{big.NewInt(20), big.NewInt(40), big.NewInt(80)}
+	createClient := func(feeTokenBalance int64) *client {
+		feeModel, _ := NewBasisPointsFeeModel(1000) // simulated token charging a 10% transfer fee
+		args := createMockEthereumClientArgs()
+		args.TokenFeeModels = map[common.Address]TokenFeeModel{
+			feeToken: feeModel,
+		}
+		args.Erc20ContractsHandler = &bridgeTests.ERC20ContractsHolderStub{
+			BalanceOfCalled: func(ctx context.Context, erc20Address common.Address, address common.Address) (*big.Int, error) {
+				if erc20Address == feeToken {
+					return big.NewInt(feeTokenBalance), nil
+				}
+
+				return big.NewInt(40), nil
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		return c
+	}
+
+	t.Run("nil fee model should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.TokenFeeModels = map[common.Address]TokenFeeModel{
+			feeToken: nil,
+		}
+		c, err := NewEthereumClient(args)
+
+		assert.True(t, check.IfNil(c))
+		assert.True(t, errors.Is(err, errNilTokenFeeModel))
+	})
+	t.Run("balance covering only the transferred amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		c := createClient(100)
+		err := c.checkAvailableTokens(context.Background(), tokens, amounts)
+		assert.True(t, errors.Is(err, errInsufficientErc20Balance))
+		assert.True(t, strings.Contains(err.Error(), "existing: 100, required: 110"))
+	})
+	t.Run("balance covering the transfer fees should work", func(t *testing.T) {
+		t.Parallel()
+
+		c := createClient(110)
+		err := c.checkAvailableTokens(context.Background(), tokens, amounts)
+		assert.Nil(t, err)
+		assert.Equal(t, []*big.Int{big.NewInt(20), big.NewInt(40), big.NewInt(80)}, amounts)
+	})
+}
+
+func TestClient_GetTransactionsStatuses(t *testing.T) {
+	t.Parallel()
+
+	expectedStatuses := []byte{1, 2, 3}
+	expectedBatchID := big.NewInt(2232)
+	args := createMockEthereumClientArgs()
+	args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+		GetStatusesAfterExecutionCalled: func(ctx context.Context, batchID *big.Int) ([]byte, error) {
+			assert.Equal(t, expectedBatchID, batchID)
+			return expectedStatuses, nil
+		},
+	}
+
+	c, _ := NewEthereumClient(args)
+
+	statuses, err := c.GetTransactionsStatuses(context.Background(), expectedBatchID.Uint64())
+	assert.Nil(t, err)
+	assert.Equal(t, expectedStatuses, statuses)
+}
+
+func TestClient_GetQuorumSize(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEthereumClientArgs()
+	providedValue := big.NewInt(6453)
+	args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+		QuorumCalled: func(ctx context.Context) (*big.Int, error) {
+			return providedValue, nil
+		},
+	}
+	c, _ := NewEthereumClient(args)
+
+	quorum, err := c.GetQuorumSize(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, providedValue, quorum)
+}
+
+func TestClient_IsPaused(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEthereumClientArgs()
+	args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+		IsPausedCalled: func(ctx context.Context) (bool, error) {
+			return true, nil
+		},
+	}
+	c, _ := NewEthereumClient(args)
+
+	isPaused, err := c.IsPaused(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, isPaused)
+}
+
+func TestClient_CancelTransaction(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.NewInt(1337)
+	createClient := func(sentTxs *[]*types.Transaction, balance int64) *client {
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			ChainIDCalled: func(ctx context.Context) (*big.Int, error) {
+				return chainID, nil
+			},
+			BalanceAtCalled: func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+				return big.NewInt(balance), nil
+			},
+			SendTransactionCalled: func(ctx context.Context, tx *types.Transaction) error {
+				*sentTxs = append(*sentTxs, tx)
+				return nil
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		return c
+	}
+
+	t.Run("nil gas price should error", func(t *testing.T) {
+		t.Parallel()
+
+		sentTxs := make([]*types.Transaction, 0)
+		hash, err := createClient(&sentTxs, 100000000).CancelTransaction(context.Background(), 4, nil)
+		assert.Empty(t, hash)
+		assert.True(t, errors.Is(err, errNilGasPrice))
+		assert.Empty(t, sentTxs)
+	})
+	t.Run("zero gas price should error", func(t *testing.T) {
+		t.Parallel()
+
+		sentTxs := make([]*types.Transaction, 0)
+		hash, err := createClient(&sentTxs, 100000000).CancelTransaction(context.Background(), 4, big.NewInt(0))
+		assert.Empty(t, hash)
+		assert.True(t, errors.Is(err, errInvalidGasPrice))
+		assert.Empty(t, sentTxs)
+	})
+	t.Run("gas price above maximum should error", func(t *testing.T) {
+		t.Parallel()
+
+		sentTxs := make([]*types.Transaction, 0)
+		hash, err := createClient(&sentTxs, 100000000).CancelTransaction(context.Background(), 4, big.NewInt(1001))
+		assert.Empty(t, hash)
+		assert.True(t, errors.Is(err, errGasPriceAboveMaximum))
+		assert.True(t, strings.Contains(err.Error(), "got: 1001, maximum: 1000"))
+		assert.Empty(t, sentTxs)
+	})
+	t.Run("insufficient relayer balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		sentTxs := make([]*types.Transaction, 0)
+		hash, err := createClient(&sentTxs, 20999999).CancelTransaction(context.Background(), 4, big.NewInt(1000))
+		assert.Empty(t, hash)
+		assert.True(t, errors.Is(err, errInsufficientBalance))
+		assert.Empty(t, sentTxs)
+	})
+	t.Run("send transaction fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			BalanceAtCalled: func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+				return big.NewInt(100000000), nil
+			},
+			SendTransactionCalled: func(ctx context.Context, tx *types.Transaction) error {
+				return expectedErr
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		hash, err := c.CancelTransaction(context.Background(), 4, big.NewInt(1000))
+		assert.Empty(t, hash)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sentTxs := make([]*types.Transaction, 0)
+		c := createClient(&sentTxs, 21000000)
+		hash, err := c.CancelTransaction(context.Background(), 4, big.NewInt(1000))
+		assert.Nil(t, err)
+		require.Equal(t, 1, len(sentTxs))
+
+		tx := sentTxs[0]
+		relayerAddress := crypto.PubkeyToAddress(*c.publicKey)
+		assert.Equal(t, tx.Hash().String(), hash)
+		assert.Equal(t, uint64(4), tx.Nonce())
+		assert.Equal(t, big.NewInt(1000), tx.GasPrice())
+		assert.Equal(t, cancelTransactionGasLimit, tx.Gas())
+		assert.Equal(t, 0, tx.Value().Sign())
+		assert.Equal(t, relayerAddress, *tx.To())
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), tx)
+		assert.Nil(t, err)
+		assert.Equal(t, relayerAddress, sender)
+	})
+	t.Run("should re-synchronize the nonce", func(t *testing.T) {
+		t.Parallel()
+
+		sentTxs := make([]*types.Transaction, 0)
+		c := createClient(&sentTxs, 21000000)
+		numPendingNonceCalls := 0
+		stub := c.clientWrapper.(*bridgeTests.EthereumClientWrapperStub)
+		stub.PendingNonceAtCalled = func(ctx context.Context, account common.Address) (uint64, error) {
+			numPendingNonceCalls++
+			return 5, nil
+		}
+		c.nonceManager.nextNonce = 9
+		c.nonceManager.isSynced = true
+
+		_, err := c.CancelTransaction(context.Background(), 4, big.NewInt(1000))
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numPendingNonceCalls)
+		assert.Equal(t, uint64(5), c.nonceManager.nextNonce)
+	})
+}
+
+func TestClient_ResetNonce(t *testing.T) {
+	t.Parallel()
+
+	minedNonce := uint64(37)
+	pendingNonce := uint64(40)
+	args := createMockEthereumClientArgs()
+	args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+		NonceAtCalled: func(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+			return minedNonce, nil
+		},
+		PendingNonceAtCalled: func(ctx context.Context, account common.Address) (uint64, error) {
+			return pendingNonce, nil
+		},
+	}
+
+	t.Run("reset should use the pending nonce", func(t *testing.T) {
+		c, _ := NewEthereumClient(args)
+
+		err := c.ResetNonce(context.Background())
+		assert.Nil(t, err)
+		nonce, err := c.nonceManager.reserveNonce(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, pendingNonce, nonce)
+	})
+	t.Run("reset to mined should use the mined nonce", func(t *testing.T) {
+		c, _ := NewEthereumClient(args)
+
+		err := c.ResetNonceToMined(context.Background())
+		assert.Nil(t, err)
+		nonce, err := c.nonceManager.reserveNonce(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, minedNonce, nonce)
+	})
+	t.Run("reset to mined should error if the block number can not be fetched", func(t *testing.T) {
+		expectedErr := errors.New("expected error get block number")
+		localArgs := createMockEthereumClientArgs()
+		localArgs.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			BlockNumberCalled: func(ctx context.Context) (uint64, error) {
+				return 0, expectedErr
+			},
+		}
+		c, _ := NewEthereumClient(localArgs)
+
+		err := c.ResetNonceToMined(context.Background())
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+}
+
+func TestClient_IsQuorumReached(t *testing.T) {
+	t.Parallel()
+
+	t.Run("quorum errors", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			QuorumCalled: func(ctx context.Context) (*big.Int, error) {
+				return nil, expectedErr
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		isReached, err := c.IsQuorumReached(context.Background(), common.Hash{})
+		assert.False(t, isReached)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("quorum returns less than minimum allowed", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			QuorumCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(0), nil
 			},
 		}
 		c, _ := NewEthereumClient(args)
 
 		isReached, err := c.IsQuorumReached(context.Background(), common.Hash{})
 		assert.False(t, isReached)
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "in IsQuorumReached, minQuorum"))
+	})
+	msgHash := common.HexToHash("0x5c2a3d4e")
+	relayersSignatures, relayers := createSignaturesAndRelayers(t, msgHash, 4)
+	previousSignatures, previousAddresses := createSignaturesAndRelayers(t, msgHash, 1)
+	foreignSignatures, _ := createSignaturesAndRelayers(t, msgHash, 1)
+	createClient := func(signatures *[][]byte) *client {
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			QuorumCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(3), nil
+			},
+			GetRelayersCalled: func(ctx context.Context) ([]common.Address, error) {
+				return relayers, nil
+			},
+		}
+		args.SignatureHolder = &testsCommon.SignaturesHolderStub{
+			SignaturesCalled: func(messageHash []byte) [][]byte {
+				return *signatures
+			},
+		}
+		args.PreviousRelayerAddresses = previousAddresses
+		c, _ := NewEthereumClient(args)
+
+		return c
+	}
+
+	t.Run("get relayers errors", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error get relayers")
+		signatures := relayersSignatures
+		c := createClient(&signatures)
+		c.clientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			QuorumCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(3), nil
+			},
+			GetRelayersCalled: func(ctx context.Context) ([]common.Address, error) {
+				return nil, expectedErr
+			},
+		}
+
+		isReached, err := c.IsQuorumReached(context.Background(), msgHash)
+		assert.False(t, isReached)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("quorum values comparison", func(t *testing.T) {
+		t.Parallel()
+
+		signatures := make([][]byte, 0)
+		c := createClient(&signatures)
+
+		isReached, err := c.IsQuorumReached(context.Background(), msgHash)
+		assert.False(t, isReached)
 		assert.Nil(t, err)
 
-		signatures = append(signatures, []byte("sig"))
-		signatures = append(signatures, []byte("sig"))
-		isReached, err = c.IsQuorumReached(context.Background(), common.Hash{})
+		signatures = append(signatures, relayersSignatures[0])
+		signatures = append(signatures, relayersSignatures[1])
+		isReached, err = c.IsQuorumReached(context.Background(), msgHash)
 		assert.False(t, isReached)
 		assert.Nil(t, err)
 
-		signatures = append(signatures, []byte("sig"))
-		isReached, err = c.IsQuorumReached(context.Background(), common.Hash{})
+		signatures = append(signatures, relayersSignatures[2])
+		isReached, err = c.IsQuorumReached(context.Background(), msgHash)
 		assert.True(t, isReached)
 		assert.Nil(t, err)
 
-		signatures = append(signatures, []byte("sig"))
-		isReached, err = c.IsQuorumReached(context.Background(), common.Hash{})
+		signatures = append(signatures, relayersSignatures[3])
+		isReached, err = c.IsQuorumReached(context.Background(), msgHash)
+		assert.True(t, isReached)
+		assert.Nil(t, err)
+	})
+	t.Run("duplicated, foreign and invalid signatures should not count towards quorum", func(t *testing.T) {
+		t.Parallel()
+
+		signatures := [][]byte{
+			relayersSignatures[0],
+			relayersSignatures[1],
+			relayersSignatures[1],
+			foreignSignatures[0],
+			[]byte("invalid signature"),
+		}
+		c := createClient(&signatures)
+
+		isReached, err := c.IsQuorumReached(context.Background(), msgHash)
+		assert.False(t, isReached)
+		assert.Nil(t, err)
+	})
+	t.Run("previous relayer address signature should count towards quorum", func(t *testing.T) {
+		t.Parallel()
+
+		signatures := [][]byte{relayersSignatures[0], relayersSignatures[1], previousSignatures[0]}
+		c := createClient(&signatures)
+
+		isReached, err := c.IsQuorumReached(context.Background(), msgHash)
 		assert.True(t, isReached)
 		assert.Nil(t, err)
 	})
 }
 
+func TestClient_IsTransactionReverted(t *testing.T) {
+	t.Parallel()
+
+	providedHash := common.HexToHash("0x8c6e1fc23ab8a6e2c2e0fb3bd6d6f3ac2c1c1e1eb8e4c0e5b2ad2d6a0a1c0f11")
+	createClient := func(receipt *types.Receipt, err error) *client {
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			TransactionReceiptCalled: func(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+				assert.Equal(t, providedHash, txHash)
+				return receipt, err
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		return c
+	}
+
+	t.Run("receipt errors", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		c := createClient(nil, expectedErr)
+
+		isReverted, err := c.IsTransactionReverted(context.Background(), providedHash.String())
+		assert.False(t, isReverted)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("receipt not found should return false", func(t *testing.T) {
+		t.Parallel()
+
+		c := createClient(nil, goEthereum.NotFound)
+
+		isReverted, err := c.IsTransactionReverted(context.Background(), providedHash.String())
+		assert.False(t, isReverted)
+		assert.Nil(t, err)
+	})
+	t.Run("successful receipt should return false", func(t *testing.T) {
+		t.Parallel()
+
+		c := createClient(&types.Receipt{Status: types.ReceiptStatusSuccessful}, nil)
+
+		isReverted, err := c.IsTransactionReverted(context.Background(), providedHash.String())
+		assert.False(t, isReverted)
+		assert.Nil(t, err)
+	})
+	t.Run("failed receipt should return true", func(t *testing.T) {
+		t.Parallel()
+
+		c := createClient(&types.Receipt{Status: types.ReceiptStatusFailed}, nil)
+
+		isReverted, err := c.IsTransactionReverted(context.Background(), providedHash.String())
+		assert.True(t, isReverted)
+		assert.Nil(t, err)
+	})
+}
+
+func TestClient_EncodeExecuteTransferCalldata(t *testing.T) {
+	t.Parallel()
+
+	signatures := [][]byte{[]byte("signature 1"), []byte("signature 2")}
+
+	t.Run("context done should error", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := NewEthereumClient(createMockEthereumClientArgs())
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		calldata, err := c.EncodeExecuteTransferCalldata(ctx, createMockTransferBatch(), signatures)
+		assert.Equal(t, context.Canceled, err)
+		assert.Nil(t, calldata)
+	})
+	t.Run("nil batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := NewEthereumClient(createMockEthereumClientArgs())
+
+		calldata, err := c.EncodeExecuteTransferCalldata(context.Background(), nil, signatures)
+		assert.Equal(t, clients.ErrNilBatch, err)
+		assert.Nil(t, calldata)
+	})
+	t.Run("empty batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := NewEthereumClient(createMockEthereumClientArgs())
+
+		calldata, err := c.EncodeExecuteTransferCalldata(context.Background(), &clients.TransferBatch{ID: 3}, signatures)
+		assert.True(t, errors.Is(err, errEmptyBatch))
+		assert.Nil(t, calldata)
+	})
+	t.Run("unsupported token should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.SupportedTokens = []common.Address{common.BytesToAddress([]byte("ERC20token1"))}
+		c, _ := NewEthereumClient(args)
+
+		calldata, err := c.EncodeExecuteTransferCalldata(context.Background(), createMockTransferBatch(), signatures)
+		assert.True(t, errors.Is(err, errUnsupportedToken))
+		assert.Nil(t, calldata)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			ExecuteTransferCalled: func(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, signatures [][]byte) (*types.Transaction, error) {
+				wasCalled = true
+				return nil, nil
+			},
+		}
+		c, _ := NewEthereumClient(args)
+		batch := createMockTransferBatch()
+
+		calldata, err := c.EncodeExecuteTransferCalldata(context.Background(), batch, signatures)
+		require.Nil(t, err)
+		assert.False(t, wasCalled)
+
+		bridgeABI, _ := contract.BridgeMetaData.GetAbi()
+		method := bridgeABI.Methods["executeTransfer"]
+		assert.Equal(t, method.ID, calldata[:4])
+
+		values, err := method.Inputs.Unpack(calldata[4:])
+		require.Nil(t, err)
+		expectedTokens := []common.Address{
+			common.BytesToAddress([]byte("ERC20token1")),
+			common.BytesToAddress([]byte("ERC20token2")),
+		}
+		expectedRecipients := []common.Address{
+			common.BytesToAddress([]byte("to1")),
+			common.BytesToAddress([]byte("to2")),
+		}
+		assert.Equal(t, expectedTokens, values[0])
+		assert.Equal(t, expectedRecipients, values[1])
+		assert.Equal(t, []*big.Int{big.NewInt(20), big.NewInt(40)}, values[2])
+		assert.Equal(t, []*big.Int{big.NewInt(10), big.NewInt(30)}, values[3])
+		assert.Equal(t, big.NewInt(332), values[4])
+		assert.Equal(t, signatures, values[5])
+	})
+}
+
+func TestClient_WasTransactionMined(t *testing.T) {
+	t.Parallel()
+
+	providedHash := common.HexToHash("0x8c6e1fc23ab8a6e2c2e0fb3bd6d6f3ac2c1c1e1eb8e4c0e5b2ad2d6a0a1c0f11")
+	createClient := func(receipt *types.Receipt, err error) *client {
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			TransactionReceiptCalled: func(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+				assert.Equal(t, providedHash, txHash)
+				return receipt, err
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		return c
+	}
+
+	t.Run("receipt errors", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		c := createClient(nil, expectedErr)
+
+		wasMined, err := c.WasTransactionMined(context.Background(), providedHash.String())
+		assert.False(t, wasMined)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("receipt not found should return false", func(t *testing.T) {
+		t.Parallel()
+
+		c := createClient(nil, goEthereum.NotFound)
+
+		wasMined, err := c.WasTransactionMined(context.Background(), providedHash.String())
+		assert.False(t, wasMined)
+		assert.Nil(t, err)
+	})
+	t.Run("failed receipt should return true", func(t *testing.T) {
+		t.Parallel()
+
+		c := createClient(&types.Receipt{Status: types.ReceiptStatusFailed}, nil)
+
+		wasMined, err := c.WasTransactionMined(context.Background(), providedHash.String())
+		assert.True(t, wasMined)
+		assert.Nil(t, err)
+	})
+}
+
 func TestClient_CheckClientAvailability(t *testing.T) {
 	t.Parallel()
 
@@ -859,7 +2505,8 @@ func TestClient_CheckClientAvailability(t *testing.T) {
 		for i := 0; i < 10; i++ {
 			message := fmt.Sprintf("block %d fetched for %d times in a row", currentNonce, args.AllowDelta+uint64(i+1))
 			err := c.CheckClientAvailability(context.Background())
-			assert.Nil(t, err)
+			assert.True(t, errors.Is(err, clients.ErrClientStalled))
+			assert.True(t, strings.Contains(err.Error(), message))
 			checkStatusHandler(t, statusHandler, ethElrond.Unavailable, message)
 		}
 	})
@@ -879,7 +2526,8 @@ func TestClient_CheckClientAvailability(t *testing.T) {
 		for i := 0; i < 10; i++ {
 			message := fmt.Sprintf("block %d fetched for %d times in a row", currentNonce, args.AllowDelta+uint64(i+1))
 			err := c.CheckClientAvailability(context.Background())
-			assert.Nil(t, err)
+			assert.True(t, errors.Is(err, clients.ErrClientStalled))
+			assert.True(t, strings.Contains(err.Error(), message))
 			checkStatusHandler(t, statusHandler, ethElrond.Unavailable, message)
 		}
 
@@ -4,6 +4,9 @@ import "errors"
 
 var (
 	errQuorumNotReached                    = errors.New("quorum not reached")
+	errNoSignaturesCollected               = errors.New("no signatures collected")
+	errQuorumBelowContractQuorum           = errors.New("provided quorum is lower than the contract quorum")
+	errInvalidQuorumCheckMode              = errors.New("invalid quorum check mode")
 	errInsufficientErc20Balance            = errors.New("insufficient ERC20 balance")
 	errInsufficientBalance                 = errors.New("insufficient balance")
 	errPublicKeyCast                       = errors.New("error casting public key to ECDSA")
@@ -12,7 +15,23 @@ var (
 	errNilBroadcaster                      = errors.New("nil broadcaster")
 	errNilSignaturesHolder                 = errors.New("nil signatures holder")
 	errNilGasHandler                       = errors.New("nil gas handler")
+	errNilTransferMetrics                  = errors.New("nil transfer metrics")
+	errNilTokenFeeModel                    = errors.New("nil token fee model")
+	errNotEnoughBlocksForFinality          = errors.New("not enough blocks for the configured finality")
+	errNotEnoughBlocksForConfirmations     = errors.New("not enough blocks for the configured execution confirmations")
+	errDuplicatedDepositNonce              = errors.New("duplicated deposit nonce")
+	errDepositNoncesGap                    = errors.New("gap in the deposit nonces")
+	errEmptyBatch                          = errors.New("empty batch")
+	errNilMaximumGasPrice                  = errors.New("nil maximum gas price")
+	errGasPriceAboveMaximum                = errors.New("gas price above the maximum allowed gas price")
+	errInvalidTransferAmount               = errors.New("invalid transfer amount")
+	errTransferAmountAboveMaximum          = errors.New("transfer amount above the maximum allowed amount")
 	errInvalidGasLimit                     = errors.New("invalid gas limit")
 	errNilEthClient                        = errors.New("nil eth client")
 	errDepositsAndBatchDepositsCountDiffer = errors.New("deposits and batch.DepositsCount differs")
+	errNilGasPrice                         = errors.New("nil gas price")
+	errInvalidGasPrice                     = errors.New("invalid gas price")
+	errUnsupportedToken                    = errors.New("unsupported token")
+	errTooManyDepositsInBatch              = errors.New("too many deposits in batch")
+	errSignatureVerificationFailed         = errors.New("signature verification failed")
 )
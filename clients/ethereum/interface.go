@@ -14,20 +14,25 @@ import (
 // ClientWrapper represents the Ethereum client wrapper that the ethereum client can rely on
 type ClientWrapper interface {
 	core.StatusHandler
-	GetBatch(ctx context.Context, batchNonce *big.Int) (contract.Batch, error)
-	GetBatchDeposits(ctx context.Context, batchNonce *big.Int) ([]contract.Deposit, error)
+	GetBatch(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) (contract.Batch, error)
+	GetBatchDeposits(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) ([]contract.Deposit, error)
 	GetRelayers(ctx context.Context) ([]common.Address, error)
-	WasBatchExecuted(ctx context.Context, batchNonce *big.Int) (bool, error)
+	WasBatchExecuted(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) (bool, error)
 	ChainID(ctx context.Context) (*big.Int, error)
 	BlockNumber(ctx context.Context) (uint64, error)
 	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
 	ExecuteTransfer(opts *bind.TransactOpts, tokens []common.Address,
 		recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int,
 		signatures [][]byte) (*types.Transaction, error)
 	Quorum(ctx context.Context) (*big.Int, error)
 	GetStatusesAfterExecution(ctx context.Context, batchID *big.Int) ([]byte, error)
 	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
 	IsPaused(ctx context.Context) (bool, error)
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
 }
 
 // Erc20ContractsHolder defines the Ethereum ERC20 contract operations
@@ -45,12 +50,21 @@ type Broadcaster interface {
 // TokensMapper can convert a token bytes from one chain to another
 type TokensMapper interface {
 	ConvertToken(ctx context.Context, sourceBytes []byte) ([]byte, error)
+	ConvertTokenReverse(ctx context.Context, convertedBytes []byte) ([]byte, error)
 	IsInterfaceNil() bool
 }
 
 // GasHandler defines the component able to fetch the current gas price
 type GasHandler interface {
 	GetCurrentGasPrice() (*big.Int, error)
+	GetCurrentGasPriceWithContext(ctx context.Context) (*big.Int, error)
+	IsInterfaceNil() bool
+}
+
+// TokenFeeModel computes the balance the safe contract needs to hold for transferring an amount of a fee-on-transfer
+// ERC20 token
+type TokenFeeModel interface {
+	RequiredBalance(amount *big.Int) *big.Int
 	IsInterfaceNil() bool
 }
 
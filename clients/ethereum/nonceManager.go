@@ -0,0 +1,127 @@
+package ethereum
+
+import (
+	"context"
+	"sync"
+)
+
+// nonceManager hands out account nonces, reserving each one locally so concurrent transfers sent from the same
+// account will not reuse a nonce. The local value is reconciled with the pending nonce reported by the chain on the
+// first reservation, after a reset, whenever the chain is ahead of the local value and, if no reserved nonce is still
+// waiting to be sent, whenever the chain is behind the local value as a sent transaction was dropped
+type nonceManager struct {
+	mut             sync.Mutex
+	getPendingNonce func(ctx context.Context) (uint64, error)
+	getMinedNonce   func(ctx context.Context) (uint64, error)
+	nextNonce       uint64
+	numReserved     int
+	isSynced        bool
+	keepLocalNonce  bool
+}
+
+func newNonceManager(
+	getPendingNonce func(ctx context.Context) (uint64, error),
+	getMinedNonce func(ctx context.Context) (uint64, error),
+) *nonceManager {
+	return &nonceManager{
+		getPendingNonce: getPendingNonce,
+		getMinedNonce:   getMinedNonce,
+	}
+}
+
+// reserveNonce returns the next nonce to be used and marks it as reserved. The reservation ends when the nonce is
+// either marked as sent or released
+func (nm *nonceManager) reserveNonce(ctx context.Context) (uint64, error) {
+	nm.mut.Lock()
+	defer nm.mut.Unlock()
+
+	pendingNonce, err := nm.getPendingNonce(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if nm.shouldResync(pendingNonce) {
+		nm.nextNonce = pendingNonce
+		nm.isSynced = true
+	}
+	nm.keepLocalNonce = false
+
+	nonce := nm.nextNonce
+	nm.nextNonce++
+	nm.numReserved++
+
+	return nonce, nil
+}
+
+// shouldResync returns true if the local value should be replaced by the chain pending nonce. Should be called under
+// mutex protection
+func (nm *nonceManager) shouldResync(pendingNonce uint64) bool {
+	if nm.keepLocalNonce {
+		return false
+	}
+	if !nm.isSynced || pendingNonce > nm.nextNonce {
+		return true
+	}
+
+	// the chain is behind only because of the reserved nonces not sent yet, otherwise a sent transaction was dropped
+	// and the following nonces would wait for the gap to be filled
+	return pendingNonce < nm.nextNonce && nm.numReserved == 0
+}
+
+// markNonceSent ends the reservation of a nonce used by a sent transaction
+func (nm *nonceManager) markNonceSent() {
+	nm.mut.Lock()
+	defer nm.mut.Unlock()
+
+	nm.endReservation()
+}
+
+// releaseNonce gives back a reserved nonce that was not used. Only the last reserved nonce can be given back,
+// otherwise a gap would be created
+func (nm *nonceManager) releaseNonce(nonce uint64) {
+	nm.mut.Lock()
+	defer nm.mut.Unlock()
+
+	nm.endReservation()
+	if nm.isSynced && nonce+1 == nm.nextNonce {
+		nm.nextNonce = nonce
+	}
+}
+
+// endReservation should be called under mutex protection
+func (nm *nonceManager) endReservation() {
+	if nm.numReserved > 0 {
+		nm.numReserved--
+	}
+}
+
+// reset drops the local nonce and re-synchronizes it with the chain pending nonce
+func (nm *nonceManager) reset(ctx context.Context) error {
+	return nm.resetWith(ctx, nm.getPendingNonce, false)
+}
+
+// resetToMinedNonce drops the local nonce and re-synchronizes it with the nonce of the last mined transaction, so the
+// next reserved nonce will replace the oldest pending transaction
+func (nm *nonceManager) resetToMinedNonce(ctx context.Context) error {
+	return nm.resetWith(ctx, nm.getMinedNonce, true)
+}
+
+func (nm *nonceManager) resetWith(
+	ctx context.Context,
+	getChainNonce func(ctx context.Context) (uint64, error),
+	keepLocalNonce bool,
+) error {
+	nm.mut.Lock()
+	defer nm.mut.Unlock()
+
+	nm.isSynced = false
+	nm.keepLocalNonce = false
+	chainNonce, err := getChainNonce(ctx)
+	if err != nil {
+		return err
+	}
+	nm.nextNonce = chainNonce
+	nm.isSynced = true
+	nm.keepLocalNonce = keepLocalNonce
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newNonceManagerWithChainNonce(getChainNonce func(ctx context.Context) (uint64, error)) *nonceManager {
+	return newNonceManager(getChainNonce, getChainNonce)
+}
+
+func TestNonceManager_ReserveNonce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("chain nonce getter errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		nm := newNonceManagerWithChainNonce(func(ctx context.Context) (uint64, error) {
+			return 0, expectedErr
+		})
+
+		nonce, err := nm.reserveNonce(context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, uint64(0), nonce)
+	})
+	t.Run("should reserve consecutive nonces while the chain nonce is behind", func(t *testing.T) {
+		t.Parallel()
+
+		nm := newNonceManagerWithChainNonce(func(ctx context.Context) (uint64, error) {
+			return 5, nil
+		})
+
+		for i := uint64(0); i < 3; i++ {
+			nonce, err := nm.reserveNonce(context.Background())
+			require.Nil(t, err)
+			assert.Equal(t, 5+i, nonce)
+		}
+	})
+	t.Run("should reconcile with the chain nonce when the chain is ahead", func(t *testing.T) {
+		t.Parallel()
+
+		chainNonce := uint64(5)
+		nm := newNonceManagerWithChainNonce(func(ctx context.Context) (uint64, error) {
+			return chainNonce, nil
+		})
+
+		nonce, _ := nm.reserveNonce(context.Background())
+		assert.Equal(t, uint64(5), nonce)
+
+		chainNonce = 10
+		nonce, _ = nm.reserveNonce(context.Background())
+		assert.Equal(t, uint64(10), nonce)
+	})
+	t.Run("concurrent reservations should not reuse nonces", func(t *testing.T) {
+		t.Parallel()
+
+		nm := newNonceManagerWithChainNonce(func(ctx context.Context) (uint64, error) {
+			return 0, nil
+		})
+
+		numReservations := 100
+		mut := sync.Mutex{}
+		reserved := make(map[uint64]struct{})
+		wg := sync.WaitGroup{}
+		wg.Add(numReservations)
+		for i := 0; i < numReservations; i++ {
+			go func() {
+				defer wg.Done()
+
+				nonce, err := nm.reserveNonce(context.Background())
+				assert.Nil(t, err)
+
+				mut.Lock()
+				reserved[nonce] = struct{}{}
+				mut.Unlock()
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, numReservations, len(reserved))
+	})
+	t.Run("dropped transaction should reconcile with the chain pending nonce", func(t *testing.T) {
+		t.Parallel()
+
+		pendingNonce := uint64(5)
+		nm := newNonceManagerWithChainNonce(func(ctx context.Context) (uint64, error) {
+			return pendingNonce, nil
+		})
+
+		nonce, _ := nm.reserveNonce(context.Background())
+		assert.Equal(t, uint64(5), nonce)
+		nm.markNonceSent()
+		pendingNonce = 6
+
+		nonce, _ = nm.reserveNonce(context.Background())
+		assert.Equal(t, uint64(6), nonce)
+		nm.markNonceSent()
+
+		// the transaction with nonce 6 was dropped from the pending pool
+		nonce, _ = nm.reserveNonce(context.Background())
+		assert.Equal(t, uint64(6), nonce)
+	})
+	t.Run("reserved nonces not sent yet should not reconcile with a lower chain pending nonce", func(t *testing.T) {
+		t.Parallel()
+
+		nm := newNonceManagerWithChainNonce(func(ctx context.Context) (uint64, error) {
+			return 5, nil
+		})
+
+		first, _ := nm.reserveNonce(context.Background())
+		second, _ := nm.reserveNonce(context.Background())
+		assert.Equal(t, uint64(5), first)
+		assert.Equal(t, uint64(6), second)
+		nm.markNonceSent()
+
+		nonce, _ := nm.reserveNonce(context.Background())
+		assert.Equal(t, uint64(7), nonce)
+	})
+}
+
+func TestNonceManager_ReleaseNonce(t *testing.T) {
+	t.Parallel()
+
+	nm := newNonceManagerWithChainNonce(func(ctx context.Context) (uint64, error) {
+		return 5, nil
+	})
+
+	first, _ := nm.reserveNonce(context.Background())
+	second, _ := nm.reserveNonce(context.Background())
+	assert.Equal(t, uint64(6), second)
+
+	nm.releaseNonce(first)
+	nonce, _ := nm.reserveNonce(context.Background())
+	assert.Equal(t, uint64(7), nonce, "releasing a nonce other than the last reserved one should not create a gap")
+
+	nm.releaseNonce(nonce)
+	nonce, _ = nm.reserveNonce(context.Background())
+	assert.Equal(t, uint64(7), nonce)
+}
+
+func TestNonceManager_Reset(t *testing.T) {
+	t.Parallel()
+
+	t.Run("chain nonce getter errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		nm := newNonceManagerWithChainNonce(func(ctx context.Context) (uint64, error) {
+			return 0, expectedErr
+		})
+
+		err := nm.reset(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should drop the reserved nonces", func(t *testing.T) {
+		t.Parallel()
+
+		nm := newNonceManagerWithChainNonce(func(ctx context.Context) (uint64, error) {
+			return 5, nil
+		})
+
+		_, _ = nm.reserveNonce(context.Background())
+		_, _ = nm.reserveNonce(context.Background())
+
+		err := nm.reset(context.Background())
+		assert.Nil(t, err)
+
+		nonce, _ := nm.reserveNonce(context.Background())
+		assert.Equal(t, uint64(5), nonce)
+	})
+	t.Run("reset to the mined nonce should replace the oldest pending transaction", func(t *testing.T) {
+		t.Parallel()
+
+		nm := newNonceManager(
+			func(ctx context.Context) (uint64, error) {
+				return 7, nil
+			},
+			func(ctx context.Context) (uint64, error) {
+				return 5, nil
+			},
+		)
+
+		nonce, _ := nm.reserveNonce(context.Background())
+		assert.Equal(t, uint64(7), nonce)
+		nm.markNonceSent()
+
+		err := nm.resetToMinedNonce(context.Background())
+		assert.Nil(t, err)
+
+		nonce, _ = nm.reserveNonce(context.Background())
+		assert.Equal(t, uint64(5), nonce)
+		nm.markNonceSent()
+
+		// the following reservations reconcile with the chain pending nonce again
+		nonce, _ = nm.reserveNonce(context.Background())
+		assert.Equal(t, uint64(7), nonce)
+	})
+}
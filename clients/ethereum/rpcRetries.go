@@ -0,0 +1,82 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core/retry"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// callWithRetries calls the provided handler until it succeeds or the maximum number of retries is reached. The
+// delay between attempts starts at rpcRetryDelay and doubles after each failed attempt
+func (c *client) callWithRetries(ctx context.Context, operation string, handler func() error) error {
+	backoff := retry.NewExponentialBackoff(c.rpcRetryDelay)
+	attempt := uint64(0)
+
+	return retry.Do(ctx, int(c.rpcMaxRetries)+1, backoff, func() error {
+		err := handler()
+		if err != nil && attempt < c.rpcMaxRetries {
+			c.log.Debug("RPC call failed, retrying", "operation", operation,
+				"attempt", attempt+1, "max retries", c.rpcMaxRetries, "delay", backoff.Delay(int(attempt)), "error", err)
+		}
+		attempt++
+
+		return err
+	})
+}
+
+func (c *client) blockNumberWithRetries(ctx context.Context) (uint64, error) {
+	var blockNumber uint64
+	err := c.callWithRetries(ctx, "BlockNumber", func() error {
+		var errCall error
+		blockNumber, errCall = c.clientWrapper.BlockNumber(ctx)
+		return errCall
+	})
+
+	return blockNumber, err
+}
+
+func (c *client) chainIDWithRetries(ctx context.Context) (*big.Int, error) {
+	var chainID *big.Int
+	err := c.callWithRetries(ctx, "ChainID", func() error {
+		var errCall error
+		chainID, errCall = c.clientWrapper.ChainID(ctx)
+		return errCall
+	})
+
+	return chainID, err
+}
+
+func (c *client) wasBatchExecutedWithRetries(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) (bool, error) {
+	var wasExecuted bool
+	err := c.callWithRetries(ctx, "WasBatchExecuted", func() error {
+		var errCall error
+		wasExecuted, errCall = c.clientWrapper.WasBatchExecuted(ctx, batchNonce, blockNumber)
+		return errCall
+	})
+
+	return wasExecuted, err
+}
+
+func (c *client) nonceAtWithRetries(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	var nonce uint64
+	err := c.callWithRetries(ctx, "NonceAt", func() error {
+		var errCall error
+		nonce, errCall = c.clientWrapper.NonceAt(ctx, account, blockNumber)
+		return errCall
+	})
+
+	return nonce, err
+}
+
+func (c *client) pendingNonceAtWithRetries(ctx context.Context, account common.Address) (uint64, error) {
+	var nonce uint64
+	err := c.callWithRetries(ctx, "PendingNonceAt", func() error {
+		var errCall error
+		nonce, errCall = c.clientWrapper.PendingNonceAt(ctx, account)
+		return errCall
+	})
+
+	return nonce, err
+}
@@ -0,0 +1,147 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_callWithRetries(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	createClient := func(maxRetries uint64) *client {
+		args := createMockEthereumClientArgs()
+		args.RPCMaxRetries = maxRetries
+		args.RPCRetryDelay = time.Millisecond
+		c, _ := NewEthereumClient(args)
+
+		return c
+	}
+
+	t.Run("no retries should call once", func(t *testing.T) {
+		t.Parallel()
+
+		c := createClient(0)
+		numCalls := 0
+		err := c.callWithRetries(context.Background(), "test", func() error {
+			numCalls++
+			return expectedErr
+		})
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("should stop after the maximum number of retries", func(t *testing.T) {
+		t.Parallel()
+
+		c := createClient(3)
+		numCalls := 0
+		err := c.callWithRetries(context.Background(), "test", func() error {
+			numCalls++
+			return expectedErr
+		})
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 4, numCalls)
+	})
+	t.Run("should stop on the first success", func(t *testing.T) {
+		t.Parallel()
+
+		c := createClient(3)
+		numCalls := 0
+		err := c.callWithRetries(context.Background(), "test", func() error {
+			numCalls++
+			if numCalls < 2 {
+				return expectedErr
+			}
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, numCalls)
+	})
+	t.Run("should back off between attempts", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.RPCMaxRetries = 3
+		args.RPCRetryDelay = 20 * time.Millisecond
+		c, _ := NewEthereumClient(args)
+
+		start := time.Now()
+		_ = c.callWithRetries(context.Background(), "test", func() error {
+			return expectedErr
+		})
+		assert.True(t, time.Since(start) >= (20+40+80)*time.Millisecond)
+	})
+	t.Run("done context should stop the retries", func(t *testing.T) {
+		t.Parallel()
+
+		c := createClient(10)
+		ctx, cancel := context.WithCancel(context.Background())
+		numCalls := 0
+		err := c.callWithRetries(ctx, "test", func() error {
+			numCalls++
+			cancel()
+			return expectedErr
+		})
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, numCalls)
+	})
+}
+
+func TestClient_WasExecutedWithRetries(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEthereumClientArgs()
+	args.RPCMaxRetries = 2
+	args.RPCRetryDelay = time.Millisecond
+	numCalls := 0
+	args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+		WasBatchExecutedCalled: func(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) (bool, error) {
+			numCalls++
+			if numCalls <= 2 {
+				return false, errors.New("transient error")
+			}
+			return true, nil
+		},
+	}
+	c, _ := NewEthereumClient(args)
+
+	wasExecuted, err := c.WasExecuted(context.Background(), 1)
+	assert.Nil(t, err)
+	assert.True(t, wasExecuted)
+	assert.Equal(t, 3, numCalls)
+}
+
+func TestClient_GetNonceWithRetries(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEthereumClientArgs()
+	args.RPCMaxRetries = 2
+	args.RPCRetryDelay = time.Millisecond
+	numCalls := 0
+	args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+		BlockNumberCalled: func(ctx context.Context) (uint64, error) {
+			return 44, nil
+		},
+		NonceAtCalled: func(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+			numCalls++
+			assert.Equal(t, big.NewInt(44), blockNumber)
+			if numCalls <= 2 {
+				return 0, errors.New("transient error")
+			}
+			return 7, nil
+		},
+	}
+	c, _ := NewEthereumClient(args)
+
+	nonce, err := c.getNonce(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(7), nonce)
+	assert.Equal(t, 3, numCalls)
+}
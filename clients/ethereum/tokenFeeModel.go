@@ -0,0 +1,43 @@
+package ethereum
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+)
+
+const maxFeeInBasisPoints = 10000
+
+// basisPointsFeeModel models a fee-on-transfer ERC20 token that charges, on top of the transferred amount, a fee
+// proportional to it. The fee is assumed to be paid by the sender (the safe contract) on each individual transfer,
+// so the required balance is computed for each deposit and the fee is rounded up to never underestimate it
+type basisPointsFeeModel struct {
+	feeInBasisPoints *big.Int
+}
+
+// NewBasisPointsFeeModel creates a fee model charging feeInBasisPoints / 10000 of each transferred amount
+func NewBasisPointsFeeModel(feeInBasisPoints uint64) (*basisPointsFeeModel, error) {
+	if feeInBasisPoints > maxFeeInBasisPoints {
+		return nil, fmt.Errorf("%w for feeInBasisPoints, got: %d, maximum: %d",
+			clients.ErrInvalidValue, feeInBasisPoints, maxFeeInBasisPoints)
+	}
+
+	return &basisPointsFeeModel{
+		feeInBasisPoints: big.NewInt(0).SetUint64(feeInBasisPoints),
+	}, nil
+}
+
+// RequiredBalance returns the balance needed for transferring the provided amount, fee included
+func (model *basisPointsFeeModel) RequiredBalance(amount *big.Int) *big.Int {
+	fee := big.NewInt(0).Mul(amount, model.feeInBasisPoints)
+	fee.Add(fee, big.NewInt(maxFeeInBasisPoints-1))
+	fee.Div(fee, big.NewInt(maxFeeInBasisPoints))
+
+	return fee.Add(fee, amount)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (model *basisPointsFeeModel) IsInterfaceNil() bool {
+	return model == nil
+}
@@ -0,0 +1,53 @@
+package ethereum
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBasisPointsFeeModel(t *testing.T) {
+	t.Parallel()
+
+	t.Run("fee higher than 100% should error", func(t *testing.T) {
+		t.Parallel()
+
+		model, err := NewBasisPointsFeeModel(maxFeeInBasisPoints + 1)
+		assert.True(t, check.IfNil(model))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "for feeInBasisPoints"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		model, err := NewBasisPointsFeeModel(maxFeeInBasisPoints)
+		assert.False(t, check.IfNil(model))
+		assert.Nil(t, err)
+	})
+}
+
+func TestBasisPointsFeeModel_RequiredBalance(t *testing.T) {
+	t.Parallel()
+
+	testRequiredBalance := func(feeInBasisPoints uint64, amount int64, expected int64) {
+		model, _ := NewBasisPointsFeeModel(feeInBasisPoints)
+		providedAmount := big.NewInt(amount)
+
+		assert.Equal(t, big.NewInt(expected).String(), model.RequiredBalance(providedAmount).String())
+		assert.Equal(t, big.NewInt(amount).String(), providedAmount.String())
+	}
+
+	testRequiredBalance(0, 1000, 1000)
+	testRequiredBalance(100, 1000, 1010)
+	testRequiredBalance(25, 10000, 10025)
+	testRequiredBalance(maxFeeInBasisPoints, 1000, 2000)
+	testRequiredBalance(100, 0, 0)
+	// the fee is rounded up: 150 * 1% = 1.5
+	testRequiredBalance(100, 150, 152)
+	testRequiredBalance(1, 1, 2)
+}
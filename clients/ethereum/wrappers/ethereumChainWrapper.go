@@ -54,16 +54,18 @@ func checkArgs(args ArgsEthereumChainWrapper) error {
 	return nil
 }
 
-// GetBatch returns the batch of transactions by providing the batch nonce
-func (wrapper *ethereumChainWrapper) GetBatch(ctx context.Context, batchNonce *big.Int) (contract.Batch, error) {
+// GetBatch returns the batch of transactions by providing the batch nonce.
+// The block number can be nil, in which case the batch is read from the latest known block.
+func (wrapper *ethereumChainWrapper) GetBatch(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) (contract.Batch, error) {
 	wrapper.AddIntMetric(core.MetricNumEthClientRequests, 1)
-	return wrapper.multiSigContract.GetBatch(&bind.CallOpts{Context: ctx}, batchNonce)
+	return wrapper.multiSigContract.GetBatch(&bind.CallOpts{Context: ctx, BlockNumber: blockNumber}, batchNonce)
 }
 
-// GetBatchDeposits returns the transactions of a batch by providing the batch nonce
-func (wrapper *ethereumChainWrapper) GetBatchDeposits(ctx context.Context, batchNonce *big.Int) ([]contract.Deposit, error) {
+// GetBatchDeposits returns the transactions of a batch by providing the batch nonce.
+// The block number can be nil, in which case the deposits are read from the latest known block.
+func (wrapper *ethereumChainWrapper) GetBatchDeposits(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) ([]contract.Deposit, error) {
 	wrapper.AddIntMetric(core.MetricNumEthClientRequests, 1)
-	return wrapper.multiSigContract.GetBatchDeposits(&bind.CallOpts{Context: ctx}, batchNonce)
+	return wrapper.multiSigContract.GetBatchDeposits(&bind.CallOpts{Context: ctx, BlockNumber: blockNumber}, batchNonce)
 }
 
 // GetRelayers returns all whitelisted ethereum addresses
@@ -72,10 +74,11 @@ func (wrapper *ethereumChainWrapper) GetRelayers(ctx context.Context) ([]common.
 	return wrapper.multiSigContract.GetRelayers(&bind.CallOpts{Context: ctx})
 }
 
-// WasBatchExecuted returns true if the batch was executed
-func (wrapper *ethereumChainWrapper) WasBatchExecuted(ctx context.Context, batchNonce *big.Int) (bool, error) {
+// WasBatchExecuted returns true if the batch was executed at the provided block number. A nil block number
+// queries the latest block
+func (wrapper *ethereumChainWrapper) WasBatchExecuted(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) (bool, error) {
 	wrapper.AddIntMetric(core.MetricNumEthClientRequests, 1)
-	return wrapper.multiSigContract.WasBatchExecuted(&bind.CallOpts{Context: ctx}, batchNonce)
+	return wrapper.multiSigContract.WasBatchExecuted(&bind.CallOpts{Context: ctx, BlockNumber: blockNumber}, batchNonce)
 }
 
 // ChainID returns the chain ID
@@ -103,6 +106,12 @@ func (wrapper *ethereumChainWrapper) NonceAt(ctx context.Context, account common
 	return wrapper.blockchainClient.NonceAt(ctx, account, blockNumber)
 }
 
+// PendingNonceAt returns the account's nonce including the transactions waiting in the pending pool
+func (wrapper *ethereumChainWrapper) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	wrapper.AddIntMetric(core.MetricNumEthClientRequests, 1)
+	return wrapper.blockchainClient.PendingNonceAt(ctx, account)
+}
+
 // ExecuteTransfer will send an execute-transfer transaction on the ethereum chain
 func (wrapper *ethereumChainWrapper) ExecuteTransfer(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, signatures [][]byte) (*types.Transaction, error) {
 	wrapper.AddIntMetric(core.MetricNumEthClientTransactions, 1)
@@ -128,6 +137,30 @@ func (wrapper *ethereumChainWrapper) BalanceAt(ctx context.Context, account comm
 	return wrapper.blockchainClient.BalanceAt(ctx, account, blockNumber)
 }
 
+// SuggestGasPrice returns the gas price, in wei, suggested by the ethereum node
+func (wrapper *ethereumChainWrapper) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	wrapper.AddIntMetric(core.MetricNumEthClientRequests, 1)
+	return wrapper.blockchainClient.SuggestGasPrice(ctx)
+}
+
+// SuggestGasTipCap returns the priority fee per gas, in wei, suggested by the ethereum node
+func (wrapper *ethereumChainWrapper) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	wrapper.AddIntMetric(core.MetricNumEthClientRequests, 1)
+	return wrapper.blockchainClient.SuggestGasTipCap(ctx)
+}
+
+// TransactionReceipt returns the receipt of the provided transaction hash
+func (wrapper *ethereumChainWrapper) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	wrapper.AddIntMetric(core.MetricNumEthClientRequests, 1)
+	return wrapper.blockchainClient.TransactionReceipt(ctx, txHash)
+}
+
+// SendTransaction injects a signed transaction into the pending pool for execution
+func (wrapper *ethereumChainWrapper) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	wrapper.AddIntMetric(core.MetricNumEthClientRequests, 1)
+	return wrapper.blockchainClient.SendTransaction(ctx, tx)
+}
+
 // IsPaused returns true if the multisig contract is paused
 func (wrapper *ethereumChainWrapper) IsPaused(ctx context.Context) (bool, error) {
 	return wrapper.multiSigContract.Paused(&bind.CallOpts{Context: ctx})
@@ -79,15 +79,17 @@ func TestEthClientWrapper_GetBatch(t *testing.T) {
 	args, statusHandler := createMockArgsEthereumChainWrapper()
 	handlerCalled := false
 	providedBatchID := big.NewInt(223)
+	providedBlockNumber := big.NewInt(445)
 	args.MultiSigContract = &bridgeTests.MultiSigContractStub{
 		GetBatchCalled: func(opts *bind.CallOpts, batchNonce *big.Int) (contract.Batch, error) {
 			handlerCalled = true
 			assert.Equal(t, providedBatchID, batchNonce)
+			assert.Equal(t, providedBlockNumber, opts.BlockNumber)
 			return contract.Batch{}, nil
 		},
 	}
 	wrapper, _ := NewEthereumChainWrapper(args)
-	batch, err := wrapper.GetBatch(context.Background(), providedBatchID)
+	batch, err := wrapper.GetBatch(context.Background(), providedBatchID, providedBlockNumber)
 	assert.Nil(t, err)
 	assert.Equal(t, contract.Batch{}, batch)
 	assert.True(t, handlerCalled)
@@ -118,14 +120,16 @@ func TestEthClientWrapper_WasBatchExecuted(t *testing.T) {
 
 	args, statusHandler := createMockArgsEthereumChainWrapper()
 	handlerCalled := false
+	providedBlockNumber := big.NewInt(37)
 	args.MultiSigContract = &bridgeTests.MultiSigContractStub{
 		WasBatchExecutedCalled: func(opts *bind.CallOpts, batchNonce *big.Int) (bool, error) {
 			handlerCalled = true
+			assert.Equal(t, providedBlockNumber, opts.BlockNumber)
 			return false, nil
 		},
 	}
 	wrapper, _ := NewEthereumChainWrapper(args)
-	executed, err := wrapper.WasBatchExecuted(context.Background(), nil)
+	executed, err := wrapper.WasBatchExecuted(context.Background(), nil, providedBlockNumber)
 	assert.Nil(t, err)
 	assert.False(t, executed)
 	assert.True(t, handlerCalled)
@@ -276,6 +280,88 @@ func TestEthClientWrapper_GetStatusesAfterExecution(t *testing.T) {
 	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumEthClientRequests))
 }
 
+func TestEthClientWrapper_SuggestGasPrice(t *testing.T) {
+	t.Parallel()
+
+	args, statusHandler := createMockArgsEthereumChainWrapper()
+	handlerCalled := false
+	args.BlockchainClient = &interactors.BlockchainClientStub{
+		SuggestGasPriceCalled: func(ctx context.Context) (*big.Int, error) {
+			handlerCalled = true
+			return big.NewInt(37), nil
+		},
+	}
+	wrapper, _ := NewEthereumChainWrapper(args)
+	gasPrice, err := wrapper.SuggestGasPrice(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(37), gasPrice)
+	assert.True(t, handlerCalled)
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumEthClientRequests))
+}
+
+func TestEthClientWrapper_SuggestGasTipCap(t *testing.T) {
+	t.Parallel()
+
+	args, statusHandler := createMockArgsEthereumChainWrapper()
+	handlerCalled := false
+	args.BlockchainClient = &interactors.BlockchainClientStub{
+		SuggestGasTipCapCalled: func(ctx context.Context) (*big.Int, error) {
+			handlerCalled = true
+			return big.NewInt(37), nil
+		},
+	}
+	wrapper, _ := NewEthereumChainWrapper(args)
+	gasTipCap, err := wrapper.SuggestGasTipCap(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(37), gasTipCap)
+	assert.True(t, handlerCalled)
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumEthClientRequests))
+}
+
+func TestEthClientWrapper_TransactionReceipt(t *testing.T) {
+	t.Parallel()
+
+	args, statusHandler := createMockArgsEthereumChainWrapper()
+	providedHash := common.HexToHash("0x1234")
+	providedReceipt := &types.Receipt{
+		Status: types.ReceiptStatusFailed,
+	}
+	handlerCalled := false
+	args.BlockchainClient = &interactors.BlockchainClientStub{
+		TransactionReceiptCalled: func(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+			handlerCalled = true
+			assert.Equal(t, providedHash, txHash)
+			return providedReceipt, nil
+		},
+	}
+	wrapper, _ := NewEthereumChainWrapper(args)
+	receipt, err := wrapper.TransactionReceipt(context.Background(), providedHash)
+	assert.Nil(t, err)
+	assert.True(t, providedReceipt == receipt)
+	assert.True(t, handlerCalled)
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumEthClientRequests))
+}
+
+func TestEthClientWrapper_SendTransaction(t *testing.T) {
+	t.Parallel()
+
+	args, statusHandler := createMockArgsEthereumChainWrapper()
+	providedTx := types.NewTx(&types.LegacyTx{Nonce: 4})
+	handlerCalled := false
+	args.BlockchainClient = &interactors.BlockchainClientStub{
+		SendTransactionCalled: func(ctx context.Context, tx *types.Transaction) error {
+			handlerCalled = true
+			assert.True(t, providedTx == tx)
+			return nil
+		},
+	}
+	wrapper, _ := NewEthereumChainWrapper(args)
+	err := wrapper.SendTransaction(context.Background(), providedTx)
+	assert.Nil(t, err)
+	assert.True(t, handlerCalled)
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumEthClientRequests))
+}
+
 func TestEthereumChainWrapper_IsPaused(t *testing.T) {
 	t.Parallel()
 
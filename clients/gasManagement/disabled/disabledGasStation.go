@@ -1,15 +1,28 @@
 package disabled
 
-import "math/big"
+import (
+	"context"
+	"math/big"
+)
 
 // DisabledGasStation implementation in case no gasStation is used
 type DisabledGasStation struct{}
 
-// GetCurrentGasPrice returns nil,nil and will cause the gas price to be determined automatically
+// GetCurrentGasPrice returns a zero gas price and no error
 func (dgs *DisabledGasStation) GetCurrentGasPrice() (*big.Int, error) {
 	return big.NewInt(0), nil
 }
 
+// GetCurrentGasPriceWithContext returns a zero gas price and no error
+func (dgs *DisabledGasStation) GetCurrentGasPriceWithContext(_ context.Context) (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
+// GetCurrentDynamicFees returns zero fees and no error
+func (dgs *DisabledGasStation) GetCurrentDynamicFees() (*big.Int, *big.Int, error) {
+	return big.NewInt(0), big.NewInt(0), nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (dgs *DisabledGasStation) IsInterfaceNil() bool {
 	return dgs == nil
@@ -1,6 +1,7 @@
 package disabled
 
 import (
+	"context"
 	"math/big"
 	"testing"
 
@@ -16,4 +17,13 @@ func TestNewDisabledGasStation(t *testing.T) {
 	gasPrice, err := dgs.GetCurrentGasPrice()
 	assert.Equal(t, big.NewInt(0), gasPrice)
 	assert.Nil(t, err)
+
+	gasPrice, err = dgs.GetCurrentGasPriceWithContext(context.Background())
+	assert.Equal(t, big.NewInt(0), gasPrice)
+	assert.Nil(t, err)
+
+	maxFeePerGas, maxPriorityFeePerGas, err := dgs.GetCurrentDynamicFees()
+	assert.Equal(t, big.NewInt(0), maxFeePerGas)
+	assert.Equal(t, big.NewInt(0), maxPriorityFeePerGas)
+	assert.Nil(t, err)
 }
@@ -1,6 +1,9 @@
 package gasManagement
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // ErrLatestGasPricesWereNotFetched signals that the latest gas price values couldn't have been fetched
 var ErrLatestGasPricesWereNotFetched = errors.New("latest gas price values couldn't have been fetched")
@@ -10,3 +13,31 @@ var ErrInvalidGasPriceSelector = errors.New("invalid gas price selector")
 
 // ErrGasPriceIsHigherThanTheMaximumSet signals that the fetched gas price is higher than the maximum set
 var ErrGasPriceIsHigherThanTheMaximumSet = errors.New("fetched gas price is higher than the maximum set")
+
+// errGasPriceAboveMaximum signals that the gas price is above the maximum set while the cap mode is Error. It wraps
+// ErrGasPriceIsHigherThanTheMaximumSet
+var errGasPriceAboveMaximum = fmt.Errorf("%w, the gas price cap mode refused it", ErrGasPriceIsHigherThanTheMaximumSet)
+
+// ErrInvalidResponseStatusCode signals that the gas station provider responded with an invalid HTTP status code
+var ErrInvalidResponseStatusCode = errors.New("invalid response status code")
+
+// ErrNilGasPriceSuggester signals that a nil gas price suggester has been provided
+var ErrNilGasPriceSuggester = errors.New("nil gas price suggester")
+
+// ErrInvalidSuggestedGasPrice signals that the node suggested an invalid gas price
+var ErrInvalidSuggestedGasPrice = errors.New("invalid suggested gas price")
+
+// ErrInvalidGasPriceSmoothingMode signals that an invalid gas price smoothing mode has been provided
+var ErrInvalidGasPriceSmoothingMode = errors.New("invalid gas price smoothing mode")
+
+// ErrInvalidGasPriceCapMode signals that an invalid gas price cap mode has been provided
+var ErrInvalidGasPriceCapMode = errors.New("invalid gas price cap mode")
+
+// ErrDynamicFeesNotEnabled signals that the dynamic fees were requested but are not enabled
+var ErrDynamicFeesNotEnabled = errors.New("dynamic fees are not enabled")
+
+// ErrLatestDynamicFeesWereNotFetched signals that the latest base fee or priority fee values couldn't have been fetched
+var ErrLatestDynamicFeesWereNotFetched = errors.New("latest dynamic fees values couldn't have been fetched")
+
+// ErrInvalidGasStationResponse signals that the gas station provider responded with malformed data
+var ErrInvalidGasStationResponse = errors.New("invalid gas station response")
@@ -18,6 +18,7 @@ func createMockArgsGasStation() gasManagement.ArgsGasStation {
 		MaximumFetchRetries:    3,
 		RequestTime:            time.Second,
 		MaximumGasPrice:        100,
+		GasPriceCapMode:        "Error",
 		GasPriceSelector:       "SafeGasPrice",
 		GasPriceMultiplier:     1,
 	}
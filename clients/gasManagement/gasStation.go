@@ -5,14 +5,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"math/big"
 	"net/http"
+	"net/url"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 )
 
@@ -21,21 +26,30 @@ const minRequestTime = time.Millisecond
 const logPath = "EthClient/gasStation"
 const minGasPriceMultiplier = 1
 const minFetchRetries = 2
+const minBaseFeeMultiplier = 1
 
 // ArgsGasStation is the DTO used for the creating a new gas handler instance
 type ArgsGasStation struct {
 	RequestURL             string
+	FallbackRequestURLs    []string
 	RequestPollingInterval time.Duration
 	RequestRetryDelay      time.Duration
 	MaximumFetchRetries    int
 	RequestTime            time.Duration
 	MaximumGasPrice        int
+	GasPriceCapMode        core.EthGasPriceCapMode
 	GasPriceSelector       core.EthGasPriceSelector
 	GasPriceMultiplier     int
+	EnableNodeGasFallback  bool
+	NodeGasPriceSuggester  GasPriceSuggester
+	SmoothingWindow        int
+	SmoothingMode          core.EthGasPriceSmoothingMode
+	EnableDynamicFees      bool
+	BaseFeeMultiplier      int
 }
 
 type gasStation struct {
-	requestURL             string
+	requestURLs            []string
 	requestTime            time.Duration
 	requestPollingInterval time.Duration
 	requestRetryDelay      time.Duration
@@ -43,14 +57,24 @@ type gasStation struct {
 	log                    logger.Logger
 	httpClient             HTTPClient
 	maximumGasPrice        int
+	gasPriceCapMode        core.EthGasPriceCapMode
 	cancel                 func()
 	gasPriceSelector       core.EthGasPriceSelector
 	loopStatus             *atomic.Flag
 	gasPriceMultiplier     *big.Int
-
-	mut            sync.RWMutex
-	latestGasPrice int
-	fetchRetries   int
+	enableNodeGasFallback  bool
+	nodeGasPriceSuggester  GasPriceSuggester
+	smoothingWindow        int
+	smoothingMode          core.EthGasPriceSmoothingMode
+	enableDynamicFees      bool
+	baseFeeMultiplier      *big.Int
+
+	mut              sync.RWMutex
+	latestGasPrice   int
+	fetchedGasPrices []int
+	latestBaseFee    *big.Int
+	latestGasTipCap  *big.Int
+	fetchRetries     int
 }
 
 // NewGasStation returns a new gas handler instance for the gas station service
@@ -61,19 +85,30 @@ func NewGasStation(args ArgsGasStation) (*gasStation, error) {
 	}
 
 	gs := &gasStation{
-		requestURL:             args.RequestURL,
+		requestURLs:            append([]string{args.RequestURL}, args.FallbackRequestURLs...),
 		requestTime:            args.RequestTime,
 		requestPollingInterval: args.RequestPollingInterval,
 		requestRetryDelay:      args.RequestRetryDelay,
 		maximumFetchRetries:    args.MaximumFetchRetries,
 		httpClient:             http.DefaultClient,
 		maximumGasPrice:        args.MaximumGasPrice,
+		gasPriceCapMode:        args.GasPriceCapMode,
 		gasPriceSelector:       args.GasPriceSelector,
 		loopStatus:             &atomic.Flag{},
 		gasPriceMultiplier:     big.NewInt(int64(args.GasPriceMultiplier)),
+		enableNodeGasFallback:  args.EnableNodeGasFallback,
+		nodeGasPriceSuggester:  args.NodeGasPriceSuggester,
+		smoothingWindow:        args.SmoothingWindow,
+		smoothingMode:          args.SmoothingMode,
+		fetchedGasPrices:       make([]int, 0, args.SmoothingWindow),
+		enableDynamicFees:      args.EnableDynamicFees,
+		baseFeeMultiplier:      big.NewInt(int64(args.BaseFeeMultiplier)),
 		latestGasPrice:         -1,
 		fetchRetries:           0,
 	}
+	if len(gs.gasPriceCapMode) == 0 {
+		gs.gasPriceCapMode = core.EthGasPriceCapError
+	}
 	gs.log = logger.GetOrCreate(logPath)
 	ctx, cancel := context.WithCancel(context.Background())
 	gs.cancel = cancel
@@ -98,6 +133,22 @@ func checkArgs(args ArgsGasStation) error {
 	if args.MaximumFetchRetries < minFetchRetries {
 		return fmt.Errorf("%w in checkArgs for value MaximumFetchRetries", clients.ErrInvalidValue)
 	}
+	if (args.EnableNodeGasFallback || args.EnableDynamicFees) && check.IfNil(args.NodeGasPriceSuggester) {
+		return ErrNilGasPriceSuggester
+	}
+	if args.EnableDynamicFees && args.BaseFeeMultiplier < minBaseFeeMultiplier {
+		return fmt.Errorf("%w in checkArgs for value BaseFeeMultiplier", clients.ErrInvalidValue)
+	}
+	if args.SmoothingWindow < 0 {
+		return fmt.Errorf("%w in checkArgs for value SmoothingWindow", clients.ErrInvalidValue)
+	}
+	if args.SmoothingWindow > 0 {
+		switch args.SmoothingMode {
+		case core.EthGasPriceSmoothingMean, core.EthGasPriceSmoothingMedian:
+		default:
+			return fmt.Errorf("%w: %q", ErrInvalidGasPriceSmoothingMode, args.SmoothingMode)
+		}
+	}
 
 	switch args.GasPriceSelector {
 	case core.EthFastGasPrice, core.EthProposeGasPrice, core.EthSafeGasPrice:
@@ -105,6 +156,13 @@ func checkArgs(args ArgsGasStation) error {
 		return fmt.Errorf("%w: %q", ErrInvalidGasPriceSelector, args.GasPriceSelector)
 	}
 
+	// an empty cap mode defaults to the error mode
+	switch args.GasPriceCapMode {
+	case "", core.EthGasPriceCapClamp, core.EthGasPriceCapError:
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidGasPriceCapMode, args.GasPriceCapMode)
+	}
+
 	return nil
 }
 
@@ -148,42 +206,195 @@ func (gs *gasStation) doRequestWithRetryMechanism(ctx context.Context) time.Dura
 	return gs.requestPollingInterval
 }
 
+// doRequest will try the gas station providers in the configured order, stopping at the first successful response.
+// If all providers fail and the node fallback is enabled, the gas price suggested by the ethereum node is used.
+// The latest gas price is discarded only if all providers and the node fallback failed and at least one provider
+// responded without a usable gas price
 func (gs *gasStation) doRequest(ctx context.Context) error {
-	bytes, err := gs.doRequestReturningBytes(ctx)
+	hasUnusableGasPrice, err := gs.doRequestOnProviders(ctx)
+	if err != nil && gs.enableNodeGasFallback {
+		gs.log.Debug("gas station: all providers failed, trying the node suggested gas price", "error", err)
+		errNode := gs.doRequestOnNode(ctx)
+		if errNode != nil {
+			err = fmt.Errorf("%w, node fallback error: %s", err, errNode.Error())
+		} else {
+			err = nil
+		}
+	}
+	if err != nil {
+		if hasUnusableGasPrice {
+			gs.mut.Lock()
+			gs.latestGasPrice = -1
+			gs.mut.Unlock()
+		}
+
+		return err
+	}
+
+	if gs.enableDynamicFees {
+		gs.updateGasTipCap(ctx)
+	}
+
+	return nil
+}
+
+// doRequestOnProviders returns the error of the last provider if all providers failed, together with a flag telling
+// if at least one provider responded without a usable gas price
+func (gs *gasStation) doRequestOnProviders(ctx context.Context) (bool, error) {
+	var err error
+	hasUnusableGasPrice := false
+	for index, requestURL := range gs.requestURLs {
+		var isGasPriceUnusable bool
+		isGasPriceUnusable, err = gs.doRequestOnProvider(ctx, requestURL)
+		if err == nil {
+			gs.log.Debug("gas station: fetched gas price", "provider index", index, "provider", providerHost(requestURL))
+			return false, nil
+		}
+		hasUnusableGasPrice = hasUnusableGasPrice || isGasPriceUnusable
+
+		if index < len(gs.requestURLs)-1 {
+			gs.log.Debug("gas station: provider failed, trying the next one",
+				"provider index", index, "provider", providerHost(requestURL), "error", err)
+		}
+	}
+
+	return hasUnusableGasPrice, err
+}
+
+// doRequestOnProvider updates the latest gas price only on success. The returned flag is set if the provider responded
+// but the selected gas price could not be used
+func (gs *gasStation) doRequestOnProvider(ctx context.Context, requestURL string) (bool, error) {
+	bytes, err := gs.doRequestReturningBytes(ctx, requestURL)
 	if err != nil {
-		return fmt.Errorf("%w: %q", err, string(bytes))
+		return false, fmt.Errorf("%w: %q", err, string(bytes))
 	}
 
 	response := &gasStationResponse{}
 	err = json.Unmarshal(bytes, response)
 	if err != nil {
-		return fmt.Errorf("%w: %q", err, string(bytes))
+		return false, fmt.Errorf("%w, %s: %q", ErrInvalidGasStationResponse, err.Error(), string(bytes))
 	}
 
 	gs.log.Debug("gas station: fetched new response", "response data", response)
 
+	gs.mut.RLock()
+	gasPrice, err := gs.selectGasPrice(response)
+	gs.mut.RUnlock()
+	if err != nil {
+		return true, fmt.Errorf("%w: %q", err, string(bytes))
+	}
+	baseFee := gs.convertBaseFee(response.Result.SuggestBaseFee)
+
 	gs.mut.Lock()
-	gs.latestGasPrice = -1
+	gs.latestGasPrice = gasPrice
+	gs.addFetchedGasPrice(gs.latestGasPrice)
+	gs.latestBaseFee = baseFee
+	gs.mut.Unlock()
+
+	return false, nil
+}
+
+// selectGasPrice returns the gas price field chosen by the gas price selector. Should be called under mutex protection
+func (gs *gasStation) selectGasPrice(response *gasStationResponse) (int, error) {
 	switch gs.gasPriceSelector {
 	case core.EthFastGasPrice:
-		_, err = fmt.Sscanf(response.Result.FastGasPrice, "%d", &gs.latestGasPrice)
+		return parseGasPrice(response.Result.FastGasPrice, gs.gasPriceSelector)
 	case core.EthProposeGasPrice:
-		_, err = fmt.Sscanf(response.Result.ProposeGasPrice, "%d", &gs.latestGasPrice)
+		return parseGasPrice(response.Result.ProposeGasPrice, gs.gasPriceSelector)
 	case core.EthSafeGasPrice:
-		_, err = fmt.Sscanf(response.Result.SafeGasPrice, "%d", &gs.latestGasPrice)
+		return parseGasPrice(response.Result.SafeGasPrice, gs.gasPriceSelector)
 	default:
-		err = fmt.Errorf("%w: %q", ErrInvalidGasPriceSelector, gs.gasPriceSelector)
+		return 0, fmt.Errorf("%w: %q", ErrInvalidGasPriceSelector, gs.gasPriceSelector)
 	}
-	gs.mut.Unlock()
+}
+
+// parseGasPrice converts the gas price provided by the gas station, expressed as a decimal value in the gas station
+// unit, rounding up. Missing, non-numeric, non-positive or out of range values are rejected
+func parseGasPrice(value string, selector core.EthGasPriceSelector) (int, error) {
+	value = strings.TrimSpace(value)
+	if len(value) == 0 {
+		return 0, fmt.Errorf("%w, missing %s field", ErrInvalidGasStationResponse, selector)
+	}
+
+	gasPrice, ok := big.NewRat(0, 1).SetString(value)
+	if !ok {
+		return 0, fmt.Errorf("%w, %s field is not a number: %q", ErrInvalidGasStationResponse, selector, value)
+	}
+	if gasPrice.Sign() <= 0 {
+		return 0, fmt.Errorf("%w, %s field is not positive: %q", ErrInvalidGasStationResponse, selector, value)
+	}
+
+	result := big.NewInt(0).Add(gasPrice.Num(), gasPrice.Denom())
+	result.Sub(result, big.NewInt(1))
+	result.Div(result, gasPrice.Denom())
+	if result.Cmp(big.NewInt(math.MaxInt32)) > 0 {
+		return 0, fmt.Errorf("%w, %s field is out of range: %q", ErrInvalidGasStationResponse, selector, value)
+	}
+
+	return int(result.Int64()), nil
+}
+
+// doRequestOnNode fetches the gas price suggested by the node. As the suggested value is expressed in wei, it is
+// converted back, rounding up, in the gas station unit so the maximum gas price and the multiplier apply the same way
+func (gs *gasStation) doRequestOnNode(ctx context.Context) error {
+	suggestedGasPrice, err := gs.nodeGasPriceSuggester.SuggestGasPrice(ctx)
 	if err != nil {
-		return fmt.Errorf("%w: %q", err, string(bytes))
+		return err
+	}
+	if suggestedGasPrice == nil || suggestedGasPrice.Sign() <= 0 {
+		return fmt.Errorf("%w: %v", ErrInvalidSuggestedGasPrice, suggestedGasPrice)
+	}
+
+	gasPrice := big.NewInt(0).Add(suggestedGasPrice, gs.gasPriceMultiplier)
+	gasPrice.Sub(gasPrice, big.NewInt(1))
+	gasPrice.Div(gasPrice, gs.gasPriceMultiplier)
+	if !gasPrice.IsInt64() {
+		return fmt.Errorf("%w: %s", ErrInvalidSuggestedGasPrice, suggestedGasPrice.String())
 	}
 
+	gs.log.Debug("gas station: fetched node suggested gas price", "suggested gas price", suggestedGasPrice.String(),
+		"converted gas price", gasPrice.String())
+
+	gs.mut.Lock()
+	gs.latestGasPrice = int(gasPrice.Int64())
+	gs.addFetchedGasPrice(gs.latestGasPrice)
+	// the node does not provide the base fee
+	gs.latestBaseFee = nil
+	gs.mut.Unlock()
+
 	return nil
 }
 
-func (gs *gasStation) doRequestReturningBytes(ctx context.Context) ([]byte, error) {
-	request, err := http.NewRequestWithContext(ctx, http.MethodGet, gs.requestURL, nil)
+// convertBaseFee converts the base fee provided by the gas station, expressed as a decimal value in the gas station
+// unit, in wei, rounding up. Returns nil if the gas station did not provide a valid base fee
+func (gs *gasStation) convertBaseFee(baseFee string) *big.Int {
+	value, ok := big.NewRat(0, 1).SetString(baseFee)
+	if !ok || value.Sign() <= 0 {
+		gs.log.Debug("gas station: invalid or missing base fee", "base fee", baseFee)
+		return nil
+	}
+
+	value.Mul(value, big.NewRat(0, 1).SetInt(gs.gasPriceMultiplier))
+	result := big.NewInt(0).Add(value.Num(), value.Denom())
+	result.Sub(result, big.NewInt(1))
+
+	return result.Div(result, value.Denom())
+}
+
+func (gs *gasStation) updateGasTipCap(ctx context.Context) {
+	gasTipCap, err := gs.nodeGasPriceSuggester.SuggestGasTipCap(ctx)
+	if err != nil || gasTipCap == nil || gasTipCap.Sign() < 0 {
+		gs.log.Debug("gas station: could not fetch the node suggested priority fee", "priority fee", gasTipCap, "error", err)
+		gasTipCap = nil
+	}
+
+	gs.mut.Lock()
+	gs.latestGasTipCap = gasTipCap
+	gs.mut.Unlock()
+}
+
+func (gs *gasStation) doRequestReturningBytes(ctx context.Context, requestURL string) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -200,14 +411,90 @@ func (gs *gasStation) doRequestReturningBytes(ctx context.Context) ([]byte, erro
 	if err != nil {
 		return nil, err
 	}
+	if response.StatusCode != http.StatusOK {
+		return body, fmt.Errorf("%w: %d", ErrInvalidResponseStatusCode, response.StatusCode)
+	}
 
 	return body, nil
 }
 
-// GetCurrentGasPrice will return the read value from the last query carried on the service provider
-// It errors if the gas price values were not fetched from the service provider or the fetched value
-// exceeds the maximum gas price provided
+// addFetchedGasPrice keeps the last fetched gas prices, up to the smoothing window. Should be called under mutex protection
+func (gs *gasStation) addFetchedGasPrice(gasPrice int) {
+	if gs.smoothingWindow == 0 {
+		return
+	}
+
+	if len(gs.fetchedGasPrices) == gs.smoothingWindow {
+		copy(gs.fetchedGasPrices, gs.fetchedGasPrices[1:])
+		gs.fetchedGasPrices = gs.fetchedGasPrices[:len(gs.fetchedGasPrices)-1]
+	}
+	gs.fetchedGasPrices = append(gs.fetchedGasPrices, gasPrice)
+}
+
+// smoothedGasPrice returns the configured statistic over the last fetched gas prices or the latest fetched
+// gas price if the smoothing is disabled. Should be called under mutex protection
+func (gs *gasStation) smoothedGasPrice() int {
+	if gs.smoothingWindow == 0 || len(gs.fetchedGasPrices) == 0 {
+		return gs.latestGasPrice
+	}
+
+	switch gs.smoothingMode {
+	case core.EthGasPriceSmoothingMedian:
+		return computeMedian(gs.fetchedGasPrices)
+	default:
+		return computeMean(gs.fetchedGasPrices)
+	}
+}
+
+func computeMean(values []int) int {
+	sum := 0
+	for _, value := range values {
+		sum += value
+	}
+
+	return sum / len(values)
+}
+
+func computeMedian(values []int) int {
+	sortedValues := make([]int, len(values))
+	copy(sortedValues, values)
+	sort.Ints(sortedValues)
+
+	middle := len(sortedValues) / 2
+	if len(sortedValues)%2 == 1 {
+		return sortedValues[middle]
+	}
+
+	return (sortedValues[middle-1] + sortedValues[middle]) / 2
+}
+
+// providerHost returns only the host of the provider URL as the URL might contain API keys that should not be logged
+func providerHost(requestURL string) string {
+	parsedURL, err := url.Parse(requestURL)
+	if err != nil {
+		return ""
+	}
+
+	return parsedURL.Host
+}
+
+// GetCurrentGasPrice will return the read value from the last query carried on the service provider.
+// It behaves as GetCurrentGasPriceWithContext called with a background context
 func (gs *gasStation) GetCurrentGasPrice() (*big.Int, error) {
+	return gs.GetCurrentGasPriceWithContext(context.Background())
+}
+
+// GetCurrentGasPriceWithContext will return the read value from the last query carried on the service provider,
+// smoothed over the last fetched values if a smoothing window was set.
+// It errors if the provided context is done or the gas price values were not fetched from the service provider.
+// If the resulting value exceeds the maximum gas price provided, it either errors or returns the maximum gas price,
+// depending on the cap mode
+func (gs *gasStation) GetCurrentGasPriceWithContext(ctx context.Context) (*big.Int, error) {
+	err := ctx.Err()
+	if err != nil {
+		return big.NewInt(0), fmt.Errorf("%w while getting the current gas price", err)
+	}
+
 	gs.mut.RLock()
 	defer gs.mut.RUnlock()
 
@@ -215,15 +502,60 @@ func (gs *gasStation) GetCurrentGasPrice() (*big.Int, error) {
 		return big.NewInt(0), ErrLatestGasPricesWereNotFetched
 	}
 
-	if gs.latestGasPrice > gs.maximumGasPrice {
-		return big.NewInt(0), fmt.Errorf("%w maximum value: %d, fetched value: %d, gas price selector: %s",
-			ErrGasPriceIsHigherThanTheMaximumSet, gs.maximumGasPrice, gs.latestGasPrice, gs.gasPriceSelector)
+	gasPrice := gs.smoothedGasPrice()
+	if gasPrice > gs.maximumGasPrice {
+		if gs.gasPriceCapMode != core.EthGasPriceCapClamp {
+			return big.NewInt(0), fmt.Errorf("%w maximum value: %d, fetched value: %d, gas price selector: %s",
+				errGasPriceAboveMaximum, gs.maximumGasPrice, gasPrice, gs.gasPriceSelector)
+		}
+
+		gs.log.Warn("gas station: fetched gas price is higher than the maximum set, using the maximum value",
+			"maximum value", gs.maximumGasPrice, "fetched value", gasPrice, "gas price selector", gs.gasPriceSelector)
+		gasPrice = gs.maximumGasPrice
 	}
 
-	result := big.NewInt(int64(gs.latestGasPrice))
+	result := big.NewInt(int64(gasPrice))
 	return result.Mul(result, gs.gasPriceMultiplier), nil
 }
 
+// GetCurrentDynamicFees will return the EIP-1559 fees, in wei, computed from the base fee provided by the gas station
+// and the priority fee suggested by the ethereum node: maxFeePerGas = base fee * base fee multiplier + priority fee.
+// It errors if the dynamic fees are not enabled or the values were not fetched. If the resulting max fee exceeds the
+// maximum gas price provided, it either errors or it is capped, depending on the cap mode
+func (gs *gasStation) GetCurrentDynamicFees() (*big.Int, *big.Int, error) {
+	if !gs.enableDynamicFees {
+		return big.NewInt(0), big.NewInt(0), ErrDynamicFeesNotEnabled
+	}
+
+	gs.mut.RLock()
+	defer gs.mut.RUnlock()
+
+	if gs.latestBaseFee == nil || gs.latestGasTipCap == nil {
+		return big.NewInt(0), big.NewInt(0), ErrLatestDynamicFeesWereNotFetched
+	}
+
+	maxPriorityFeePerGas := big.NewInt(0).Set(gs.latestGasTipCap)
+	maxFeePerGas := big.NewInt(0).Mul(gs.latestBaseFee, gs.baseFeeMultiplier)
+	maxFeePerGas.Add(maxFeePerGas, maxPriorityFeePerGas)
+
+	maximumFee := big.NewInt(0).Mul(big.NewInt(int64(gs.maximumGasPrice)), gs.gasPriceMultiplier)
+	if maxFeePerGas.Cmp(maximumFee) > 0 {
+		if gs.gasPriceCapMode != core.EthGasPriceCapClamp {
+			return big.NewInt(0), big.NewInt(0), fmt.Errorf("%w maximum value: %s, max fee per gas: %s",
+				errGasPriceAboveMaximum, maximumFee.String(), maxFeePerGas.String())
+		}
+
+		gs.log.Warn("gas station: max fee per gas is higher than the maximum set, using the maximum value",
+			"maximum value", maximumFee.String(), "max fee per gas", maxFeePerGas.String())
+		maxFeePerGas = maximumFee
+		if maxPriorityFeePerGas.Cmp(maxFeePerGas) > 0 {
+			maxPriorityFeePerGas.Set(maxFeePerGas)
+		}
+	}
+
+	return maxFeePerGas, maxPriorityFeePerGas, nil
+}
+
 // Close will stop any started go routines
 func (gs *gasStation) Close() error {
 	gs.cancel()
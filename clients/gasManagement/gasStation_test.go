@@ -1,6 +1,7 @@
 package gasManagement
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,11 +9,13 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/interactors"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -26,6 +29,7 @@ func createMockArgsGasStation() ArgsGasStation {
 		MaximumFetchRetries:    3,
 		RequestTime:            time.Second,
 		MaximumGasPrice:        100,
+		GasPriceCapMode:        "Error",
 		GasPriceSelector:       "SafeGasPrice",
 		GasPriceMultiplier:     1000000000,
 	}
@@ -78,6 +82,14 @@ func TestNewGasStation(t *testing.T) {
 		assert.True(t, check.IfNil(gs))
 		assert.True(t, errors.Is(err, ErrInvalidGasPriceSelector))
 	})
+	t.Run("invalid gas price cap mode", func(t *testing.T) {
+		args := createMockArgsGasStation()
+		args.GasPriceCapMode = "invalid"
+
+		gs, err := NewGasStation(args)
+		assert.True(t, check.IfNil(gs))
+		assert.True(t, errors.Is(err, ErrInvalidGasPriceCapMode))
+	})
 	t.Run("invalid gas price multiplier", func(t *testing.T) {
 		args := createMockArgsGasStation()
 		args.GasPriceMultiplier = 0
@@ -87,6 +99,61 @@ func TestNewGasStation(t *testing.T) {
 		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
 		assert.True(t, strings.Contains(err.Error(), "checkArgs for value GasPriceMultiplier"))
 	})
+	t.Run("node gas fallback enabled with nil gas price suggester", func(t *testing.T) {
+		args := createMockArgsGasStation()
+		args.EnableNodeGasFallback = true
+
+		gs, err := NewGasStation(args)
+		assert.True(t, check.IfNil(gs))
+		assert.Equal(t, ErrNilGasPriceSuggester, err)
+	})
+	t.Run("dynamic fees enabled with nil gas price suggester", func(t *testing.T) {
+		args := createMockArgsGasStation()
+		args.EnableDynamicFees = true
+		args.BaseFeeMultiplier = 2
+
+		gs, err := NewGasStation(args)
+		assert.True(t, check.IfNil(gs))
+		assert.Equal(t, ErrNilGasPriceSuggester, err)
+	})
+	t.Run("invalid base fee multiplier", func(t *testing.T) {
+		args := createMockArgsGasStation()
+		args.EnableDynamicFees = true
+		args.NodeGasPriceSuggester = &interactors.BlockchainClientStub{}
+
+		gs, err := NewGasStation(args)
+		assert.True(t, check.IfNil(gs))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "checkArgs for value BaseFeeMultiplier"))
+	})
+	t.Run("invalid smoothing window", func(t *testing.T) {
+		args := createMockArgsGasStation()
+		args.SmoothingWindow = -1
+
+		gs, err := NewGasStation(args)
+		assert.True(t, check.IfNil(gs))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "checkArgs for value SmoothingWindow"))
+	})
+	t.Run("invalid smoothing mode", func(t *testing.T) {
+		args := createMockArgsGasStation()
+		args.SmoothingWindow = 3
+		args.SmoothingMode = "invalid"
+
+		gs, err := NewGasStation(args)
+		assert.True(t, check.IfNil(gs))
+		assert.True(t, errors.Is(err, ErrInvalidGasPriceSmoothingMode))
+	})
+	t.Run("invalid smoothing mode is ignored when smoothing is disabled", func(t *testing.T) {
+		args := createMockArgsGasStation()
+		args.SmoothingMode = "invalid"
+
+		gs, err := NewGasStation(args)
+		assert.False(t, check.IfNil(gs))
+		assert.Nil(t, err)
+
+		_ = gs.Close()
+	})
 	t.Run("should work", func(t *testing.T) {
 		args := createMockArgsGasStation()
 
@@ -407,6 +474,665 @@ func TestGasStation_GetCurrentGasPriceExceededMaximum(t *testing.T) {
 	_ = gs.Close()
 }
 
+func TestGasStation_GetCurrentGasPriceWithContext(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGasStation()
+	gs, err := NewGasStation(args)
+	require.Nil(t, err)
+	_ = gs.Close()
+	// let the processing loop finish its first request
+	time.Sleep(time.Millisecond * 100)
+
+	gs.mut.Lock()
+	gs.latestGasPrice = 20
+	gs.mut.Unlock()
+
+	t.Run("done context should error", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		price, err := gs.GetCurrentGasPriceWithContext(ctx)
+		assert.True(t, errors.Is(err, context.Canceled))
+		assert.Equal(t, big.NewInt(0), price)
+	})
+	t.Run("should work", func(t *testing.T) {
+		expected := big.NewInt(0).Mul(big.NewInt(20), big.NewInt(int64(args.GasPriceMultiplier)))
+
+		price, err := gs.GetCurrentGasPriceWithContext(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, expected, price)
+
+		price, err = gs.GetCurrentGasPrice()
+		assert.Nil(t, err)
+		assert.Equal(t, expected, price)
+	})
+}
+
+func TestGasStation_GasPriceCapMode(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGasStation()
+	multiplier := big.NewInt(int64(args.GasPriceMultiplier))
+	getCurrentGasPrice := func(t *testing.T, capMode core.EthGasPriceCapMode, fetchedGasPrice int) (*big.Int, error) {
+		argsCapMode := createMockArgsGasStation()
+		argsCapMode.GasPriceCapMode = capMode
+		gs, err := NewGasStation(argsCapMode)
+		require.Nil(t, err)
+		_ = gs.Close()
+		// let the processing loop finish its first request
+		time.Sleep(time.Millisecond * 100)
+
+		gs.mut.Lock()
+		gs.latestGasPrice = fetchedGasPrice
+		gs.mut.Unlock()
+
+		return gs.GetCurrentGasPrice()
+	}
+
+	t.Run("clamp mode", func(t *testing.T) {
+		t.Parallel()
+
+		price, err := getCurrentGasPrice(t, core.EthGasPriceCapClamp, args.MaximumGasPrice-1)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(0).Mul(big.NewInt(int64(args.MaximumGasPrice-1)), multiplier), price)
+
+		price, err = getCurrentGasPrice(t, core.EthGasPriceCapClamp, args.MaximumGasPrice)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(0).Mul(big.NewInt(int64(args.MaximumGasPrice)), multiplier), price)
+
+		price, err = getCurrentGasPrice(t, core.EthGasPriceCapClamp, args.MaximumGasPrice+1)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(0).Mul(big.NewInt(int64(args.MaximumGasPrice)), multiplier), price)
+	})
+	t.Run("error mode", func(t *testing.T) {
+		t.Parallel()
+
+		price, err := getCurrentGasPrice(t, core.EthGasPriceCapError, args.MaximumGasPrice-1)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(0).Mul(big.NewInt(int64(args.MaximumGasPrice-1)), multiplier), price)
+
+		price, err = getCurrentGasPrice(t, core.EthGasPriceCapError, args.MaximumGasPrice)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(0).Mul(big.NewInt(int64(args.MaximumGasPrice)), multiplier), price)
+
+		price, err = getCurrentGasPrice(t, core.EthGasPriceCapError, args.MaximumGasPrice+1)
+		assert.True(t, errors.Is(err, errGasPriceAboveMaximum))
+		assert.True(t, errors.Is(err, ErrGasPriceIsHigherThanTheMaximumSet))
+		assert.Equal(t, big.NewInt(0), price)
+	})
+	t.Run("empty mode should default to error mode", func(t *testing.T) {
+		t.Parallel()
+
+		price, err := getCurrentGasPrice(t, "", args.MaximumGasPrice)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(0).Mul(big.NewInt(int64(args.MaximumGasPrice)), multiplier), price)
+
+		price, err = getCurrentGasPrice(t, "", args.MaximumGasPrice+1)
+		assert.True(t, errors.Is(err, errGasPriceAboveMaximum))
+		assert.Equal(t, big.NewInt(0), price)
+	})
+}
+
+func TestGasStation_MultipleProviders(t *testing.T) {
+	t.Parallel()
+
+	gsResponse := createMockGasStationResponse()
+	createFailingServer := func(numCalled *uint32) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			atomic.AddUint32(numCalled, 1)
+			rw.WriteHeader(http.StatusInternalServerError)
+			_, _ = rw.Write([]byte("internal server error"))
+		}))
+	}
+	createWorkingServer := func(numCalled *uint32, response gasStationResponse) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			atomic.AddUint32(numCalled, 1)
+			rw.WriteHeader(http.StatusOK)
+			resp, _ := json.Marshal(&response)
+			_, _ = rw.Write(resp)
+		}))
+	}
+
+	t.Run("first provider fails, second provider should be used", func(t *testing.T) {
+		t.Parallel()
+
+		numCalledFirst, numCalledSecond, numCalledThird := uint32(0), uint32(0), uint32(0)
+		firstServer := createFailingServer(&numCalledFirst)
+		defer firstServer.Close()
+		secondServer := createWorkingServer(&numCalledSecond, gsResponse)
+		defer secondServer.Close()
+		thirdResponse := createMockGasStationResponse()
+		thirdResponse.Result.SafeGasPrice = "1"
+		thirdServer := createWorkingServer(&numCalledThird, thirdResponse)
+		defer thirdServer.Close()
+
+		args := createMockArgsGasStation()
+		args.RequestURL = firstServer.URL
+		args.FallbackRequestURLs = []string{secondServer.URL, thirdServer.URL}
+		gs, err := NewGasStation(args)
+		require.Nil(t, err)
+
+		time.Sleep(time.Second)
+		_ = gs.Close()
+
+		price, err := gs.GetCurrentGasPrice()
+		require.Nil(t, err)
+		expected := big.NewInt(0).Mul(big.NewInt(81), big.NewInt(int64(args.GasPriceMultiplier)))
+		assert.Equal(t, expected, price)
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalledFirst))
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalledSecond))
+		assert.Equal(t, uint32(0), atomic.LoadUint32(&numCalledThird))
+	})
+	t.Run("all providers fail should not fetch the gas price", func(t *testing.T) {
+		t.Parallel()
+
+		numCalledFirst, numCalledSecond := uint32(0), uint32(0)
+		firstServer := createFailingServer(&numCalledFirst)
+		defer firstServer.Close()
+		secondServer := createFailingServer(&numCalledSecond)
+		defer secondServer.Close()
+
+		args := createMockArgsGasStation()
+		args.RequestURL = firstServer.URL
+		args.FallbackRequestURLs = []string{secondServer.URL}
+		gs, err := NewGasStation(args)
+		require.Nil(t, err)
+
+		time.Sleep(time.Millisecond * 500)
+		_ = gs.Close()
+
+		price, err := gs.GetCurrentGasPrice()
+		assert.Equal(t, ErrLatestGasPricesWereNotFetched, err)
+		assert.Equal(t, big.NewInt(0), price)
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalledFirst))
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalledSecond))
+	})
+	t.Run("failing providers should keep the latest gas price", func(t *testing.T) {
+		t.Parallel()
+
+		var gs *gasStation
+		observedGasPrice := int32(0)
+		firstServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rw.WriteHeader(http.StatusOK)
+			_, _ = rw.Write([]byte("not a json"))
+		}))
+		defer firstServer.Close()
+		numCalledSecond := uint32(0)
+		secondServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			atomic.AddUint32(&numCalledSecond, 1)
+			atomic.StoreInt32(&observedGasPrice, int32(gs.GetLatestGasPrice()))
+			rw.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer secondServer.Close()
+
+		args := createMockArgsGasStation()
+		args.RequestURL = firstServer.URL
+		args.FallbackRequestURLs = []string{secondServer.URL}
+		gs, _ = NewGasStation(args)
+		_ = gs.Close()
+		// let the processing loop finish its first request
+		time.Sleep(time.Millisecond * 100)
+
+		gs.mut.Lock()
+		gs.latestGasPrice = 50
+		gs.mut.Unlock()
+		atomic.StoreUint32(&numCalledSecond, 0)
+
+		err := gs.doRequest(context.Background())
+		assert.NotNil(t, err)
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalledSecond))
+		assert.Equal(t, int32(50), atomic.LoadInt32(&observedGasPrice))
+		assert.Equal(t, 50, gs.GetLatestGasPrice())
+	})
+}
+
+func TestGasStation_InvalidStatusCodeShouldError(t *testing.T) {
+	t.Parallel()
+
+	gsResponse := createMockGasStationResponse()
+	httpServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusTooManyRequests)
+		resp, _ := json.Marshal(&gsResponse)
+		_, _ = rw.Write(resp)
+	}))
+	defer httpServer.Close()
+
+	args := createMockArgsGasStation()
+	args.RequestURL = httpServer.URL
+	gs, err := NewGasStation(args)
+	require.Nil(t, err)
+	_ = gs.Close()
+
+	err = gs.doRequest(context.Background())
+	assert.True(t, errors.Is(err, ErrInvalidResponseStatusCode))
+	assert.Equal(t, -1, gs.GetLatestGasPrice())
+}
+
+func TestGasStation_NodeGasFallback(t *testing.T) {
+	t.Parallel()
+
+	createFailingServer := func() *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rw.WriteHeader(http.StatusInternalServerError)
+		}))
+	}
+	createGasStation := func(t *testing.T, requestURL string, enabled bool, suggester GasPriceSuggester) *gasStation {
+		args := createMockArgsGasStation()
+		args.RequestURL = requestURL
+		args.EnableNodeGasFallback = enabled
+		args.NodeGasPriceSuggester = suggester
+		gs, err := NewGasStation(args)
+		require.Nil(t, err)
+		_ = gs.Close()
+		// let the processing loop finish its first request
+		time.Sleep(time.Millisecond * 100)
+
+		return gs
+	}
+	createCountingSuggester := func(numCalled *uint32) *interactors.BlockchainClientStub {
+		return &interactors.BlockchainClientStub{
+			SuggestGasPriceCalled: func(ctx context.Context) (*big.Int, error) {
+				atomic.AddUint32(numCalled, 1)
+				return big.NewInt(1), nil
+			},
+		}
+	}
+
+	t.Run("fallback disabled should not query the node", func(t *testing.T) {
+		t.Parallel()
+
+		server := createFailingServer()
+		defer server.Close()
+		numCalled := uint32(0)
+		gs := createGasStation(t, server.URL, false, createCountingSuggester(&numCalled))
+		atomic.StoreUint32(&numCalled, 0)
+
+		err := gs.doRequest(context.Background())
+		assert.True(t, errors.Is(err, ErrInvalidResponseStatusCode))
+		assert.Equal(t, -1, gs.GetLatestGasPrice())
+		assert.Equal(t, uint32(0), atomic.LoadUint32(&numCalled))
+	})
+	t.Run("working provider should not query the node", func(t *testing.T) {
+		t.Parallel()
+
+		gsResponse := createMockGasStationResponse()
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rw.WriteHeader(http.StatusOK)
+			resp, _ := json.Marshal(&gsResponse)
+			_, _ = rw.Write(resp)
+		}))
+		defer server.Close()
+		numCalled := uint32(0)
+		gs := createGasStation(t, server.URL, true, createCountingSuggester(&numCalled))
+		atomic.StoreUint32(&numCalled, 0)
+
+		err := gs.doRequest(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 81, gs.GetLatestGasPrice())
+		assert.Equal(t, uint32(0), atomic.LoadUint32(&numCalled))
+	})
+	t.Run("node errors should return both errors", func(t *testing.T) {
+		t.Parallel()
+
+		server := createFailingServer()
+		defer server.Close()
+		expectedErr := errors.New("expected error")
+		suggester := &interactors.BlockchainClientStub{
+			SuggestGasPriceCalled: func(ctx context.Context) (*big.Int, error) {
+				return nil, expectedErr
+			},
+		}
+		gs := createGasStation(t, server.URL, true, suggester)
+
+		err := gs.doRequest(context.Background())
+		assert.True(t, errors.Is(err, ErrInvalidResponseStatusCode))
+		assert.True(t, strings.Contains(err.Error(), expectedErr.Error()))
+		assert.Equal(t, -1, gs.GetLatestGasPrice())
+	})
+	t.Run("node returns invalid gas price should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := createFailingServer()
+		defer server.Close()
+		suggester := &interactors.BlockchainClientStub{
+			SuggestGasPriceCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(0), nil
+			},
+		}
+		gs := createGasStation(t, server.URL, true, suggester)
+
+		err := gs.doRequest(context.Background())
+		assert.True(t, strings.Contains(err.Error(), ErrInvalidSuggestedGasPrice.Error()))
+		assert.Equal(t, -1, gs.GetLatestGasPrice())
+	})
+	t.Run("all providers fail should use the node suggested gas price", func(t *testing.T) {
+		t.Parallel()
+
+		server := createFailingServer()
+		defer server.Close()
+		suggester := &interactors.BlockchainClientStub{
+			SuggestGasPriceCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(42000000001), nil
+			},
+		}
+		gs := createGasStation(t, server.URL, true, suggester)
+
+		err := gs.doRequest(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 43, gs.GetLatestGasPrice())
+
+		price, err := gs.GetCurrentGasPrice()
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(43000000000), price)
+	})
+}
+
+func TestGasStation_MalformedResponses(t *testing.T) {
+	t.Parallel()
+
+	createGasStation := func(t *testing.T, response string, enableNodeGasFallback bool) *gasStation {
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rw.WriteHeader(http.StatusOK)
+			_, _ = rw.Write([]byte(response))
+		}))
+		t.Cleanup(server.Close)
+
+		args := createMockArgsGasStation()
+		args.RequestURL = server.URL
+		args.EnableNodeGasFallback = enableNodeGasFallback
+		args.NodeGasPriceSuggester = &interactors.BlockchainClientStub{
+			SuggestGasPriceCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(42000000000), nil
+			},
+		}
+		gs, err := NewGasStation(args)
+		require.Nil(t, err)
+		_ = gs.Close()
+		// let the processing loop finish its first request
+		time.Sleep(time.Millisecond * 100)
+
+		return gs
+	}
+
+	malformedResponses := map[string]string{
+		"truncated response":     `{"status":"1","message":"OK","result":{"LastBlock":"14836699","SafeGasPrice":"8`,
+		"wrong schema":           `{"status":"0","message":"NOTOK","result":"Max rate limit reached"}`,
+		"missing field":          `{"status":"1","message":"OK","result":{"ProposeGasPrice":"82","FastGasPrice":"83"}}`,
+		"empty object":           `{}`,
+		"non numeric value":      `{"status":"1","message":"OK","result":{"SafeGasPrice":"eighty"}}`,
+		"zero value":             `{"status":"1","message":"OK","result":{"SafeGasPrice":"0"}}`,
+		"negative value":         `{"status":"1","message":"OK","result":{"SafeGasPrice":"-81"}}`,
+		"out of range value":     `{"status":"1","message":"OK","result":{"SafeGasPrice":"100000000000000000000"}}`,
+		"number instead of text": `{"status":"1","message":"OK","result":{"SafeGasPrice":81}}`,
+	}
+	for name, response := range malformedResponses {
+		response := response
+		t.Run(name+" should error", func(t *testing.T) {
+			t.Parallel()
+
+			gs := createGasStation(t, response, false)
+
+			err := gs.doRequest(context.Background())
+			assert.True(t, errors.Is(err, ErrInvalidGasStationResponse))
+			assert.Equal(t, -1, gs.GetLatestGasPrice())
+
+			gasPrice, err := gs.GetCurrentGasPrice()
+			assert.Equal(t, big.NewInt(0), gasPrice)
+			assert.Equal(t, ErrLatestGasPricesWereNotFetched, err)
+		})
+		t.Run(name+" should use the node suggested gas price", func(t *testing.T) {
+			t.Parallel()
+
+			gs := createGasStation(t, response, true)
+
+			err := gs.doRequest(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, 42, gs.GetLatestGasPrice())
+		})
+	}
+
+	t.Run("decimal value should be rounded up", func(t *testing.T) {
+		t.Parallel()
+
+		gs := createGasStation(t, `{"status":"1","message":"OK","result":{"SafeGasPrice":"12.1"}}`, false)
+
+		err := gs.doRequest(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 13, gs.GetLatestGasPrice())
+	})
+}
+
+func TestGasStation_Smoothing(t *testing.T) {
+	t.Parallel()
+
+	createGasStation := func(t *testing.T, window int, mode core.EthGasPriceSmoothingMode) *gasStation {
+		args := createMockArgsGasStation()
+		args.SmoothingWindow = window
+		args.SmoothingMode = mode
+		gs, err := NewGasStation(args)
+		require.Nil(t, err)
+		_ = gs.Close()
+		// let the processing loop finish its first request
+		time.Sleep(time.Millisecond * 100)
+
+		return gs
+	}
+	fetchGasPrices := func(gs *gasStation, gasPrices ...int) {
+		gs.mut.Lock()
+		defer gs.mut.Unlock()
+
+		for _, gasPrice := range gasPrices {
+			gs.latestGasPrice = gasPrice
+			gs.addFetchedGasPrice(gasPrice)
+		}
+	}
+	multiplier := big.NewInt(int64(createMockArgsGasStation().GasPriceMultiplier))
+
+	t.Run("smoothing disabled should return the latest value", func(t *testing.T) {
+		t.Parallel()
+
+		gs := createGasStation(t, 0, "")
+		fetchGasPrices(gs, 10, 90, 20)
+
+		price, err := gs.GetCurrentGasPrice()
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(0).Mul(big.NewInt(20), multiplier), price)
+		assert.Empty(t, gs.fetchedGasPrices)
+	})
+	t.Run("mean over the window", func(t *testing.T) {
+		t.Parallel()
+
+		gs := createGasStation(t, 3, core.EthGasPriceSmoothingMean)
+		fetchGasPrices(gs, 10)
+		price, err := gs.GetCurrentGasPrice()
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(0).Mul(big.NewInt(10), multiplier), price)
+
+		fetchGasPrices(gs, 90, 20, 40)
+		price, err = gs.GetCurrentGasPrice()
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(0).Mul(big.NewInt(50), multiplier), price)
+		assert.Equal(t, []int{90, 20, 40}, gs.fetchedGasPrices)
+	})
+	t.Run("median over the window", func(t *testing.T) {
+		t.Parallel()
+
+		gs := createGasStation(t, 3, core.EthGasPriceSmoothingMedian)
+		fetchGasPrices(gs, 10, 90, 20, 95)
+		price, err := gs.GetCurrentGasPrice()
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(0).Mul(big.NewInt(90), multiplier), price)
+		assert.Equal(t, []int{90, 20, 95}, gs.fetchedGasPrices)
+	})
+	t.Run("spike should be smoothed below the maximum gas price", func(t *testing.T) {
+		t.Parallel()
+
+		gs := createGasStation(t, 3, core.EthGasPriceSmoothingMedian)
+		fetchGasPrices(gs, 40, 45, 500)
+		price, err := gs.GetCurrentGasPrice()
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(0).Mul(big.NewInt(45), multiplier), price)
+	})
+}
+
+func TestComputeMeanAndMedian(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 5, computeMean([]int{5}))
+	assert.Equal(t, 3, computeMean([]int{1, 2, 4, 6}))
+	assert.Equal(t, 5, computeMedian([]int{5}))
+	assert.Equal(t, 4, computeMedian([]int{9, 1, 4}))
+	assert.Equal(t, 3, computeMedian([]int{6, 1, 2, 4}))
+
+	values := []int{3, 1, 2}
+	_ = computeMedian(values)
+	assert.Equal(t, []int{3, 1, 2}, values)
+}
+
+func TestGasStation_GetCurrentDynamicFees(t *testing.T) {
+	t.Parallel()
+
+	gsResponse := createMockGasStationResponse()
+	gsResponse.Result.SuggestBaseFee = "30.5"
+	createServer := func() *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rw.WriteHeader(http.StatusOK)
+			resp, _ := json.Marshal(&gsResponse)
+			_, _ = rw.Write(resp)
+		}))
+	}
+	createGasStation := func(t *testing.T, requestURL string, capMode core.EthGasPriceCapMode, suggester GasPriceSuggester) *gasStation {
+		args := createMockArgsGasStation()
+		args.RequestURL = requestURL
+		args.GasPriceCapMode = capMode
+		args.EnableDynamicFees = true
+		args.BaseFeeMultiplier = 2
+		args.NodeGasPriceSuggester = suggester
+		gs, err := NewGasStation(args)
+		require.Nil(t, err)
+		_ = gs.Close()
+		// let the processing loop finish its first request
+		time.Sleep(time.Millisecond * 100)
+
+		return gs
+	}
+	createSuggester := func(gasTipCap *big.Int, err error) *interactors.BlockchainClientStub {
+		return &interactors.BlockchainClientStub{
+			SuggestGasTipCapCalled: func(ctx context.Context) (*big.Int, error) {
+				return gasTipCap, err
+			},
+		}
+	}
+
+	t.Run("dynamic fees not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		gs, err := NewGasStation(createMockArgsGasStation())
+		require.Nil(t, err)
+		_ = gs.Close()
+
+		maxFeePerGas, maxPriorityFeePerGas, err := gs.GetCurrentDynamicFees()
+		assert.Equal(t, ErrDynamicFeesNotEnabled, err)
+		assert.Equal(t, big.NewInt(0), maxFeePerGas)
+		assert.Equal(t, big.NewInt(0), maxPriorityFeePerGas)
+	})
+	t.Run("not fetched should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := createServer()
+		defer server.Close()
+		gs := createGasStation(t, server.URL, core.EthGasPriceCapError, createSuggester(big.NewInt(2000000000), nil))
+		gs.mut.Lock()
+		gs.latestBaseFee = nil
+		gs.mut.Unlock()
+
+		_, _, err := gs.GetCurrentDynamicFees()
+		assert.Equal(t, ErrLatestDynamicFeesWereNotFetched, err)
+	})
+	t.Run("node priority fee errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := createServer()
+		defer server.Close()
+		gs := createGasStation(t, server.URL, core.EthGasPriceCapError, createSuggester(nil, errors.New("expected error")))
+		err := gs.doRequest(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 81, gs.GetLatestGasPrice())
+
+		_, _, err = gs.GetCurrentDynamicFees()
+		assert.Equal(t, ErrLatestDynamicFeesWereNotFetched, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		server := createServer()
+		defer server.Close()
+		gs := createGasStation(t, server.URL, core.EthGasPriceCapError, createSuggester(big.NewInt(2000000000), nil))
+		err := gs.doRequest(context.Background())
+		assert.Nil(t, err)
+
+		maxFeePerGas, maxPriorityFeePerGas, err := gs.GetCurrentDynamicFees()
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(63000000000), maxFeePerGas)
+		assert.Equal(t, big.NewInt(2000000000), maxPriorityFeePerGas)
+
+		gasPrice, err := gs.GetCurrentGasPrice()
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(81000000000), gasPrice)
+	})
+	t.Run("above maximum in error mode should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := createServer()
+		defer server.Close()
+		gs := createGasStation(t, server.URL, core.EthGasPriceCapError, createSuggester(big.NewInt(40000000000), nil))
+		err := gs.doRequest(context.Background())
+		assert.Nil(t, err)
+
+		maxFeePerGas, maxPriorityFeePerGas, err := gs.GetCurrentDynamicFees()
+		assert.True(t, errors.Is(err, ErrGasPriceIsHigherThanTheMaximumSet))
+		assert.Equal(t, big.NewInt(0), maxFeePerGas)
+		assert.Equal(t, big.NewInt(0), maxPriorityFeePerGas)
+	})
+	t.Run("above maximum in clamp mode should cap the fees", func(t *testing.T) {
+		t.Parallel()
+
+		server := createServer()
+		defer server.Close()
+		gs := createGasStation(t, server.URL, core.EthGasPriceCapClamp, createSuggester(big.NewInt(120000000000), nil))
+		err := gs.doRequest(context.Background())
+		assert.Nil(t, err)
+
+		maxFeePerGas, maxPriorityFeePerGas, err := gs.GetCurrentDynamicFees()
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(100000000000), maxFeePerGas)
+		assert.Equal(t, big.NewInt(100000000000), maxPriorityFeePerGas)
+	})
+}
+
+func TestGasStation_ConvertBaseFee(t *testing.T) {
+	t.Parallel()
+
+	gs, err := NewGasStation(createMockArgsGasStation())
+	require.Nil(t, err)
+	_ = gs.Close()
+
+	assert.Nil(t, gs.convertBaseFee(""))
+	assert.Nil(t, gs.convertBaseFee("invalid"))
+	assert.Nil(t, gs.convertBaseFee("0"))
+	assert.Nil(t, gs.convertBaseFee("-1"))
+	assert.Equal(t, big.NewInt(30000000000), gs.convertBaseFee("30"))
+	assert.Equal(t, big.NewInt(30123456790), gs.convertBaseFee("30.1234567891"))
+}
+
+func TestProviderHost(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "api.etherscan.io", providerHost("https://api.etherscan.io/api?module=gastracker&action=gasoracle&apikey=secret"))
+	assert.Equal(t, "", providerHost("://invalid"))
+}
+
 func createMockGasStationResponse() gasStationResponse {
 	return gasStationResponse{
 		Status:  "1",
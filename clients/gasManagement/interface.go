@@ -1,8 +1,19 @@
 package gasManagement
 
-import "net/http"
+import (
+	"context"
+	"math/big"
+	"net/http"
+)
 
 // HTTPClient is the interface we expect to call in order to do the HTTP requests
 type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
+
+// GasPriceSuggester defines the component able to suggest a gas price and a priority fee, in wei, such as the ethereum node
+type GasPriceSuggester interface {
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	IsInterfaceNil() bool
+}
@@ -8,6 +8,8 @@ import (
 // GasHandler defines the component able to fetch the current gas price
 type GasHandler interface {
 	GetCurrentGasPrice() (*big.Int, error)
+	GetCurrentGasPriceWithContext(ctx context.Context) (*big.Int, error)
+	GetCurrentDynamicFees() (maxFeePerGas *big.Int, maxPriorityFeePerGas *big.Int, err error)
 	IsInterfaceNil() bool
 }
 
@@ -16,19 +16,22 @@ const ethSignatureSize = 64
 
 // ArgsEthereumRoleProvider is the argument for the ethereum role provider constructor
 type ArgsEthereumRoleProvider struct {
-	EthereumChainInteractor EthereumChainInteractor
-	Log                     logger.Logger
+	EthereumChainInteractor  EthereumChainInteractor
+	Log                      logger.Logger
+	PreviousRelayerAddresses []common.Address
 }
 
 type ethereumRoleProvider struct {
-	ethereumChainInteractor EthereumChainInteractor
-	log                     logger.Logger
-	whitelistedAddresses    map[common.Address]struct{}
-	mut                     sync.RWMutex
+	ethereumChainInteractor  EthereumChainInteractor
+	log                      logger.Logger
+	previousRelayerAddresses []common.Address
+	whitelistedAddresses     map[common.Address]struct{}
+	mut                      sync.RWMutex
 }
 
 // NewEthereumRoleProvider creates a new ethereum role provider instance able to fetch the
-// whitelisted addresses and able to check ethereum signatures
+// whitelisted addresses and able to check ethereum signatures. The previous relayer addresses are always
+// whitelisted so the signatures of a relayer that recently rotated its key are still accepted
 func NewEthereumRoleProvider(args ArgsEthereumRoleProvider) (*ethereumRoleProvider, error) {
 	err := checkEthereumRoleProviderSpecificArgs(args)
 	if err != nil {
@@ -36,10 +39,12 @@ func NewEthereumRoleProvider(args ArgsEthereumRoleProvider) (*ethereumRoleProvid
 	}
 
 	erp := &ethereumRoleProvider{
-		whitelistedAddresses:    make(map[common.Address]struct{}),
-		ethereumChainInteractor: args.EthereumChainInteractor,
-		log:                     args.Log,
+		whitelistedAddresses:     make(map[common.Address]struct{}),
+		ethereumChainInteractor:  args.EthereumChainInteractor,
+		log:                      args.Log,
+		previousRelayerAddresses: make([]common.Address, len(args.PreviousRelayerAddresses)),
 	}
+	copy(erp.previousRelayerAddresses, args.PreviousRelayerAddresses)
 
 	return erp, nil
 }
@@ -77,6 +82,10 @@ func (erp *ethereumRoleProvider) processResults(results []common.Address) {
 		erp.whitelistedAddresses[addr] = struct{}{}
 		currentList = append(currentList, addr.String())
 	}
+	for _, addr := range erp.previousRelayerAddresses {
+		erp.whitelistedAddresses[addr] = struct{}{}
+		currentList = append(currentList, addr.String()+" (previous relayer address)")
+	}
 	erp.mut.Unlock()
 
 	erp.log.Debug("fetched Ethereum whitelisted addresses:\n" + strings.Join(currentList, "\n"))
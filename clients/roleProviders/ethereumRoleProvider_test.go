@@ -112,6 +112,30 @@ func testEthereumExecuteShouldWork(whitelistedAddresses []common.Address) func(t
 	}
 }
 
+func TestEthereumProvider_ExecuteShouldKeepPreviousRelayerAddresses(t *testing.T) {
+	t.Parallel()
+
+	relayer := common.HexToAddress("0x132A150926691F08a693721503a38affeD18d524")
+	previousRelayer := common.HexToAddress("0xb6e20FF4Ae7d29be233D874633F2F0Dcb326E5c0")
+	args := createEthereumMockArgs()
+	args.EthereumChainInteractor = &bridgeTests.EthereumClientWrapperStub{
+		GetRelayersCalled: func(ctx context.Context) ([]common.Address, error) {
+			return []common.Address{relayer}, nil
+		},
+	}
+	args.PreviousRelayerAddresses = []common.Address{previousRelayer}
+
+	erp, _ := NewEthereumRoleProvider(args)
+	assert.False(t, erp.isWhitelisted(previousRelayer))
+
+	for i := 0; i < 2; i++ {
+		err := erp.Execute(context.TODO())
+		assert.Nil(t, err)
+		assert.True(t, erp.isWhitelisted(relayer))
+		assert.True(t, erp.isWhitelisted(previousRelayer))
+	}
+}
+
 func TestEthereumRoleProvider_VerifyEthSignature(t *testing.T) {
 	t.Parallel()
 
@@ -129,6 +153,26 @@ func TestEthereumRoleProvider_VerifyEthSignature(t *testing.T) {
 	hexMsg = "c124f221e1992619dfe3254e46a97bd7d787ed4e699f48aca715d54e7f52ff5d"
 	hexSig = "b0ddb854c7c6a5c78cdbf9e7e6c204711c162220298dc1bfab58be77b8627c155ae4dac5d06197283407993b359752f8906487fc0e3a031173fd07c010e5cddc00"
 	t.Run("address not whitelisted", testEthereumVerifySigShouldWork(whitelistedAddresses, hexSig, hexMsg, ErrAddressIsNotWhitelisted))
+	t.Run("previous relayer address should verify", func(t *testing.T) {
+		t.Parallel()
+
+		sig, _ := hex.DecodeString(hexSig)
+		msg, _ := hex.DecodeString(hexMsg)
+		args := createEthereumMockArgs()
+		args.EthereumChainInteractor = &bridgeTests.EthereumClientWrapperStub{
+			GetRelayersCalled: func(ctx context.Context) ([]common.Address, error) {
+				return whitelistedAddresses, nil
+			},
+		}
+		args.PreviousRelayerAddresses = []common.Address{common.HexToAddress("0xb6e20FF4Ae7d29be233D874633F2F0Dcb326E5c0")}
+
+		erp, _ := NewEthereumRoleProvider(args)
+		err := erp.Execute(context.TODO())
+		assert.Nil(t, err)
+
+		err = erp.VerifyEthSignature(sig, msg)
+		assert.Nil(t, err)
+	})
 }
 
 func testEthereumVerifySigShouldWork(whitelistedAddresses []common.Address, hexSig string, hexMsg string, expectedErr error) func(t *testing.T) {
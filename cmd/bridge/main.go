@@ -126,6 +126,7 @@ func startRelay(ctx *cli.Context, version string) error {
 	}
 
 	metricsHolder := status.NewMetricsHolder()
+	healthReporter := status.NewHealthReporter()
 	ethClientStatusHandler, err := status.NewStatusHandler(core.EthClientStatusHandlerName, statusStorer)
 	if err != nil {
 		return err
@@ -228,6 +229,7 @@ func startRelay(ctx *cli.Context, version string) error {
 		TimeForBootstrap:          timeForBootstrap,
 		TimeBeforeRepeatJoin:      timeBeforeRepeatJoin,
 		MetricsHolder:             metricsHolder,
+		HealthReporter:            healthReporter,
 		AppStatusHandler:          appStatusHandler.StatusHandler(),
 		ElrondClientStatusHandler: elrondClientStatusHandler,
 	}
@@ -252,11 +254,16 @@ func startRelay(ctx *cli.Context, version string) error {
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 
-	<-sigs
+	var lastErr error
+	select {
+	case <-sigs:
+	case fatalErr := <-ethToElrondComponents.FatalErrorChan():
+		log.Error("halting the relay after a fatal error", "error", fatalErr)
+		lastErr = fatalErr
+	}
 
 	log.Info("application closing, calling Close on all subcomponents...")
 
-	var lastErr error
 	err = ethToElrondComponents.Close()
 	if err != nil {
 		lastErr = err
@@ -277,6 +284,11 @@ func loadConfig(filepath string) (config.Config, error) {
 		return config.Config{}, err
 	}
 
+	err = cfg.Validate()
+	if err != nil {
+		return config.Config{}, err
+	}
+
 	return cfg, nil
 }
 
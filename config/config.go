@@ -31,26 +31,48 @@ type EthereumConfig struct {
 	MultisigContractAddress            string
 	SafeContractAddress                string
 	PrivateKeyFile                     string
+	PrivateKeyEnvVar                   string
+	PreviousRelayerAddresses           []string
 	IntervalToResendTxsInSeconds       uint64
 	GasLimitBase                       uint64
 	GasLimitForEach                    uint64
 	GasStation                         GasStationConfig
 	MaxRetriesOnQuorumReached          uint64
 	IntervalToWaitForTransferInSeconds uint64
+	ResetNonceOnStuckTransfer          bool
 	MaxBlocksDelta                     uint64
+	SupportedTokens                    []string
+	MaxDepositsPerBatch                uint64
+	MaxBatchAgeInBlocks                uint64
+	CheckExecutedBeforeSend            bool
+	RPCMaxRetries                      uint64
+	RPCRetryDelayInMillis              uint64
+	QuorumCheckMode                    string
+	TransferFeesInBasisPoints          map[string]uint64
+	FinalityBlocks                     uint64
+	ExecutionConfirmations             uint64
+	SortDepositsByNonce                bool
+	MaxTransferAmounts                 map[string]string
 }
 
 // GasStationConfig represents the configuration for the gas station handler
 type GasStationConfig struct {
 	Enabled                    bool
 	URL                        string
+	FallbackURLs               []string
 	PollingIntervalInSeconds   int
 	RequestRetryDelayInSeconds int
 	MaxFetchRetries            int
 	RequestTimeInSeconds       int
 	MaximumAllowedGasPrice     int
+	GasPriceCapMode            string
 	GasPriceSelector           string
 	GasPriceMultiplier         int
+	EnableNodeGasFallback      bool
+	SmoothingWindow            int
+	SmoothingMode              string
+	EnableDynamicFees          bool
+	BaseFeeMultiplier          int
 }
 
 // ConfigP2P configuration for the P2P communication
@@ -67,12 +89,18 @@ type ConfigRelayer struct {
 	Marshalizer          config.MarshalizerConfig
 	RoleProvider         RoleProviderConfig
 	StatusMetricsStorage config.StorageConfig
+	SignaturesHolder     SignaturesHolderConfig
+	ReadOnly             bool
 }
 
 // ConfigStateMachine the configuration for the state machine
 type ConfigStateMachine struct {
-	StepDurationInMillis       uint64
-	IntervalForLeaderInSeconds uint64
+	StepDurationInMillis          uint64
+	IntervalForLeaderInSeconds    uint64
+	LeaderSkewToleranceInSeconds  uint64
+	StepExecutionTimeoutInMillis  uint64
+	StepDurationOverridesInMillis map[string]uint64
+	MaxConsecutiveErrors          uint32
 }
 
 // ContextFlagsConfig the configuration for flags
@@ -106,6 +134,9 @@ type BatchValidatorConfig struct {
 	Enabled              bool
 	URL                  string
 	RequestTimeInSeconds int
+	MaxRetries           uint64
+	RetryDelayInMillis   uint64
+	RequestMethod        string
 }
 
 // ApiRoutesConfig holds the configuration related to Rest API routes
@@ -141,11 +172,18 @@ type RoleProviderConfig struct {
 	PollingIntervalInMillis uint64
 }
 
+// SignaturesHolderConfig is the configuration for the component holding the signatures received from the peers
+type SignaturesHolderConfig struct {
+	MaxMessageHashes     int
+	MaxSignaturesPerHash int
+}
+
 // ElrondConfig represents the Elrond Config parameters
 type ElrondConfig struct {
 	NetworkAddress                  string
 	MultisigContractAddress         string
 	PrivateKeyFile                  string
+	PrivateKeyEnvVar                string
 	IntervalToResendTxsInSeconds    uint64
 	GasMap                          ElrondGasMapConfig
 	MaxRetriesOnQuorumReached       uint64
@@ -154,6 +192,10 @@ type ElrondConfig struct {
 	ProxyRestAPIEntityType          string
 	ProxyMaxNoncesDelta             int
 	ProxyFinalityCheck              bool
+	TokensMapperCacheTTLInSeconds   uint64
+	TokensMapperCacheMaxSize        int
+	ProxyCallTimeoutInMillis        uint64
+	EstimateGasUsingProxy           bool
 }
 
 // ElrondGasMapConfig represents the gas limits for Elrond operations
@@ -0,0 +1,9 @@
+package config
+
+import "errors"
+
+// ErrInvalidConfig signals that the configuration contains invalid values
+var ErrInvalidConfig = errors.New("invalid config")
+
+// ErrInvalidEthereumAddress signals that an Ethereum address from the configuration is empty or malformed
+var ErrInvalidEthereumAddress = errors.New("invalid Ethereum address")
@@ -0,0 +1,178 @@
+package config
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients/chain"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// configValidator collects all the problems found in the configuration
+type configValidator struct {
+	problems []string
+}
+
+func (cv *configValidator) addProblem(format string, args ...interface{}) {
+	cv.problems = append(cv.problems, fmt.Sprintf(format, args...))
+}
+
+func (cv *configValidator) checkNotEmpty(field string, value string) {
+	if len(strings.TrimSpace(value)) == 0 {
+		cv.addProblem("%s is empty", field)
+	}
+}
+
+func (cv *configValidator) checkHexAddress(field string, value string) {
+	err := validateHexAddress(field, value)
+	if err != nil {
+		cv.addProblem("%v", err)
+	}
+}
+
+func validateHexAddress(field string, value string) error {
+	if len(strings.TrimSpace(value)) == 0 {
+		return fmt.Errorf("%w: %s is empty", ErrInvalidEthereumAddress, field)
+	}
+	if !common.IsHexAddress(value) {
+		return fmt.Errorf("%w: %s is not a valid hex address: %q", ErrInvalidEthereumAddress, field, value)
+	}
+
+	return nil
+}
+
+func (cv *configValidator) checkPositive(field string, value uint64) {
+	if value == 0 {
+		cv.addProblem("%s should be positive", field)
+	}
+}
+
+func (cv *configValidator) checkPositiveInt(field string, value int) {
+	if value <= 0 {
+		cv.addProblem("%s should be positive, got: %d", field, value)
+	}
+}
+
+func (cv *configValidator) checkNotNegativeInt(field string, value int) {
+	if value < 0 {
+		cv.addProblem("%s should not be negative, got: %d", field, value)
+	}
+}
+
+func (cv *configValidator) checkPrivateKey(section string, keyFile string, envVarName string) {
+	if len(strings.TrimSpace(keyFile)) == 0 && len(strings.TrimSpace(envVarName)) == 0 {
+		cv.addProblem("%s.PrivateKeyFile and %s.PrivateKeyEnvVar are both empty", section, section)
+	}
+}
+
+func (cv *configValidator) error() error {
+	if len(cv.problems) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", ErrInvalidConfig, strings.Join(cv.problems, "; "))
+}
+
+// Validate checks the configuration values and returns an error listing all the invalid ones
+func (cfg Config) Validate() error {
+	cv := &configValidator{}
+	cfg.Eth.validate(cv)
+	cfg.Elrond.validate(cv)
+
+	names := make([]string, 0, len(cfg.StateMachine))
+	for name := range cfg.StateMachine {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		stateMachineConfig := cfg.StateMachine[name]
+		cv.checkPositive(fmt.Sprintf("StateMachine.%s.StepDurationInMillis", name), stateMachineConfig.StepDurationInMillis)
+		cv.checkPositive(fmt.Sprintf("StateMachine.%s.IntervalForLeaderInSeconds", name), stateMachineConfig.IntervalForLeaderInSeconds)
+		if 2*stateMachineConfig.LeaderSkewToleranceInSeconds >= stateMachineConfig.IntervalForLeaderInSeconds {
+			cv.addProblem("StateMachine.%s.LeaderSkewToleranceInSeconds should be less than half of IntervalForLeaderInSeconds", name)
+		}
+	}
+	cv.checkPositive("Relayer.RoleProvider.PollingIntervalInMillis", cfg.Relayer.RoleProvider.PollingIntervalInMillis)
+	cv.checkNotNegativeInt("Relayer.SignaturesHolder.MaxMessageHashes", cfg.Relayer.SignaturesHolder.MaxMessageHashes)
+	cv.checkNotNegativeInt("Relayer.SignaturesHolder.MaxSignaturesPerHash", cfg.Relayer.SignaturesHolder.MaxSignaturesPerHash)
+
+	return cv.error()
+}
+
+// ValidateAddresses checks that the contract addresses are well-formed hex addresses, as common.HexToAddress
+// would silently convert a malformed value into the zero address
+func (ethConfig EthereumConfig) ValidateAddresses() error {
+	err := validateHexAddress("Eth.MultisigContractAddress", ethConfig.MultisigContractAddress)
+	if err != nil {
+		return err
+	}
+
+	return validateHexAddress("Eth.SafeContractAddress", ethConfig.SafeContractAddress)
+}
+
+func (ethConfig EthereumConfig) validate(cv *configValidator) {
+	switch ethConfig.Chain {
+	case chain.Ethereum, chain.Bsc:
+	default:
+		cv.addProblem("Eth.Chain is not a recognized EVM compatible chain: %q", ethConfig.Chain)
+	}
+	cv.checkNotEmpty("Eth.NetworkAddress", ethConfig.NetworkAddress)
+	cv.checkHexAddress("Eth.MultisigContractAddress", ethConfig.MultisigContractAddress)
+	cv.checkHexAddress("Eth.SafeContractAddress", ethConfig.SafeContractAddress)
+	cv.checkPrivateKey("Eth", ethConfig.PrivateKeyFile, ethConfig.PrivateKeyEnvVar)
+	cv.checkPositive("Eth.GasLimitBase", ethConfig.GasLimitBase)
+	cv.checkPositive("Eth.GasLimitForEach", ethConfig.GasLimitForEach)
+	cv.checkPositive("Eth.IntervalToWaitForTransferInSeconds", ethConfig.IntervalToWaitForTransferInSeconds)
+	if ethConfig.RPCMaxRetries > 0 {
+		cv.checkPositive("Eth.RPCRetryDelayInMillis", ethConfig.RPCRetryDelayInMillis)
+	}
+	for i, address := range ethConfig.PreviousRelayerAddresses {
+		cv.checkHexAddress(fmt.Sprintf("Eth.PreviousRelayerAddresses[%d]", i), address)
+	}
+	for i, token := range ethConfig.SupportedTokens {
+		cv.checkHexAddress(fmt.Sprintf("Eth.SupportedTokens[%d]", i), token)
+	}
+	for token := range ethConfig.TransferFeesInBasisPoints {
+		cv.checkHexAddress(fmt.Sprintf("Eth.TransferFeesInBasisPoints[%q]", token), token)
+	}
+	for token, maxAmount := range ethConfig.MaxTransferAmounts {
+		name := fmt.Sprintf("Eth.MaxTransferAmounts[%q]", token)
+		cv.checkHexAddress(name, token)
+		value, ok := big.NewInt(0).SetString(maxAmount, 10)
+		if !ok || value.Sign() <= 0 {
+			cv.addProblem("%s is not a valid positive amount: %q", name, maxAmount)
+		}
+	}
+
+	gasStation := ethConfig.GasStation
+	if !gasStation.Enabled {
+		return
+	}
+	cv.checkNotEmpty("Eth.GasStation.URL", gasStation.URL)
+	cv.checkPositiveInt("Eth.GasStation.PollingIntervalInSeconds", gasStation.PollingIntervalInSeconds)
+	cv.checkPositiveInt("Eth.GasStation.RequestRetryDelayInSeconds", gasStation.RequestRetryDelayInSeconds)
+	cv.checkPositiveInt("Eth.GasStation.RequestTimeInSeconds", gasStation.RequestTimeInSeconds)
+	cv.checkPositiveInt("Eth.GasStation.GasPriceMultiplier", gasStation.GasPriceMultiplier)
+}
+
+func (elrondConfig ElrondConfig) validate(cv *configValidator) {
+	cv.checkNotEmpty("Elrond.NetworkAddress", elrondConfig.NetworkAddress)
+	_, err := data.NewAddressFromBech32String(elrondConfig.MultisigContractAddress)
+	if err != nil {
+		cv.addProblem("Elrond.MultisigContractAddress is not a valid bech32 address: %q", elrondConfig.MultisigContractAddress)
+	}
+	cv.checkPrivateKey("Elrond", elrondConfig.PrivateKeyFile, elrondConfig.PrivateKeyEnvVar)
+	cv.checkPositive("Elrond.IntervalToResendTxsInSeconds", elrondConfig.IntervalToResendTxsInSeconds)
+
+	gasMap := elrondConfig.GasMap
+	cv.checkPositive("Elrond.GasMap.Sign", gasMap.Sign)
+	cv.checkPositive("Elrond.GasMap.ProposeTransferBase", gasMap.ProposeTransferBase)
+	cv.checkPositive("Elrond.GasMap.ProposeTransferForEach", gasMap.ProposeTransferForEach)
+	cv.checkPositive("Elrond.GasMap.ProposeStatusBase", gasMap.ProposeStatusBase)
+	cv.checkPositive("Elrond.GasMap.ProposeStatusForEach", gasMap.ProposeStatusForEach)
+	cv.checkPositive("Elrond.GasMap.PerformActionBase", gasMap.PerformActionBase)
+	cv.checkPositive("Elrond.GasMap.PerformActionForEach", gasMap.PerformActionForEach)
+}
@@ -0,0 +1,158 @@
+package config
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	elrondCore "github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadTestConfig(t *testing.T) Config {
+	cfg := Config{}
+	err := elrondCore.LoadTomlFile(&cfg, "../cmd/bridge/config/config.toml")
+	require.Nil(t, err)
+
+	return cfg
+}
+
+func TestConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default config should be valid", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := loadTestConfig(t)
+		assert.Nil(t, cfg.Validate())
+	})
+	t.Run("should list all the problems", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := loadTestConfig(t)
+		cfg.Eth.Chain = "unknown"
+		cfg.Eth.SafeContractAddress = ""
+		cfg.Eth.MultisigContractAddress = "0xinvalid"
+		cfg.Eth.GasLimitBase = 0
+		cfg.Eth.PreviousRelayerAddresses = []string{"previous"}
+		cfg.Eth.SupportedTokens = []string{"3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c", "invalid"}
+		cfg.Eth.TransferFeesInBasisPoints = map[string]uint64{"invalid fee token": 25}
+		cfg.Eth.MaxTransferAmounts = map[string]string{"3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c": "-5"}
+		cfg.Elrond.MultisigContractAddress = "erd1invalid"
+		cfg.Elrond.GasMap.Sign = 0
+		stateMachineConfig := cfg.StateMachine["EthereumToElrond"]
+		stateMachineConfig.StepDurationInMillis = 0
+		cfg.StateMachine["EthereumToElrond"] = stateMachineConfig
+
+		err := cfg.Validate()
+		require.True(t, errors.Is(err, ErrInvalidConfig))
+		expectedProblems := []string{
+			`Eth.Chain is not a recognized EVM compatible chain: "unknown"`,
+			`Eth.MultisigContractAddress is not a valid hex address: "0xinvalid"`,
+			"Eth.SafeContractAddress is empty",
+			"Eth.GasLimitBase should be positive",
+			`Eth.PreviousRelayerAddresses[0] is not a valid hex address: "previous"`,
+			`Eth.SupportedTokens[1] is not a valid hex address: "invalid"`,
+			`Eth.TransferFeesInBasisPoints["invalid fee token"] is not a valid hex address: "invalid fee token"`,
+			`Eth.MaxTransferAmounts["3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c"] is not a valid positive amount: "-5"`,
+			`Elrond.MultisigContractAddress is not a valid bech32 address: "erd1invalid"`,
+			"Elrond.GasMap.Sign should be positive",
+			"StateMachine.EthereumToElrond.StepDurationInMillis should be positive",
+		}
+		for _, problem := range expectedProblems {
+			assert.True(t, strings.Contains(err.Error(), problem), problem)
+		}
+		assert.Equal(t, len(expectedProblems), strings.Count(err.Error(), ";")+1)
+	})
+	t.Run("leader skew tolerance should be less than half of the leader interval", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := loadTestConfig(t)
+		stateMachineConfig := cfg.StateMachine["ElrondToEthereum"]
+		stateMachineConfig.LeaderSkewToleranceInSeconds = stateMachineConfig.IntervalForLeaderInSeconds / 2
+		cfg.StateMachine["ElrondToEthereum"] = stateMachineConfig
+
+		err := cfg.Validate()
+		require.True(t, errors.Is(err, ErrInvalidConfig))
+		assert.True(t, strings.Contains(err.Error(), "StateMachine.ElrondToEthereum.LeaderSkewToleranceInSeconds should be less than half of IntervalForLeaderInSeconds"))
+
+		stateMachineConfig.LeaderSkewToleranceInSeconds--
+		cfg.StateMachine["ElrondToEthereum"] = stateMachineConfig
+		assert.Nil(t, cfg.Validate())
+	})
+	t.Run("disabled gas station should not be validated", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := loadTestConfig(t)
+		cfg.Eth.GasStation.URL = ""
+		assert.NotNil(t, cfg.Validate())
+
+		cfg.Eth.GasStation.Enabled = false
+		assert.Nil(t, cfg.Validate())
+	})
+	t.Run("optional values left to zero should be valid", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := loadTestConfig(t)
+		cfg.Eth.MaxDepositsPerBatch = 0
+		cfg.Relayer.SignaturesHolder.MaxMessageHashes = 0
+		cfg.Relayer.SignaturesHolder.MaxSignaturesPerHash = 0
+		assert.Nil(t, cfg.Validate())
+	})
+	t.Run("negative signatures holder capacities should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := loadTestConfig(t)
+		cfg.Relayer.SignaturesHolder.MaxMessageHashes = -1
+		cfg.Relayer.SignaturesHolder.MaxSignaturesPerHash = -2
+		err := cfg.Validate()
+		require.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "Relayer.SignaturesHolder.MaxMessageHashes should not be negative, got: -1"))
+		assert.True(t, strings.Contains(err.Error(), "Relayer.SignaturesHolder.MaxSignaturesPerHash should not be negative, got: -2"))
+	})
+	t.Run("private key should be set either by file or by environment variable", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := loadTestConfig(t)
+		cfg.Eth.PrivateKeyFile = ""
+		cfg.Eth.PrivateKeyEnvVar = "ETH_PRIVATE_KEY"
+		assert.Nil(t, cfg.Validate())
+
+		cfg.Elrond.PrivateKeyFile = ""
+		err := cfg.Validate()
+		require.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "Elrond.PrivateKeyFile and Elrond.PrivateKeyEnvVar are both empty"))
+	})
+}
+
+func TestEthereumConfig_ValidateAddresses(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid addresses should work", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := loadTestConfig(t)
+		assert.Nil(t, cfg.Eth.ValidateAddresses())
+	})
+	t.Run("empty multisig address should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := loadTestConfig(t)
+		cfg.Eth.MultisigContractAddress = " "
+
+		err := cfg.Eth.ValidateAddresses()
+		assert.True(t, errors.Is(err, ErrInvalidEthereumAddress))
+		assert.True(t, strings.Contains(err.Error(), "Eth.MultisigContractAddress is empty"))
+	})
+	t.Run("malformed safe address should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := loadTestConfig(t)
+		cfg.Eth.SafeContractAddress = "A6504Cc508889bbDBd4B748aFf6EA6b5D0d2684"
+
+		err := cfg.Eth.ValidateAddresses()
+		assert.True(t, errors.Is(err, ErrInvalidEthereumAddress))
+		assert.True(t, strings.Contains(err.Error(), "Eth.SafeContractAddress is not a valid hex address"))
+	})
+}
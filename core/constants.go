@@ -10,6 +10,27 @@ const (
 	// EthProposeGasPrice represents the proposed gas price value
 	EthProposeGasPrice EthGasPriceSelector = "ProposeGasPrice"
 
+	// EthGasPriceCapClamp represents the cap mode that returns the maximum allowed gas price instead of a higher value
+	EthGasPriceCapClamp EthGasPriceCapMode = "Clamp"
+
+	// EthGasPriceCapError represents the cap mode that refuses to return a gas price higher than the maximum allowed value
+	EthGasPriceCapError EthGasPriceCapMode = "Error"
+
+	// EthGasPriceSmoothingMean represents the smoothing mode that uses the mean of the fetched gas prices
+	EthGasPriceSmoothingMean EthGasPriceSmoothingMode = "Mean"
+
+	// EthGasPriceSmoothingMedian represents the smoothing mode that uses the median of the fetched gas prices
+	EthGasPriceSmoothingMedian EthGasPriceSmoothingMode = "Median"
+
+	// EthQuorumCheckOff represents the quorum check mode that uses the provided quorum without querying the contract
+	EthQuorumCheckOff EthQuorumCheckMode = "Off"
+
+	// EthQuorumCheckRaise represents the quorum check mode that raises a lower provided quorum to the contract quorum
+	EthQuorumCheckRaise EthQuorumCheckMode = "Raise"
+
+	// EthQuorumCheckError represents the quorum check mode that refuses to execute a transfer with a lower provided quorum
+	EthQuorumCheckError EthQuorumCheckMode = "Error"
+
 	// WebServerOffString represents the constant used to switch off the web server
 	WebServerOffString = "off"
 )
@@ -18,6 +39,10 @@ const (
 	// MetricNumBatches represents the metric used for counting the number of executed batches
 	MetricNumBatches = "num batches"
 
+	// MetricCurrentBatchCorrelationID represents the metric used to store the correlation ID of the batch currently
+	// processed by the state machine
+	MetricCurrentBatchCorrelationID = "current batch correlation ID"
+
 	// MetricLastError represents the metric used to store the last encountered error
 	MetricLastError = "last encountered error"
 
@@ -58,6 +83,76 @@ const (
 
 	// MetricLastBlockNonce represents the last block nonce queried
 	MetricLastBlockNonce = "last block nonce"
+
+	// MetricStepAverageDurationInMillis represents the metric suffix used to store the average execution duration of a step
+	MetricStepAverageDurationInMillis = "average duration in millis"
+
+	// MetricStepMaxDurationInMillis represents the metric suffix used to store the maximum execution duration of a step
+	MetricStepMaxDurationInMillis = "max duration in millis"
+
+	// MetricNumTransfersProposed represents the metric used to count the number of proposed transfers
+	MetricNumTransfersProposed = "num transfers proposed"
+
+	// MetricNumTransfersSigned represents the metric used to count the number of signed transfers
+	MetricNumTransfersSigned = "num transfers signed"
+
+	// MetricNumTransfersSent represents the metric used to count the number of sent transfer transactions
+	MetricNumTransfersSent = "num transfers sent"
+
+	// MetricNumTransfersFailed represents the metric used to count the number of failed transfer executions
+	MetricNumTransfersFailed = "num transfers failed"
+
+	// MetricLastExecutionGasPrice represents the metric used to store the gas price used by the last transfer execution
+	MetricLastExecutionGasPrice = "last execution gas price"
+
+	// MetricAverageExecutionGasPrice represents the metric used to store the average gas price used by the transfer executions
+	MetricAverageExecutionGasPrice = "average execution gas price"
+
+	// MetricAverageBatchExecutionDurationInMillis represents the metric used to store the average time passed between
+	// the batch detection and its execution
+	MetricAverageBatchExecutionDurationInMillis = "average batch execution duration in millis"
+
+	// MetricMaxBatchExecutionDurationInMillis represents the metric used to store the maximum time passed between
+	// the batch detection and its execution
+	MetricMaxBatchExecutionDurationInMillis = "max batch execution duration in millis"
+
+	// MetricNumEvictedSignatureHashes represents the metric used to count the message hashes evicted from the
+	// signatures holder as the maximum number of tracked hashes was reached
+	MetricNumEvictedSignatureHashes = "num evicted signature hashes"
+
+	// MetricNumDroppedSignatures represents the metric used to count the signatures dropped as the maximum number
+	// of signatures for their message hash was reached
+	MetricNumDroppedSignatures = "num dropped signatures"
+
+	// MetricNumStuckTransfers represents the metric used to count the transfer transactions sent on Ethereum that
+	// were not mined in the wait interval
+	MetricNumStuckTransfers = "num stuck transfers"
+
+	// MetricNumBatchValidatorRequestsSucceeded represents the metric used to count the batch validator requests that
+	// got a response
+	MetricNumBatchValidatorRequestsSucceeded = "num batch validator requests succeeded"
+
+	// MetricNumBatchValidatorRequestsFailed represents the metric used to count the batch validator requests that
+	// failed after all retries
+	MetricNumBatchValidatorRequestsFailed = "num batch validator requests failed"
+
+	// MetricNumBatchValidatorValidBatches represents the metric used to count the batches found valid by the batch validator
+	MetricNumBatchValidatorValidBatches = "num batch validator valid batches"
+
+	// MetricNumBatchValidatorInvalidBatches represents the metric used to count the batches rejected by the batch validator
+	MetricNumBatchValidatorInvalidBatches = "num batch validator invalid batches"
+
+	// MetricLastBatchValidatorRequestDurationInMillis represents the metric used to store the duration of the last
+	// batch validator request, retries included
+	MetricLastBatchValidatorRequestDurationInMillis = "last batch validator request duration in millis"
+
+	// MetricAverageBatchValidatorRequestDurationInMillis represents the metric used to store the average duration of
+	// the batch validator requests, retries included
+	MetricAverageBatchValidatorRequestDurationInMillis = "average batch validator request duration in millis"
+
+	// MetricMaxBatchValidatorRequestDurationInMillis represents the metric used to store the maximum duration of
+	// the batch validator requests, retries included
+	MetricMaxBatchValidatorRequestDurationInMillis = "max batch validator request duration in millis"
 )
 
 // PersistedMetrics represents the array of metrics that should be persisted
@@ -71,4 +166,10 @@ const (
 
 	// ElrondClientStatusHandlerName is the elrond client status handler name
 	ElrondClientStatusHandlerName = "elrond-client"
+
+	// TransfersStatusHandlerName is the transfers lifecycle status handler name
+	TransfersStatusHandlerName = "transfers"
+
+	// BatchValidatorStatusHandlerName is the batch validator status handler name
+	BatchValidatorStatusHandlerName = "batch-validator"
 )
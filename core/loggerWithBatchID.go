@@ -0,0 +1,146 @@
+package core
+
+import (
+	"context"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+const batchIDLogKey = "batch ID"
+const correlationIDLogKey = "correlation ID"
+
+type batchIDContextKey struct{}
+
+type correlationIDContextKey struct{}
+
+// ContextWithBatchID returns a copy of the provided context that carries the batch ID
+func ContextWithBatchID(ctx context.Context, batchID uint64) context.Context {
+	return context.WithValue(ctx, batchIDContextKey{}, batchID)
+}
+
+// BatchIDFromContext returns the batch ID stored in the provided context, if any
+func BatchIDFromContext(ctx context.Context) (uint64, bool) {
+	if ctx == nil {
+		return 0, false
+	}
+
+	batchID, ok := ctx.Value(batchIDContextKey{}).(uint64)
+
+	return batchID, ok
+}
+
+// ContextWithCorrelationID returns a copy of the provided context that carries the batch correlation ID
+func ContextWithCorrelationID(ctx context.Context, correlationID string) context.Context {
+	return context.WithValue(ctx, correlationIDContextKey{}, correlationID)
+}
+
+// CorrelationIDFromContext returns the batch correlation ID stored in the provided context, if any
+func CorrelationIDFromContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+
+	correlationID, ok := ctx.Value(correlationIDContextKey{}).(string)
+
+	return correlationID, ok && len(correlationID) > 0
+}
+
+// loggerWithBatchID is a decorator for the logger that appends the batch ID and, if set, the batch correlation ID
+// on each log line
+type loggerWithBatchID struct {
+	logger        logger.Logger
+	batchID       uint64
+	correlationID string
+}
+
+// NewLoggerWithBatchID returns a logger that will append the batch ID and the correlation ID found in the provided
+// context to all log lines. If the context does not hold a batch ID, the provided logger is returned
+func NewLoggerWithBatchID(ctx context.Context, log logger.Logger) logger.Logger {
+	batchID, found := BatchIDFromContext(ctx)
+	if !found || log == nil {
+		return log
+	}
+
+	correlationID, _ := CorrelationIDFromContext(ctx)
+
+	return &loggerWithBatchID{
+		logger:        log,
+		batchID:       batchID,
+		correlationID: correlationID,
+	}
+}
+
+// Trace outputs a tracing log message with optional provided arguments, followed by the batch ID
+func (l *loggerWithBatchID) Trace(message string, args ...interface{}) {
+	l.logger.Trace(message, l.appendBatchID(args)...)
+}
+
+// Debug outputs a debugging log message with optional provided arguments, followed by the batch ID
+func (l *loggerWithBatchID) Debug(message string, args ...interface{}) {
+	l.logger.Debug(message, l.appendBatchID(args)...)
+}
+
+// Info outputs an information log message with optional provided arguments, followed by the batch ID
+func (l *loggerWithBatchID) Info(message string, args ...interface{}) {
+	l.logger.Info(message, l.appendBatchID(args)...)
+}
+
+// Warn outputs a warning log message with optional provided arguments, followed by the batch ID
+func (l *loggerWithBatchID) Warn(message string, args ...interface{}) {
+	l.logger.Warn(message, l.appendBatchID(args)...)
+}
+
+// Error outputs an error log message with optional provided arguments, followed by the batch ID
+func (l *loggerWithBatchID) Error(message string, args ...interface{}) {
+	l.logger.Error(message, l.appendBatchID(args)...)
+}
+
+// LogIfError outputs an error log message with optional provided arguments if the provided error parameter is not nil
+func (l *loggerWithBatchID) LogIfError(err error, args ...interface{}) {
+	if err == nil {
+		return
+	}
+
+	l.Error(err.Error(), args...)
+}
+
+// Log outputs a log message with optional provided arguments, followed by the batch ID
+func (l *loggerWithBatchID) Log(logLevel logger.LogLevel, message string, args ...interface{}) {
+	l.logger.Log(logLevel, message, l.appendBatchID(args)...)
+}
+
+// LogLine forwards the log line towards underlying log output handler, adding the batch ID
+func (l *loggerWithBatchID) LogLine(line *logger.LogLine) {
+	if line == nil {
+		return
+	}
+
+	line.Args = l.appendBatchID(line.Args)
+	l.logger.LogLine(line)
+}
+
+// SetLevel sets the current level of the logger
+func (l *loggerWithBatchID) SetLevel(logLevel logger.LogLevel) {
+	l.logger.SetLevel(logLevel)
+}
+
+// GetLevel gets the current level of the logger
+func (l *loggerWithBatchID) GetLevel() logger.LogLevel {
+	return l.logger.GetLevel()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (l *loggerWithBatchID) IsInterfaceNil() bool {
+	return l == nil
+}
+
+func (l *loggerWithBatchID) appendBatchID(args []interface{}) []interface{} {
+	newArgs := make([]interface{}, 0, len(args)+4)
+	newArgs = append(newArgs, args...)
+	newArgs = append(newArgs, batchIDLogKey, l.batchID)
+	if len(l.correlationID) > 0 {
+		newArgs = append(newArgs, correlationIDLogKey, l.correlationID)
+	}
+
+	return newArgs
+}
@@ -0,0 +1,132 @@
+package core_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchIDFromContext(t *testing.T) {
+	t.Parallel()
+
+	t.Run("context without batch ID", func(t *testing.T) {
+		t.Parallel()
+
+		batchID, found := core.BatchIDFromContext(context.Background())
+		assert.False(t, found)
+		assert.Zero(t, batchID)
+	})
+	t.Run("context with batch ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := core.ContextWithBatchID(context.Background(), 37)
+		batchID, found := core.BatchIDFromContext(ctx)
+		assert.True(t, found)
+		assert.Equal(t, uint64(37), batchID)
+	})
+}
+
+func TestCorrelationIDFromContext(t *testing.T) {
+	t.Parallel()
+
+	t.Run("context without correlation ID", func(t *testing.T) {
+		t.Parallel()
+
+		correlationID, found := core.CorrelationIDFromContext(context.Background())
+		assert.False(t, found)
+		assert.Empty(t, correlationID)
+	})
+	t.Run("context with empty correlation ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := core.ContextWithCorrelationID(context.Background(), "")
+		correlationID, found := core.CorrelationIDFromContext(ctx)
+		assert.False(t, found)
+		assert.Empty(t, correlationID)
+	})
+	t.Run("context with correlation ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := core.ContextWithCorrelationID(context.Background(), "a1b2c3")
+		correlationID, found := core.CorrelationIDFromContext(ctx)
+		assert.True(t, found)
+		assert.Equal(t, "a1b2c3", correlationID)
+	})
+}
+
+func TestNewLoggerWithBatchID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("context without batch ID should return the same logger", func(t *testing.T) {
+		t.Parallel()
+
+		log := &testsCommon.LoggerStub{}
+		assert.True(t, log == core.NewLoggerWithBatchID(context.Background(), log))
+	})
+	t.Run("batch ID should be appended on all layers sharing the context", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := core.ContextWithBatchID(context.Background(), 37)
+		expectedArgs := []interface{}{"hash", "hash0", "batch ID", uint64(37)}
+
+		clientArgs := make([]interface{}, 0)
+		clientLogger := &testsCommon.LoggerStub{
+			InfoCalled: func(message string, args ...interface{}) {
+				clientArgs = args
+			},
+		}
+		validatorArgs := make([]interface{}, 0)
+		validatorLogger := &testsCommon.LoggerStub{
+			DebugCalled: func(message string, args ...interface{}) {
+				validatorArgs = args
+			},
+		}
+		stepArgs := make([]interface{}, 0)
+		stepLogger := &testsCommon.LoggerStub{
+			LogCalled: func(logLevel logger.LogLevel, message string, args ...interface{}) {
+				stepArgs = args
+			},
+		}
+
+		core.NewLoggerWithBatchID(ctx, clientLogger).Info("client", "hash", "hash0")
+		core.NewLoggerWithBatchID(ctx, validatorLogger).Debug("validator", "hash", "hash0")
+		core.NewLoggerWithBatchID(ctx, stepLogger).Log(logger.LogInfo, "step", "hash", "hash0")
+
+		assert.Equal(t, expectedArgs, clientArgs)
+		assert.Equal(t, expectedArgs, validatorArgs)
+		assert.Equal(t, expectedArgs, stepArgs)
+	})
+	t.Run("log line should contain the batch ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := core.ContextWithBatchID(context.Background(), 37)
+		var providedLine *logger.LogLine
+		log := &testsCommon.LoggerStub{
+			LogLineCalled: func(line *logger.LogLine) {
+				providedLine = line
+			},
+		}
+
+		core.NewLoggerWithBatchID(ctx, log).LogLine(&logger.LogLine{Message: "message"})
+		assert.Equal(t, []interface{}{"batch ID", uint64(37)}, providedLine.Args)
+	})
+	t.Run("correlation ID should be appended after the batch ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := core.ContextWithBatchID(context.Background(), 37)
+		ctx = core.ContextWithCorrelationID(ctx, "a1b2c3")
+		providedArgs := make([]interface{}, 0)
+		log := &testsCommon.LoggerStub{
+			InfoCalled: func(message string, args ...interface{}) {
+				providedArgs = args
+			},
+		}
+
+		core.NewLoggerWithBatchID(ctx, log).Info("message", "hash", "hash0")
+		assert.Equal(t, []interface{}{"hash", "hash0", "batch ID", uint64(37), "correlation ID", "a1b2c3"}, providedArgs)
+	})
+}
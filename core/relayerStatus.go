@@ -0,0 +1,19 @@
+package core
+
+// StateMachineStatus holds the status of a relayer state machine
+type StateMachineStatus struct {
+	CurrentStep string `json:"currentStep"`
+	NumBatches  int    `json:"numBatches"`
+	LastError   string `json:"lastError"`
+}
+
+// RelayerStatus is the serializable snapshot of the relayer health
+type RelayerStatus struct {
+	StateMachines          map[string]StateMachineStatus `json:"stateMachines"`
+	ElrondRelayerAddress   string                        `json:"elrondRelayerAddress"`
+	EthereumRelayerAddress string                        `json:"ethereumRelayerAddress"`
+	EthereumBalance        string                        `json:"ethereumBalance"`
+	GasPrice               string                        `json:"gasPrice"`
+	ClientsAvailability    GeneralMetrics                `json:"clientsAvailability"`
+	Errors                 []string                      `json:"errors,omitempty"`
+}
@@ -0,0 +1,9 @@
+package retry
+
+import "errors"
+
+// ErrNilBackoff signals that a nil backoff was provided
+var ErrNilBackoff = errors.New("nil backoff")
+
+// ErrInvalidNumberOfAttempts signals that the provided number of attempts is lower than 1
+var ErrInvalidNumberOfAttempts = errors.New("invalid number of attempts")
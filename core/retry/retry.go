@@ -0,0 +1,120 @@
+package retry
+
+import (
+	"context"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+const (
+	// maxExponentialShift limits the number of doublings done by the exponential backoff
+	maxExponentialShift = 30
+	// maxExponentialDelay caps the delay computed by the exponential backoff, so the doubling can not overflow time.Duration
+	maxExponentialDelay = time.Hour
+)
+
+// Backoff computes the delay to wait before a retry. The retry index starts at 0 for the first retry
+type Backoff interface {
+	Delay(retry int) time.Duration
+	IsInterfaceNil() bool
+}
+
+type constantBackoff struct {
+	delay time.Duration
+}
+
+// NewConstantBackoff creates a backoff waiting the same delay before each retry
+func NewConstantBackoff(delay time.Duration) *constantBackoff {
+	return &constantBackoff{
+		delay: delay,
+	}
+}
+
+// Delay returns the configured delay
+func (backoff *constantBackoff) Delay(_ int) time.Duration {
+	return backoff.delay
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (backoff *constantBackoff) IsInterfaceNil() bool {
+	return backoff == nil
+}
+
+type linearBackoff struct {
+	delay time.Duration
+}
+
+// NewLinearBackoff creates a backoff waiting delay before the first retry, 2 * delay before the second one and so on
+func NewLinearBackoff(delay time.Duration) *linearBackoff {
+	return &linearBackoff{
+		delay: delay,
+	}
+}
+
+// Delay returns the configured delay multiplied by the retry number
+func (backoff *linearBackoff) Delay(retry int) time.Duration {
+	return backoff.delay * time.Duration(retry+1)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (backoff *linearBackoff) IsInterfaceNil() bool {
+	return backoff == nil
+}
+
+type exponentialBackoff struct {
+	delay time.Duration
+}
+
+// NewExponentialBackoff creates a backoff waiting delay before the first retry and doubling it before each next retry
+func NewExponentialBackoff(delay time.Duration) *exponentialBackoff {
+	return &exponentialBackoff{
+		delay: delay,
+	}
+}
+
+// Delay returns the configured delay multiplied by 2 to the power of the retry index, capped at maxExponentialDelay
+func (backoff *exponentialBackoff) Delay(retry int) time.Duration {
+	if retry > maxExponentialShift {
+		retry = maxExponentialShift
+	}
+	if retry < 0 {
+		retry = 0
+	}
+	if backoff.delay > maxExponentialDelay>>uint(retry) {
+		return maxExponentialDelay
+	}
+
+	return backoff.delay << uint(retry)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (backoff *exponentialBackoff) IsInterfaceNil() bool {
+	return backoff == nil
+}
+
+// Do calls fn until it succeeds or the number of attempts is reached, waiting the delay computed by the backoff
+// between consecutive attempts. A done context stops the retries. The error returned by the last call is returned
+func Do(ctx context.Context, attempts int, backoff Backoff, fn func() error) error {
+	if attempts < 1 {
+		return ErrInvalidNumberOfAttempts
+	}
+	if check.IfNil(backoff) {
+		return ErrNilBackoff
+	}
+
+	for retry := 0; ; retry++ {
+		err := fn()
+		if err == nil || retry+1 >= attempts {
+			return err
+		}
+
+		timer := time.NewTimer(backoff.Delay(retry))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
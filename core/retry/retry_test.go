@@ -0,0 +1,142 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+var expectedErr = errors.New("expected error")
+
+func TestBackoffs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("constant backoff", func(t *testing.T) {
+		t.Parallel()
+
+		backoff := NewConstantBackoff(time.Second)
+		assert.False(t, check.IfNil(backoff))
+		assert.Equal(t, time.Second, backoff.Delay(0))
+		assert.Equal(t, time.Second, backoff.Delay(1))
+		assert.Equal(t, time.Second, backoff.Delay(10))
+	})
+	t.Run("linear backoff", func(t *testing.T) {
+		t.Parallel()
+
+		backoff := NewLinearBackoff(time.Second)
+		assert.False(t, check.IfNil(backoff))
+		assert.Equal(t, time.Second, backoff.Delay(0))
+		assert.Equal(t, 2*time.Second, backoff.Delay(1))
+		assert.Equal(t, 11*time.Second, backoff.Delay(10))
+	})
+	t.Run("exponential backoff", func(t *testing.T) {
+		t.Parallel()
+
+		backoff := NewExponentialBackoff(time.Millisecond)
+		assert.False(t, check.IfNil(backoff))
+		assert.Equal(t, time.Millisecond, backoff.Delay(0))
+		assert.Equal(t, 2*time.Millisecond, backoff.Delay(1))
+		assert.Equal(t, 1024*time.Millisecond, backoff.Delay(10))
+		assert.Equal(t, backoff.Delay(maxExponentialShift), backoff.Delay(1000))
+		assert.True(t, backoff.Delay(1000) > 0)
+	})
+	t.Run("exponential backoff with a large delay should be capped", func(t *testing.T) {
+		t.Parallel()
+
+		backoff := NewExponentialBackoff(10 * time.Second)
+		assert.Equal(t, 10*time.Second, backoff.Delay(0))
+		assert.Equal(t, 2560*time.Second, backoff.Delay(8))
+		for _, retry := range []int{9, 20, maxExponentialShift, 1000} {
+			assert.Equal(t, maxExponentialDelay, backoff.Delay(retry), "retry %d", retry)
+		}
+
+		backoff = NewExponentialBackoff(2 * time.Hour)
+		assert.Equal(t, maxExponentialDelay, backoff.Delay(0))
+		assert.Equal(t, maxExponentialDelay, backoff.Delay(maxExponentialShift))
+	})
+}
+
+func TestDo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of attempts should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := Do(context.Background(), 0, NewConstantBackoff(time.Millisecond), func() error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		})
+		assert.Equal(t, ErrInvalidNumberOfAttempts, err)
+	})
+	t.Run("nil backoff should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := Do(context.Background(), 1, nil, func() error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		})
+		assert.Equal(t, ErrNilBackoff, err)
+	})
+	t.Run("one attempt should call once", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		err := Do(context.Background(), 1, NewConstantBackoff(time.Millisecond), func() error {
+			numCalls++
+			return expectedErr
+		})
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("should stop after the number of attempts", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		err := Do(context.Background(), 4, NewConstantBackoff(time.Millisecond), func() error {
+			numCalls++
+			return expectedErr
+		})
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 4, numCalls)
+	})
+	t.Run("should stop on the first success", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		err := Do(context.Background(), 4, NewConstantBackoff(time.Millisecond), func() error {
+			numCalls++
+			if numCalls < 2 {
+				return expectedErr
+			}
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, numCalls)
+	})
+	t.Run("should wait the backoff delays between attempts", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Now()
+		_ = Do(context.Background(), 4, NewLinearBackoff(10*time.Millisecond), func() error {
+			return expectedErr
+		})
+		assert.True(t, time.Since(start) >= (10+20+30)*time.Millisecond)
+	})
+	t.Run("done context should stop the retries", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		numCalls := 0
+		err := Do(ctx, 10, NewConstantBackoff(time.Minute), func() error {
+			numCalls++
+			cancel()
+			return expectedErr
+		})
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, numCalls)
+	})
+}
@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"time"
 )
 
 // StepIdentifier defines a step name
@@ -20,6 +21,16 @@ type Step interface {
 // EthGasPriceSelector defines the ethereum gas price selector
 type EthGasPriceSelector string
 
+// EthGasPriceCapMode defines the behavior of the gas handler when the gas price exceeds the maximum allowed value
+type EthGasPriceCapMode string
+
+// EthGasPriceSmoothingMode defines the statistic used to smooth the fetched ethereum gas prices
+type EthGasPriceSmoothingMode string
+
+// EthQuorumCheckMode defines the behavior of the ethereum client when the provided quorum is lower than the one
+// required by the multisig contract
+type EthQuorumCheckMode string
+
 // Timer defines operations related to time
 type Timer interface {
 	NowUnix() int64
@@ -63,6 +74,25 @@ type MetricsHolder interface {
 	IsInterfaceNil() bool
 }
 
+// AvailabilityChecker is able to check if its backend is reachable and in sync
+type AvailabilityChecker interface {
+	CheckClientAvailability(ctx context.Context) error
+	IsInterfaceNil() bool
+}
+
+// HealthReporter represents the component that aggregates the availability checks of the registered components
+type HealthReporter interface {
+	AddChecker(name string, checker AvailabilityChecker) error
+	CheckHealth(ctx context.Context) (GeneralMetrics, error)
+	IsInterfaceNil() bool
+}
+
+// StatusProvider is able to assemble the relayer status snapshot
+type StatusProvider interface {
+	GetStatus(ctx context.Context) *RelayerStatus
+	IsInterfaceNil() bool
+}
+
 // Storer defines a component able to store and load data
 type Storer interface {
 	Put(key, data []byte) error
@@ -71,6 +101,33 @@ type Storer interface {
 	IsInterfaceNil() bool
 }
 
+// StepDurationRecorder is able to record how long the execution of a state machine step took
+type StepDurationRecorder interface {
+	RecordStepDuration(identifier StepIdentifier, duration time.Duration)
+	IsInterfaceNil() bool
+}
+
+// TransferMetrics is able to record the counters and the observations regarding the transfers lifecycle
+type TransferMetrics interface {
+	IncTransfersProposed()
+	IncTransfersSigned()
+	IncTransfersSent()
+	IncTransfersFailed()
+	ObserveExecutionGasPrice(gasPrice float64)
+	ObserveBatchExecutionDuration(duration time.Duration)
+	IsInterfaceNil() bool
+}
+
+// BatchValidatorMetrics is able to record the counters and the latencies regarding the batch validator requests
+type BatchValidatorMetrics interface {
+	ObserveRequestDuration(duration time.Duration)
+	IncRequestsSucceeded()
+	IncRequestsFailed()
+	IncBatchesValid()
+	IncBatchesInvalid()
+	IsInterfaceNil() bool
+}
+
 // GeneralMetrics represents an objects metrics map
 type GeneralMetrics map[string]interface{}
 
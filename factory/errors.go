@@ -12,5 +12,9 @@ var (
 	errPublicKeyCast           = errors.New("error casting public key to ECDSA")
 	errInvalidValue            = errors.New("invalid value")
 	errNilMetricsHolder        = errors.New("nil metrics holder")
+	errNilHealthReporter       = errors.New("nil health reporter")
 	errNilStatusHandler        = errors.New("nil status handler")
+	errMissingPrivateKey       = errors.New("missing private key, either the private key file or environment variable should be set")
+	errEmptyPrivateKeyEnvVar   = errors.New("empty private key environment variable")
+	errInconsistentPrivateKeys = errors.New("inconsistent private keys")
 )
@@ -5,7 +5,7 @@ import (
 	"crypto/ecdsa"
 	"fmt"
 	"io"
-	"io/ioutil"
+	"math/big"
 	"sync"
 	"time"
 
@@ -52,6 +52,11 @@ const (
 	minTimeForBootstrap     = time.Millisecond * 100
 	minTimeBeforeRepeatJoin = time.Second * 30
 	pollingDurationOnError  = time.Second * 5
+
+	numStateMachines = 2
+
+	defaultMaxMessageHashes     = 1000
+	defaultMaxSignaturesPerHash = 100
 )
 
 var suite = ed25519.NewEd25519()
@@ -70,6 +75,7 @@ type ArgsEthereumToElrondBridge struct {
 	TimeForBootstrap          time.Duration
 	TimeBeforeRepeatJoin      time.Duration
 	MetricsHolder             core.MetricsHolder
+	HealthReporter            core.HealthReporter
 	AppStatusHandler          elrondCore.AppStatusHandler
 }
 
@@ -93,17 +99,28 @@ type ethElrondBridgeComponents struct {
 	timeForBootstrap              time.Duration
 	metricsHolder                 core.MetricsHolder
 	addressConverter              core.AddressConverter
-
-	ethToElrondMachineStates    core.MachineStates
-	ethToElrondStepDuration     time.Duration
-	ethToElrondStatusHandler    core.StatusHandler
-	ethToElrondStateMachine     StateMachine
-	ethToElrondSignaturesHolder ethElrond.SignaturesHolder
-
-	elrondToEthMachineStates core.MachineStates
-	elrondToEthStepDuration  time.Duration
-	elrondToEthStatusHandler core.StatusHandler
-	elrondToEthStateMachine  StateMachine
+	gasHandler                    ethereum.GasHandler
+	statusProvider                core.StatusProvider
+	transferMetrics               core.TransferMetrics
+	transfersStatusHandler        core.StatusHandler
+	batchValidator                batchValidatorManagement.BidirectionalBatchValidator
+
+	ethToElrondMachineStates        core.MachineStates
+	ethToElrondStepDuration         time.Duration
+	ethToElrondStepExecutionTimeout time.Duration
+	ethToElrondStepDurations        map[core.StepIdentifier]time.Duration
+	ethToElrondMaxConsecutiveErrors uint32
+	ethToElrondStatusHandler        core.StatusHandler
+	ethToElrondStateMachine         StateMachine
+	ethToElrondSignaturesHolder     ethElrond.SignaturesHolder
+
+	elrondToEthMachineStates        core.MachineStates
+	elrondToEthStepDuration         time.Duration
+	elrondToEthStepExecutionTimeout time.Duration
+	elrondToEthStepDurations        map[core.StepIdentifier]time.Duration
+	elrondToEthMaxConsecutiveErrors uint32
+	elrondToEthStatusHandler        core.StatusHandler
+	elrondToEthStateMachine         StateMachine
 
 	mutClosableHandlers sync.RWMutex
 	closableHandlers    []io.Closer
@@ -113,6 +130,7 @@ type ethElrondBridgeComponents struct {
 	timeBeforeRepeatJoin time.Duration
 	cancelFunc           func()
 	appStatusHandler     elrondCore.AppStatusHandler
+	fatalErrorChan       chan error
 }
 
 // NewEthElrondBridgeComponents creates a new eth-elrond bridge components holder
@@ -136,6 +154,7 @@ func NewEthElrondBridgeComponents(args ArgsEthereumToElrondBridge) (*ethElrondBr
 		timeBeforeRepeatJoin: args.TimeBeforeRepeatJoin,
 		metricsHolder:        args.MetricsHolder,
 		appStatusHandler:     args.AppStatusHandler,
+		fatalErrorChan:       make(chan error, numStateMachines),
 	}
 
 	addressConverter, err := converters.NewAddressConverter()
@@ -171,11 +190,21 @@ func NewEthElrondBridgeComponents(args ArgsEthereumToElrondBridge) (*ethElrondBr
 		return nil, err
 	}
 
+	err = components.createTransferMetrics()
+	if err != nil {
+		return nil, err
+	}
+
 	err = components.createEthereumClient(args)
 	if err != nil {
 		return nil, err
 	}
 
+	err = components.registerHealthCheckers(args.HealthReporter)
+	if err != nil {
+		return nil, err
+	}
+
 	err = components.createEthereumToElrondBridge(args)
 	if err != nil {
 		return nil, err
@@ -196,6 +225,11 @@ func NewEthElrondBridgeComponents(args ArgsEthereumToElrondBridge) (*ethElrondBr
 		return nil, err
 	}
 
+	err = components.createStatusProvider(args)
+	if err != nil {
+		return nil, err
+	}
+
 	return components, nil
 }
 
@@ -230,6 +264,9 @@ func checkArgsEthereumToElrondBridge(args ArgsEthereumToElrondBridge) error {
 	if check.IfNil(args.MetricsHolder) {
 		return errNilMetricsHolder
 	}
+	if check.IfNil(args.HealthReporter) {
+		return errNilHealthReporter
+	}
 	if check.IfNil(args.AppStatusHandler) {
 		return errNilStatusHandler
 	}
@@ -239,9 +276,9 @@ func checkArgsEthereumToElrondBridge(args ArgsEthereumToElrondBridge) error {
 
 func (components *ethElrondBridgeComponents) createElrondKeysAndAddresses(elrondConfigs config.ElrondConfig) error {
 	wallet := interactors.NewWallet()
-	elrondPrivateKeyBytes, err := wallet.LoadPrivateKeyFromPemFile(elrondConfigs.PrivateKeyFile)
+	elrondPrivateKeyBytes, err := loadPrivateKey(elrondConfigs.PrivateKeyFile, elrondConfigs.PrivateKeyEnvVar, decodeElrondPrivateKey)
 	if err != nil {
-		return err
+		return fmt.Errorf("%w for the Elrond private key", err)
 	}
 
 	components.elrondRelayerPrivateKey, err = keyGen.PrivateKeyFromByteArray(elrondPrivateKeyBytes)
@@ -279,7 +316,11 @@ func (components *ethElrondBridgeComponents) createDataGetter() error {
 
 func (components *ethElrondBridgeComponents) createElrondClient(args ArgsEthereumToElrondBridge) error {
 	elrondConfigs := args.Configs.GeneralConfig.Elrond
-	tokensMapper, err := mappers.NewElrondToErc20Mapper(components.dataGetter)
+	elrondToErc20Mapper, err := mappers.NewElrondToErc20Mapper(components.dataGetter)
+	if err != nil {
+		return err
+	}
+	tokensMapper, err := createCachedTokensMapper(elrondToErc20Mapper, elrondConfigs)
 	if err != nil {
 		return err
 	}
@@ -296,33 +337,66 @@ func (components *ethElrondBridgeComponents) createElrondClient(args ArgsEthereu
 		RoleProvider:                 components.elrondRoleProvider,
 		StatusHandler:                args.ElrondClientStatusHandler,
 		AllowDelta:                   uint64(elrondConfigs.ProxyMaxNoncesDelta),
+		EstimateGasUsingProxy:        elrondConfigs.EstimateGasUsingProxy,
+	}
+
+	elrondClient, err := elrond.NewClient(clientArgs)
+	if err != nil {
+		return err
 	}
+	components.addClosableComponent(elrondClient)
+	components.elrondClient = elrondClient
 
-	components.elrondClient, err = elrond.NewClient(clientArgs)
-	components.addClosableComponent(components.elrondClient)
+	if elrondConfigs.ProxyCallTimeoutInMillis == 0 {
+		return nil
+	}
+
+	argsClientWithTimeout := elrond.ArgsClientWithTimeout{
+		Client:      elrondClient,
+		CallTimeout: time.Duration(elrondConfigs.ProxyCallTimeoutInMillis) * time.Millisecond,
+	}
+	components.elrondClient, err = elrond.NewClientWithTimeout(argsClientWithTimeout)
 
 	return err
 }
 
+func (components *ethElrondBridgeComponents) registerHealthCheckers(healthReporter core.HealthReporter) error {
+	err := healthReporter.AddChecker(core.ElrondClientStatusHandlerName, components.elrondClient)
+	if err != nil {
+		return err
+	}
+
+	return healthReporter.AddChecker(core.EthClientStatusHandlerName, components.ethClient)
+}
+
 func (components *ethElrondBridgeComponents) createEthereumClient(args ArgsEthereumToElrondBridge) error {
 	ethereumConfigs := args.Configs.GeneralConfig.Eth
 
 	gasStationConfig := ethereumConfigs.GasStation
 	argsGasStation := gasManagement.ArgsGasStation{
 		RequestURL:             gasStationConfig.URL,
+		FallbackRequestURLs:    gasStationConfig.FallbackURLs,
 		RequestPollingInterval: time.Duration(gasStationConfig.PollingIntervalInSeconds) * time.Second,
 		RequestRetryDelay:      time.Duration(gasStationConfig.RequestRetryDelayInSeconds) * time.Second,
 		MaximumFetchRetries:    gasStationConfig.MaxFetchRetries,
 		RequestTime:            time.Duration(gasStationConfig.RequestTimeInSeconds) * time.Second,
 		MaximumGasPrice:        gasStationConfig.MaximumAllowedGasPrice,
+		GasPriceCapMode:        core.EthGasPriceCapMode(gasStationConfig.GasPriceCapMode),
 		GasPriceSelector:       core.EthGasPriceSelector(gasStationConfig.GasPriceSelector),
 		GasPriceMultiplier:     gasStationConfig.GasPriceMultiplier,
+		EnableNodeGasFallback:  gasStationConfig.EnableNodeGasFallback,
+		NodeGasPriceSuggester:  args.ClientWrapper,
+		SmoothingWindow:        gasStationConfig.SmoothingWindow,
+		SmoothingMode:          core.EthGasPriceSmoothingMode(gasStationConfig.SmoothingMode),
+		EnableDynamicFees:      gasStationConfig.EnableDynamicFees,
+		BaseFeeMultiplier:      gasStationConfig.BaseFeeMultiplier,
 	}
 
 	gs, err := factory.CreateGasStation(argsGasStation, gasStationConfig.Enabled)
 	if err != nil {
 		return err
 	}
+	components.gasHandler = gs
 
 	antifloodComponents, err := components.createAntifloodComponents(args.Configs.GeneralConfig.P2P.AntifloodConfig)
 	if err != nil {
@@ -357,12 +431,11 @@ func (components *ethElrondBridgeComponents) createEthereumClient(args ArgsEther
 		return err
 	}
 
-	privateKeyBytes, err := ioutil.ReadFile(ethereumConfigs.PrivateKeyFile)
+	privateKeyBytes, err := loadPrivateKey(ethereumConfigs.PrivateKeyFile, ethereumConfigs.PrivateKeyEnvVar, decodeEthereumPrivateKey)
 	if err != nil {
-		return err
+		return fmt.Errorf("%w for the Ethereum private key", err)
 	}
-	privateKeyString := converters.TrimWhiteSpaceCharacters(string(privateKeyBytes))
-	privateKey, err := ethCrypto.HexToECDSA(privateKeyString)
+	privateKey, err := ethCrypto.ToECDSA(privateKeyBytes)
 	if err != nil {
 		return err
 	}
@@ -374,35 +447,80 @@ func (components *ethElrondBridgeComponents) createEthereumClient(args ArgsEther
 	}
 	components.ethereumRelayerAddress = ethCrypto.PubkeyToAddress(*publicKeyECDSA)
 
-	tokensMapper, err := mappers.NewErc20ToElrondMapper(components.dataGetter)
+	erc20ToElrondMapper, err := mappers.NewErc20ToElrondMapper(components.dataGetter)
+	if err != nil {
+		return err
+	}
+	tokensMapper, err := createCachedTokensMapper(erc20ToElrondMapper, args.Configs.GeneralConfig.Elrond)
 	if err != nil {
 		return err
 	}
 
-	signaturesHolder := ethElrond.NewSignatureHolder()
+	signaturesHolder, err := ethElrond.NewSignatureHolder(createArgsSignaturesHolder(
+		args.Configs.GeneralConfig.Relayer.SignaturesHolder, components.transfersStatusHandler))
+	if err != nil {
+		return err
+	}
 	components.ethToElrondSignaturesHolder = signaturesHolder
 	err = components.broadcaster.AddBroadcastClient(signaturesHolder)
 	if err != nil {
 		return err
 	}
 
+	err = ethereumConfigs.ValidateAddresses()
+	if err != nil {
+		return err
+	}
 	safeContractAddress := common.HexToAddress(ethereumConfigs.SafeContractAddress)
+	supportedTokens, err := convertSupportedTokens(ethereumConfigs.SupportedTokens)
+	if err != nil {
+		return err
+	}
+	tokenFeeModels, err := createTokenFeeModels(ethereumConfigs.TransferFeesInBasisPoints)
+	if err != nil {
+		return err
+	}
+	maxTransferAmounts, err := createMaxTransferAmounts(ethereumConfigs.MaxTransferAmounts)
+	if err != nil {
+		return err
+	}
+	previousRelayerAddresses, err := convertPreviousRelayerAddresses(ethereumConfigs.PreviousRelayerAddresses)
+	if err != nil {
+		return err
+	}
+	maximumGasPrice := big.NewInt(int64(gasStationConfig.MaximumAllowedGasPrice))
+	maximumGasPrice.Mul(maximumGasPrice, big.NewInt(int64(gasStationConfig.GasPriceMultiplier)))
 
 	ethClientLogId := components.evmCompatibleChain.EvmCompatibleChainClientLogId()
 	argsEthClient := ethereum.ArgsEthereumClient{
-		ClientWrapper:           args.ClientWrapper,
-		Erc20ContractsHandler:   args.Erc20ContractsHolder,
-		Log:                     core.NewLoggerWithIdentifier(logger.GetOrCreate(ethClientLogId), ethClientLogId),
-		AddressConverter:        components.addressConverter,
-		Broadcaster:             components.broadcaster,
-		PrivateKey:              privateKey,
-		TokensMapper:            tokensMapper,
-		SignatureHolder:         signaturesHolder,
-		SafeContractAddress:     safeContractAddress,
-		GasHandler:              gs,
-		TransferGasLimitBase:    ethereumConfigs.GasLimitBase,
-		TransferGasLimitForEach: ethereumConfigs.GasLimitForEach,
-		AllowDelta:              ethereumConfigs.MaxBlocksDelta,
+		ClientWrapper:            args.ClientWrapper,
+		Erc20ContractsHandler:    args.Erc20ContractsHolder,
+		Log:                      core.NewLoggerWithIdentifier(logger.GetOrCreate(ethClientLogId), ethClientLogId),
+		AddressConverter:         components.addressConverter,
+		Broadcaster:              components.broadcaster,
+		PrivateKey:               privateKey,
+		TokensMapper:             tokensMapper,
+		SignatureHolder:          signaturesHolder,
+		SafeContractAddress:      safeContractAddress,
+		GasHandler:               gs,
+		TransferGasLimitBase:     ethereumConfigs.GasLimitBase,
+		TransferGasLimitForEach:  ethereumConfigs.GasLimitForEach,
+		AllowDelta:               ethereumConfigs.MaxBlocksDelta,
+		MaxDepositsPerBatch:      ethereumConfigs.MaxDepositsPerBatch,
+		MaxBatchAgeInBlocks:      ethereumConfigs.MaxBatchAgeInBlocks,
+		CheckExecutedBeforeSend:  ethereumConfigs.CheckExecutedBeforeSend,
+		RPCMaxRetries:            ethereumConfigs.RPCMaxRetries,
+		RPCRetryDelay:            time.Duration(ethereumConfigs.RPCRetryDelayInMillis) * time.Millisecond,
+		SupportedTokens:          supportedTokens,
+		TransferMetrics:          components.transferMetrics,
+		QuorumCheckMode:          core.EthQuorumCheckMode(ethereumConfigs.QuorumCheckMode),
+		TokenFeeModels:           tokenFeeModels,
+		FinalityBlocks:           ethereumConfigs.FinalityBlocks,
+		ExecutionConfirmations:   ethereumConfigs.ExecutionConfirmations,
+		SortDepositsByNonce:      ethereumConfigs.SortDepositsByNonce,
+		MaximumGasPrice:          maximumGasPrice,
+		MaxTransferAmounts:       maxTransferAmounts,
+		PreviousRelayerAddresses: previousRelayerAddresses,
 	}
 
 	components.ethClient, err = ethereum.NewEthereumClient(argsEthClient)
@@ -449,12 +567,17 @@ func (components *ethElrondBridgeComponents) createEthereumRoleProvider(args Arg
 	configs := args.Configs.GeneralConfig
 	ethRoleProviderLogId := components.evmCompatibleChain.EvmCompatibleChainRoleProviderLogId()
 	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(ethRoleProviderLogId), ethRoleProviderLogId)
+	previousRelayerAddresses, err := convertPreviousRelayerAddresses(configs.Eth.PreviousRelayerAddresses)
+	if err != nil {
+		return err
+	}
+
 	argsRoleProvider := roleProviders.ArgsEthereumRoleProvider{
-		EthereumChainInteractor: args.ClientWrapper,
-		Log:                     log,
+		EthereumChainInteractor:  args.ClientWrapper,
+		Log:                      log,
+		PreviousRelayerAddresses: previousRelayerAddresses,
 	}
 
-	var err error
 	components.ethereumRoleProvider, err = roleProviders.NewEthereumRoleProvider(argsRoleProvider)
 	if err != nil {
 		return err
@@ -489,11 +612,15 @@ func (components *ethElrondBridgeComponents) createEthereumToElrondBridge(args A
 	}
 
 	components.ethToElrondStepDuration = time.Duration(configs.StepDurationInMillis) * time.Millisecond
+	components.ethToElrondStepExecutionTimeout = time.Duration(configs.StepExecutionTimeoutInMillis) * time.Millisecond
+	components.ethToElrondStepDurations = convertStepDurations(configs.StepDurationOverridesInMillis)
+	components.ethToElrondMaxConsecutiveErrors = configs.MaxConsecutiveErrors
 
 	argsTopologyHandler := topology.ArgsTopologyHandler{
 		PublicKeysProvider: components.elrondRoleProvider,
 		Timer:              components.timer,
 		IntervalForLeader:  time.Second * time.Duration(configs.IntervalForLeaderInSeconds),
+		SkewTolerance:      time.Second * time.Duration(configs.LeaderSkewToleranceInSeconds),
 		AddressBytes:       components.elrondRelayerAddress.AddressBytes(),
 		Log:                log,
 		AddressConverter:   components.addressConverter,
@@ -533,6 +660,10 @@ func (components *ethElrondBridgeComponents) createEthereumToElrondBridge(args A
 		MaxQuorumRetriesOnEthereum: args.Configs.GeneralConfig.Eth.MaxRetriesOnQuorumReached,
 		MaxQuorumRetriesOnElrond:   args.Configs.GeneralConfig.Elrond.MaxRetriesOnQuorumReached,
 		MaxRestriesOnWasProposed:   args.Configs.GeneralConfig.Elrond.MaxRetriesOnWasTransferProposed,
+		TransferMetrics:            components.transferMetrics,
+		Timer:                      components.timer,
+		ResetNonceOnStuckTransfer:  args.Configs.GeneralConfig.Eth.ResetNonceOnStuckTransfer,
+		ReadOnly:                   args.Configs.GeneralConfig.Relayer.ReadOnly,
 	}
 
 	bridge, err := ethElrond.NewBridgeExecutor(argsBridgeExecutor)
@@ -558,10 +689,14 @@ func (components *ethElrondBridgeComponents) createElrondToEthereumBridge(args A
 	}
 
 	components.elrondToEthStepDuration = time.Duration(configs.StepDurationInMillis) * time.Millisecond
+	components.elrondToEthStepExecutionTimeout = time.Duration(configs.StepExecutionTimeoutInMillis) * time.Millisecond
+	components.elrondToEthStepDurations = convertStepDurations(configs.StepDurationOverridesInMillis)
+	components.elrondToEthMaxConsecutiveErrors = configs.MaxConsecutiveErrors
 	argsTopologyHandler := topology.ArgsTopologyHandler{
 		PublicKeysProvider: components.elrondRoleProvider,
 		Timer:              components.timer,
 		IntervalForLeader:  time.Second * time.Duration(configs.IntervalForLeaderInSeconds),
+		SkewTolerance:      time.Second * time.Duration(configs.LeaderSkewToleranceInSeconds),
 		AddressBytes:       components.elrondRelayerAddress.AddressBytes(),
 		Log:                log,
 		AddressConverter:   components.addressConverter,
@@ -601,6 +736,10 @@ func (components *ethElrondBridgeComponents) createElrondToEthereumBridge(args A
 		MaxQuorumRetriesOnEthereum: args.Configs.GeneralConfig.Eth.MaxRetriesOnQuorumReached,
 		MaxQuorumRetriesOnElrond:   args.Configs.GeneralConfig.Elrond.MaxRetriesOnQuorumReached,
 		MaxRestriesOnWasProposed:   args.Configs.GeneralConfig.Elrond.MaxRetriesOnWasTransferProposed,
+		TransferMetrics:            components.transferMetrics,
+		Timer:                      components.timer,
+		ResetNonceOnStuckTransfer:  args.Configs.GeneralConfig.Eth.ResetNonceOnStuckTransfer,
+		ReadOnly:                   args.Configs.GeneralConfig.Relayer.ReadOnly,
 	}
 
 	bridge, err := ethElrond.NewBridgeExecutor(argsBridgeExecutor)
@@ -608,7 +747,7 @@ func (components *ethElrondBridgeComponents) createElrondToEthereumBridge(args A
 		return err
 	}
 
-	components.elrondToEthMachineStates, err = elrondToEthSteps.CreateSteps(bridge)
+	components.elrondToEthMachineStates, err = elrondToEthSteps.CreateSteps(bridge, args.Configs.GeneralConfig.BatchValidator.Enabled)
 	if err != nil {
 		return err
 	}
@@ -654,34 +793,111 @@ func (components *ethElrondBridgeComponents) Start() error {
 	return nil
 }
 
+func (components *ethElrondBridgeComponents) createTransferMetrics() error {
+	transfersStatusHandler, err := status.NewStatusHandler(core.TransfersStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return err
+	}
+
+	err = components.metricsHolder.AddStatusHandler(transfersStatusHandler)
+	if err != nil {
+		return err
+	}
+
+	components.transfersStatusHandler = transfersStatusHandler
+	components.transferMetrics, err = status.NewTransferMetrics(transfersStatusHandler)
+
+	return err
+}
+
+func (components *ethElrondBridgeComponents) createStatusProvider(args ArgsEthereumToElrondBridge) error {
+	argsStatusProvider := status.ArgsStatusProvider{
+		MetricsHolder: components.metricsHolder,
+		StateMachineNames: []string{
+			components.evmCompatibleChain.EvmCompatibleChainToElrondName(),
+			components.evmCompatibleChain.ElrondToEvmCompatibleChainName(),
+		},
+		HealthReporter:         args.HealthReporter,
+		GasHandler:             components.gasHandler,
+		BalanceProvider:        args.ClientWrapper,
+		EthereumRelayerAddress: components.ethereumRelayerAddress,
+		ElrondRelayerAddress:   components.elrondRelayerAddress.AddressAsBech32String(),
+	}
+
+	var err error
+	components.statusProvider, err = status.NewStatusProvider(argsStatusProvider)
+
+	return err
+}
+
+// createBatchValidator returns a batch validator for the provided direction. Both half-bridges share the same
+// underlying instance, created on the first call and serving both directions
 func (components *ethElrondBridgeComponents) createBatchValidator(sourceChain chain.Chain, destinationChain chain.Chain, args config.BatchValidatorConfig) (clients.BatchValidator, error) {
-	argsBatchValidator := batchValidatorManagement.ArgsBatchValidator{
-		SourceChain:      sourceChain,
-		DestinationChain: destinationChain,
-		RequestURL:       args.URL,
-		RequestTime:      time.Second * time.Duration(args.RequestTimeInSeconds),
+	if check.IfNil(components.batchValidator) {
+		batchValidatorMetrics, err := components.createBatchValidatorMetrics()
+		if err != nil {
+			return nil, err
+		}
+
+		argsBatchValidator := batchValidatorManagement.ArgsBatchValidator{
+			SourceChain:      sourceChain,
+			DestinationChain: destinationChain,
+			RequestURL:       args.URL,
+			RequestTime:      time.Second * time.Duration(args.RequestTimeInSeconds),
+			MaxRetries:       args.MaxRetries,
+			RetryDelay:       time.Millisecond * time.Duration(args.RetryDelayInMillis),
+			RequestMethod:    args.RequestMethod,
+			BothDirections:   true,
+			Metrics:          batchValidatorMetrics,
+		}
+
+		batchValidator, err := batchManagementFactory.CreateBidirectionalBatchValidator(argsBatchValidator, args.Enabled)
+		if err != nil {
+			return nil, err
+		}
+		components.batchValidator = batchValidator
+	}
+
+	return components.batchValidator.ForDirection(sourceChain, destinationChain)
+}
+
+func (components *ethElrondBridgeComponents) createBatchValidatorMetrics() (core.BatchValidatorMetrics, error) {
+	batchValidatorStatusHandler, err := status.NewStatusHandler(core.BatchValidatorStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return nil, err
 	}
 
-	batchValidator, err := batchManagementFactory.CreateBatchValidator(argsBatchValidator, args.Enabled)
+	err = components.metricsHolder.AddStatusHandler(batchValidatorStatusHandler)
 	if err != nil {
 		return nil, err
 	}
-	return batchValidator, err
+
+	return status.NewBatchValidatorMetrics(batchValidatorStatusHandler)
 }
 
 func (components *ethElrondBridgeComponents) createEthereumToElrondStateMachine() error {
 	ethToElrondName := components.evmCompatibleChain.EvmCompatibleChainToElrondName()
 	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(ethToElrondName), ethToElrondName)
 
+	stepDurationMetrics, err := status.NewStepDurationMetrics(components.ethToElrondStatusHandler)
+	if err != nil {
+		return err
+	}
+
 	argsStateMachine := stateMachine.ArgsStateMachine{
 		StateMachineName:     ethToElrondName,
 		Steps:                components.ethToElrondMachineStates,
 		StartStateIdentifier: ethToElrondSteps.GettingPendingBatchFromEthereum,
 		Log:                  log,
 		StatusHandler:        components.ethToElrondStatusHandler,
+		StepExecutionTimeout: components.ethToElrondStepExecutionTimeout,
+		StepDurationRecorder: stepDurationMetrics,
+		StepDurations:        components.ethToElrondStepDurations,
+		Storer:               components.statusStorer,
+		MaxConsecutiveErrors: components.ethToElrondMaxConsecutiveErrors,
+		OnFatalError:         components.createFatalErrorHandler(ethToElrondName),
 	}
 
-	var err error
 	components.ethToElrondStateMachine, err = stateMachine.NewStateMachine(argsStateMachine)
 	if err != nil {
 		return err
@@ -710,15 +926,25 @@ func (components *ethElrondBridgeComponents) createElrondToEthereumStateMachine(
 	elrondToEthName := components.evmCompatibleChain.ElrondToEvmCompatibleChainName()
 	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(elrondToEthName), elrondToEthName)
 
+	stepDurationMetrics, err := status.NewStepDurationMetrics(components.elrondToEthStatusHandler)
+	if err != nil {
+		return err
+	}
+
 	argsStateMachine := stateMachine.ArgsStateMachine{
 		StateMachineName:     elrondToEthName,
 		Steps:                components.elrondToEthMachineStates,
 		StartStateIdentifier: elrondToEthSteps.GettingPendingBatchFromElrond,
 		Log:                  log,
 		StatusHandler:        components.elrondToEthStatusHandler,
+		StepExecutionTimeout: components.elrondToEthStepExecutionTimeout,
+		StepDurationRecorder: stepDurationMetrics,
+		StepDurations:        components.elrondToEthStepDurations,
+		Storer:               components.statusStorer,
+		MaxConsecutiveErrors: components.elrondToEthMaxConsecutiveErrors,
+		OnFatalError:         components.createFatalErrorHandler(elrondToEthName),
 	}
 
-	var err error
 	components.elrondToEthStateMachine, err = stateMachine.NewStateMachine(argsStateMachine)
 	if err != nil {
 		return err
@@ -743,6 +969,104 @@ func (components *ethElrondBridgeComponents) createElrondToEthereumStateMachine(
 	return nil
 }
 
+func convertStepDurations(durationsInMillis map[string]uint64) map[core.StepIdentifier]time.Duration {
+	durations := make(map[core.StepIdentifier]time.Duration, len(durationsInMillis))
+	for identifier, durationInMillis := range durationsInMillis {
+		durations[core.StepIdentifier(identifier)] = time.Duration(durationInMillis) * time.Millisecond
+	}
+
+	return durations
+}
+
+func convertSupportedTokens(tokens []string) ([]common.Address, error) {
+	addresses := make([]common.Address, 0, len(tokens))
+	for _, token := range tokens {
+		if !common.IsHexAddress(token) {
+			return nil, fmt.Errorf("%w for SupportedTokens, received: %q", errInvalidValue, token)
+		}
+		addresses = append(addresses, common.HexToAddress(token))
+	}
+
+	return addresses, nil
+}
+
+func convertPreviousRelayerAddresses(previousAddresses []string) ([]common.Address, error) {
+	addresses := make([]common.Address, 0, len(previousAddresses))
+	for _, address := range previousAddresses {
+		if !common.IsHexAddress(address) {
+			return nil, fmt.Errorf("%w for PreviousRelayerAddresses, received: %q", errInvalidValue, address)
+		}
+		addresses = append(addresses, common.HexToAddress(address))
+	}
+
+	return addresses, nil
+}
+
+func createTokenFeeModels(transferFees map[string]uint64) (map[common.Address]ethereum.TokenFeeModel, error) {
+	feeModels := make(map[common.Address]ethereum.TokenFeeModel, len(transferFees))
+	for token, feeInBasisPoints := range transferFees {
+		if !common.IsHexAddress(token) {
+			return nil, fmt.Errorf("%w for TransferFeesInBasisPoints, received: %q", errInvalidValue, token)
+		}
+
+		feeModel, err := ethereum.NewBasisPointsFeeModel(feeInBasisPoints)
+		if err != nil {
+			return nil, fmt.Errorf("%w for ERC20 token %s", err, token)
+		}
+		feeModels[common.HexToAddress(token)] = feeModel
+	}
+
+	return feeModels, nil
+}
+
+func createMaxTransferAmounts(maxAmounts map[string]string) (map[common.Address]*big.Int, error) {
+	result := make(map[common.Address]*big.Int, len(maxAmounts))
+	for token, maxAmount := range maxAmounts {
+		if !common.IsHexAddress(token) {
+			return nil, fmt.Errorf("%w for MaxTransferAmounts, received: %q", errInvalidValue, token)
+		}
+
+		value, ok := big.NewInt(0).SetString(maxAmount, 10)
+		if !ok {
+			return nil, fmt.Errorf("%w for MaxTransferAmounts, ERC20 token %s, received: %q", errInvalidValue, token, maxAmount)
+		}
+		result[common.HexToAddress(token)] = value
+	}
+
+	return result, nil
+}
+
+// createArgsSignaturesHolder uses the default capacities for the signatures holder values left to zero
+func createArgsSignaturesHolder(cfg config.SignaturesHolderConfig, statusHandler core.StatusHandler) ethElrond.ArgsSignaturesHolder {
+	args := ethElrond.ArgsSignaturesHolder{
+		MaxMessageHashes:     cfg.MaxMessageHashes,
+		MaxSignaturesPerHash: cfg.MaxSignaturesPerHash,
+		StatusHandler:        statusHandler,
+	}
+	if args.MaxMessageHashes == 0 {
+		args.MaxMessageHashes = defaultMaxMessageHashes
+	}
+	if args.MaxSignaturesPerHash == 0 {
+		args.MaxSignaturesPerHash = defaultMaxSignaturesPerHash
+	}
+
+	return args
+}
+
+func createCachedTokensMapper(tokensMapper mappers.TokensMapper, elrondConfigs config.ElrondConfig) (mappers.TokensMapper, error) {
+	if elrondConfigs.TokensMapperCacheTTLInSeconds == 0 {
+		return tokensMapper, nil
+	}
+
+	argsCachedTokensMapper := mappers.ArgsCachedTokensMapper{
+		Mapper:  tokensMapper,
+		TTL:     time.Duration(elrondConfigs.TokensMapperCacheTTLInSeconds) * time.Second,
+		MaxSize: elrondConfigs.TokensMapperCacheMaxSize,
+	}
+
+	return mappers.NewCachedTokensMapper(argsCachedTokensMapper)
+}
+
 func (components *ethElrondBridgeComponents) createAntifloodComponents(antifloodConfig elrondConfig.AntifloodConfig) (*antifloodFactory.AntiFloodComponents, error) {
 	var err error
 	ctx, cancelFunc := context.WithCancel(context.Background())
@@ -810,11 +1134,34 @@ func (components *ethElrondBridgeComponents) Close() error {
 	return lastError
 }
 
+// createFatalErrorHandler returns the handler notified when the named state machine halts. The error is forwarded on
+// the fatal errors channel so the application can shut down instead of running with a stopped state machine
+func (components *ethElrondBridgeComponents) createFatalErrorHandler(stateMachineName string) func(err error) {
+	return func(err error) {
+		components.baseLogger.Error("state machine halted", "name", stateMachineName, "error", err)
+
+		select {
+		case components.fatalErrorChan <- fmt.Errorf("%s state machine: %w", stateMachineName, err):
+		default:
+		}
+	}
+}
+
+// FatalErrorChan returns the channel on which the errors that halted a state machine are reported
+func (components *ethElrondBridgeComponents) FatalErrorChan() <-chan error {
+	return components.fatalErrorChan
+}
+
 // ElrondRelayerAddress returns the Elrond's address associated to this relayer
 func (components *ethElrondBridgeComponents) ElrondRelayerAddress() erdgoCore.AddressHandler {
 	return components.elrondRelayerAddress
 }
 
+// StatusProvider returns the component able to assemble the relayer status snapshot
+func (components *ethElrondBridgeComponents) StatusProvider() core.StatusProvider {
+	return components.statusProvider
+}
+
 // EthereumRelayerAddress returns the Ethereum's address associated to this relayer
 func (components *ethElrondBridgeComponents) EthereumRelayerAddress() common.Address {
 	return components.ethereumRelayerAddress
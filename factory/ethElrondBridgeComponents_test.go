@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-eth-bridge/clients"
 	"github.com/ElrondNetwork/elrond-eth-bridge/clients/chain"
 	"github.com/ElrondNetwork/elrond-eth-bridge/config"
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
@@ -34,6 +35,7 @@ func createMockEthElrondBridgeArgs() ArgsEthereumToElrondBridge {
 		Eth: config.EthereumConfig{
 			Chain:                        chain.Ethereum,
 			NetworkAddress:               "http://127.0.0.1:8545",
+			MultisigContractAddress:      "3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c",
 			SafeContractAddress:          "5DdDe022a65F8063eE9adaC54F359CBF46166068",
 			PrivateKeyFile:               "testdata/grace.sk",
 			IntervalToResendTxsInSeconds: 0,
@@ -47,12 +49,15 @@ func createMockEthElrondBridgeArgs() ArgsEthereumToElrondBridge {
 				MaxFetchRetries:            3,
 				RequestTimeInSeconds:       1,
 				MaximumAllowedGasPrice:     100,
+				GasPriceCapMode:            "Error",
 				GasPriceSelector:           "FastGasPrice",
 				GasPriceMultiplier:         1,
 			},
 			MaxRetriesOnQuorumReached:          1,
 			IntervalToWaitForTransferInSeconds: 1,
 			MaxBlocksDelta:                     10,
+			MaxDepositsPerBatch:                100,
+			QuorumCheckMode:                    "Raise",
 		},
 		Elrond: config.ElrondConfig{
 			PrivateKeyFile:                  "testdata/grace.pem",
@@ -68,6 +73,10 @@ func createMockEthElrondBridgeArgs() ArgsEthereumToElrondBridge {
 			RoleProvider: config.RoleProviderConfig{
 				PollingIntervalInMillis: 1000,
 			},
+			SignaturesHolder: config.SignaturesHolderConfig{
+				MaxMessageHashes:     100,
+				MaxSignaturesPerHash: 10,
+			},
 		},
 		StateMachine: map[string]config.ConfigStateMachine{
 			"EthereumToElrond": stateMachineConfig,
@@ -99,6 +108,7 @@ func createMockEthElrondBridgeArgs() ArgsEthereumToElrondBridge {
 		TimeForBootstrap:          minTimeForBootstrap,
 		TimeBeforeRepeatJoin:      minTimeBeforeRepeatJoin,
 		MetricsHolder:             status.NewMetricsHolder(),
+		HealthReporter:            status.NewHealthReporter(),
 		AppStatusHandler:          &statusHandler.AppStatusHandlerStub{},
 	}
 }
@@ -151,6 +161,15 @@ func TestNewEthElrondBridgeComponents(t *testing.T) {
 		assert.Equal(t, errNilErc20ContractsHolder, err)
 		assert.Nil(t, components)
 	})
+	t.Run("err on invalid ethereum safe contract address", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.Configs.GeneralConfig.Eth.SafeContractAddress = "invalid"
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.True(t, errors.Is(err, config.ErrInvalidEthereumAddress))
+		assert.Nil(t, components)
+	})
 	t.Run("err on createElrondKeysAndAddresses, empty pk file", func(t *testing.T) {
 		t.Parallel()
 		args := createMockEthElrondBridgeArgs()
@@ -235,6 +254,60 @@ func TestNewEthElrondBridgeComponents(t *testing.T) {
 		assert.True(t, strings.Contains(err.Error(), "for TimeBeforeRepeatJoin"))
 		assert.Nil(t, components)
 	})
+	t.Run("invalid supported token", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.Configs.GeneralConfig.Eth.SupportedTokens = []string{"not an address"}
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.True(t, errors.Is(err, errInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "for SupportedTokens"))
+		assert.Nil(t, components)
+	})
+	t.Run("invalid transfer fee token", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.Configs.GeneralConfig.Eth.TransferFeesInBasisPoints = map[string]uint64{"not an address": 25}
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.True(t, errors.Is(err, errInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "for TransferFeesInBasisPoints"))
+		assert.Nil(t, components)
+	})
+	t.Run("invalid transfer fee value", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.Configs.GeneralConfig.Eth.TransferFeesInBasisPoints = map[string]uint64{
+			"0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c": 10001,
+		}
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "for feeInBasisPoints"))
+		assert.Nil(t, components)
+	})
+	t.Run("invalid maximum transfer amount token", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.Configs.GeneralConfig.Eth.MaxTransferAmounts = map[string]string{"not an address": "100"}
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.True(t, errors.Is(err, errInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "for MaxTransferAmounts"))
+		assert.Nil(t, components)
+	})
+	t.Run("invalid maximum transfer amount value", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.Configs.GeneralConfig.Eth.MaxTransferAmounts = map[string]string{
+			"0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c": "not a number",
+		}
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.True(t, errors.Is(err, errInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), `received: "not a number"`))
+		assert.Nil(t, components)
+	})
 	t.Run("nil MetricsHolder", func(t *testing.T) {
 		t.Parallel()
 		args := createMockEthElrondBridgeArgs()
@@ -244,6 +317,15 @@ func TestNewEthElrondBridgeComponents(t *testing.T) {
 		assert.Equal(t, errNilMetricsHolder, err)
 		assert.Nil(t, components)
 	})
+	t.Run("nil HealthReporter", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthElrondBridgeArgs()
+		args.HealthReporter = nil
+
+		components, err := NewEthElrondBridgeComponents(args)
+		assert.Equal(t, errNilHealthReporter, err)
+		assert.Nil(t, components)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 		args := createMockEthElrondBridgeArgs()
@@ -253,6 +335,7 @@ func TestNewEthElrondBridgeComponents(t *testing.T) {
 		require.NotNil(t, components)
 		require.Equal(t, 6, len(components.closableHandlers))
 		require.False(t, check.IfNil(components.ethToElrondStatusHandler))
+		require.False(t, check.IfNil(components.StatusProvider()))
 		require.False(t, check.IfNil(components.elrondToEthStatusHandler))
 	})
 }
@@ -421,3 +504,52 @@ func TestEthElrondBridgeComponents_RelayerAddresses(t *testing.T) {
 	assert.Equal(t, "erd1r69gk66fmedhhcg24g2c5kn2f2a5k4kvpr6jfw67dn2lyydd8cfswy6ede", components.ElrondRelayerAddress().AddressAsBech32String())
 	assert.Equal(t, "0x3FE464Ac5aa562F7948322F92020F2b668D543d8", components.EthereumRelayerAddress().String())
 }
+
+func TestCreateArgsSignaturesHolder(t *testing.T) {
+	t.Parallel()
+
+	statusHandler := testsCommon.NewStatusHandlerMock("test")
+	t.Run("zero values should use the defaults", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsSignaturesHolder(config.SignaturesHolderConfig{}, statusHandler)
+		assert.Equal(t, defaultMaxMessageHashes, args.MaxMessageHashes)
+		assert.Equal(t, defaultMaxSignaturesPerHash, args.MaxSignaturesPerHash)
+		assert.Equal(t, statusHandler, args.StatusHandler)
+	})
+	t.Run("configured values should be kept", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.SignaturesHolderConfig{
+			MaxMessageHashes:     37,
+			MaxSignaturesPerHash: 7,
+		}
+		args := createArgsSignaturesHolder(cfg, statusHandler)
+		assert.Equal(t, 37, args.MaxMessageHashes)
+		assert.Equal(t, 7, args.MaxSignaturesPerHash)
+	})
+}
+
+func TestEthElrondBridgeComponents_FatalErrorHandler(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEthElrondBridgeArgs()
+	components, err := NewEthElrondBridgeComponents(args)
+	require.Nil(t, err)
+
+	expectedErr := errors.New("expected error")
+	components.createFatalErrorHandler("machine 1")(expectedErr)
+	components.createFatalErrorHandler("machine 2")(expectedErr)
+	// a full channel should not block the halting state machine
+	components.createFatalErrorHandler("machine 3")(expectedErr)
+
+	for _, name := range []string{"machine 1", "machine 2"} {
+		select {
+		case fatalErr := <-components.FatalErrorChan():
+			assert.True(t, errors.Is(fatalErr, expectedErr))
+			assert.True(t, strings.Contains(fatalErr.Error(), name))
+		default:
+			assert.Fail(t, "fatal error not reported for "+name)
+		}
+	}
+}
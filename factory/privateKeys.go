@@ -0,0 +1,74 @@
+package factory
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core/converters"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/interactors"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+type privateKeyDecoder func(data []byte) ([]byte, error)
+
+// loadPrivateKey returns the private key bytes read from the provided environment variable, if set, or from the
+// provided file. When both are set, the file is optional but, if it exists, it must hold the same private key
+func loadPrivateKey(keyFile string, envVarName string, decoder privateKeyDecoder) ([]byte, error) {
+	if len(envVarName) == 0 {
+		if len(keyFile) == 0 {
+			return nil, errMissingPrivateKey
+		}
+
+		return loadPrivateKeyFromFile(keyFile, decoder)
+	}
+
+	envData := os.Getenv(envVarName)
+	if len(strings.TrimSpace(envData)) == 0 {
+		return nil, fmt.Errorf("%w: %s", errEmptyPrivateKeyEnvVar, envVarName)
+	}
+	privateKey, err := decoder([]byte(envData))
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the private key from the environment variable %s", err, envVarName)
+	}
+	if len(keyFile) == 0 {
+		return privateKey, nil
+	}
+
+	_, err = os.Stat(keyFile)
+	if os.IsNotExist(err) {
+		return privateKey, nil
+	}
+	privateKeyFromFile, err := loadPrivateKeyFromFile(keyFile, decoder)
+	if err != nil || !bytes.Equal(privateKey, privateKeyFromFile) {
+		return nil, fmt.Errorf("%w: environment variable %s and file %s", errInconsistentPrivateKeys, envVarName, keyFile)
+	}
+
+	return privateKey, nil
+}
+
+func loadPrivateKeyFromFile(keyFile string, decoder privateKeyDecoder) ([]byte, error) {
+	data, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return decoder(data)
+}
+
+func decodeEthereumPrivateKey(data []byte) ([]byte, error) {
+	privateKeyString := converters.TrimWhiteSpaceCharacters(string(data))
+	_, err := ethCrypto.HexToECDSA(privateKeyString)
+	if err != nil {
+		return nil, err
+	}
+
+	return hex.DecodeString(privateKeyString)
+}
+
+func decodeElrondPrivateKey(data []byte) ([]byte, error) {
+	return interactors.NewWallet().LoadPrivateKeyFromPemData(data)
+}
@@ -0,0 +1,114 @@
+package factory
+
+import (
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const gracePrivateKeyHex = "9bb971db41e3815a669a71c3f1bcb24e0b81f21e04bf11faa7a34b9b40e7cfb1"
+
+func TestLoadPrivateKey(t *testing.T) {
+	t.Parallel()
+
+	expectedPrivateKey, _ := hex.DecodeString(gracePrivateKeyHex)
+
+	t.Run("neither file nor environment variable should error", func(t *testing.T) {
+		t.Parallel()
+
+		privateKey, err := loadPrivateKey("", "", decodeEthereumPrivateKey)
+		assert.Equal(t, errMissingPrivateKey, err)
+		assert.Nil(t, privateKey)
+	})
+	t.Run("from file should work", func(t *testing.T) {
+		t.Parallel()
+
+		privateKey, err := loadPrivateKey("testdata/grace.sk", "", decodeEthereumPrivateKey)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedPrivateKey, privateKey)
+	})
+	t.Run("empty environment variable should error", func(t *testing.T) {
+		t.Parallel()
+
+		privateKey, err := loadPrivateKey("testdata/grace.sk", "TEST_LOAD_PRIVATE_KEY_UNSET", decodeEthereumPrivateKey)
+		assert.True(t, errors.Is(err, errEmptyPrivateKeyEnvVar))
+		assert.True(t, strings.Contains(err.Error(), "TEST_LOAD_PRIVATE_KEY_UNSET"))
+		assert.Nil(t, privateKey)
+	})
+	t.Run("invalid environment variable value should error", func(t *testing.T) {
+		t.Parallel()
+
+		envVarName := "TEST_LOAD_PRIVATE_KEY_INVALID"
+		_ = os.Setenv(envVarName, "not a private key")
+		defer func() {
+			_ = os.Unsetenv(envVarName)
+		}()
+
+		privateKey, err := loadPrivateKey("", envVarName, decodeEthereumPrivateKey)
+		assert.NotNil(t, err)
+		assert.Nil(t, privateKey)
+	})
+	t.Run("environment variable without file should work", func(t *testing.T) {
+		t.Parallel()
+
+		envVarName := "TEST_LOAD_PRIVATE_KEY_NO_FILE"
+		_ = os.Setenv(envVarName, gracePrivateKeyHex)
+		defer func() {
+			_ = os.Unsetenv(envVarName)
+		}()
+
+		privateKey, err := loadPrivateKey("testdata/missing.sk", envVarName, decodeEthereumPrivateKey)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedPrivateKey, privateKey)
+	})
+	t.Run("environment variable matching the file should work", func(t *testing.T) {
+		t.Parallel()
+
+		envVarName := "TEST_LOAD_PRIVATE_KEY_MATCHING"
+		_ = os.Setenv(envVarName, gracePrivateKeyHex+"\n")
+		defer func() {
+			_ = os.Unsetenv(envVarName)
+		}()
+
+		privateKey, err := loadPrivateKey("testdata/grace.sk", envVarName, decodeEthereumPrivateKey)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedPrivateKey, privateKey)
+	})
+	t.Run("environment variable not matching the file should error", func(t *testing.T) {
+		t.Parallel()
+
+		envVarName := "TEST_LOAD_PRIVATE_KEY_NOT_MATCHING"
+		_ = os.Setenv(envVarName, strings.Repeat("1", len(gracePrivateKeyHex)))
+		defer func() {
+			_ = os.Unsetenv(envVarName)
+		}()
+
+		privateKey, err := loadPrivateKey("testdata/grace.sk", envVarName, decodeEthereumPrivateKey)
+		assert.True(t, errors.Is(err, errInconsistentPrivateKeys))
+		assert.Nil(t, privateKey)
+	})
+	t.Run("elrond pem from environment variable should work", func(t *testing.T) {
+		t.Parallel()
+
+		pemData, err := ioutil.ReadFile("testdata/grace.pem")
+		require.Nil(t, err)
+		expectedElrondPrivateKey, err := decodeElrondPrivateKey(pemData)
+		require.Nil(t, err)
+
+		envVarName := "TEST_LOAD_PRIVATE_KEY_PEM"
+		_ = os.Setenv(envVarName, string(pemData))
+		defer func() {
+			_ = os.Unsetenv(envVarName)
+		}()
+
+		privateKey, err := loadPrivateKey("testdata/grace.pem", envVarName, decodeElrondPrivateKey)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedElrondPrivateKey, privateKey)
+	})
+}
@@ -124,6 +124,11 @@ func (mock *ElrondChainMock) GetAccount(_ context.Context, address erdgoCore.Add
 	return mock.accounts.getOrCreate(address), nil
 }
 
+// RequestTransactionCost -
+func (mock *ElrondChainMock) RequestTransactionCost(_ context.Context, _ *data.Transaction) (*data.TxCostResponseData, error) {
+	return nil, fmt.Errorf("transaction cost estimation not supported by the chain mock")
+}
+
 // AddRelayer -
 func (mock *ElrondChainMock) AddRelayer(address erdgoCore.AddressHandler) {
 	mock.mutState.Lock()
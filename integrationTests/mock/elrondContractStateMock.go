@@ -249,6 +249,8 @@ func (mock *elrondContractStateMock) processVmRequests(vmRequest *data.VmValueRe
 		return mock.vmRequestSigned(vmRequest), nil
 	case "isPaused":
 		return mock.vmRequestIsPaused(vmRequest), nil
+	case "getQuorum":
+		return mock.vmRequestGetQuorum(vmRequest), nil
 	}
 
 	panic("unimplemented function: " + vmRequest.FuncName)
@@ -408,6 +410,12 @@ func (mock *elrondContractStateMock) vmRequestGetLastExecutedEthTxId(_ *data.VmV
 	return createOkVmResponse([][]byte{val.Bytes()})
 }
 
+func (mock *elrondContractStateMock) vmRequestGetQuorum(_ *data.VmValueRequest) *data.VmValuesResponseData {
+	val := big.NewInt(int64(mock.quorum))
+
+	return createOkVmResponse([][]byte{val.Bytes()})
+}
+
 func (mock *elrondContractStateMock) vmRequestGetCurrentPendingBatch(_ *data.VmValueRequest) *data.VmValuesResponseData {
 	if mock.pendingBatch == nil {
 		return createOkVmResponse(make([][]byte, 0))
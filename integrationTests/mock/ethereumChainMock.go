@@ -68,7 +68,7 @@ func (mock *EthereumChainMock) Name() string {
 }
 
 // GetBatch -
-func (mock *EthereumChainMock) GetBatch(_ context.Context, batchNonce *big.Int) (contract.Batch, error) {
+func (mock *EthereumChainMock) GetBatch(_ context.Context, batchNonce *big.Int, _ *big.Int) (contract.Batch, error) {
 	mock.mutState.RLock()
 	defer mock.mutState.RUnlock()
 
@@ -81,7 +81,7 @@ func (mock *EthereumChainMock) GetBatch(_ context.Context, batchNonce *big.Int)
 }
 
 // GetBatchDeposits -
-func (mock *EthereumChainMock) GetBatchDeposits(_ context.Context, batchNonce *big.Int) ([]contract.Deposit, error) {
+func (mock *EthereumChainMock) GetBatchDeposits(_ context.Context, batchNonce *big.Int, _ *big.Int) ([]contract.Deposit, error) {
 	mock.mutState.RLock()
 	defer mock.mutState.RUnlock()
 
@@ -110,7 +110,7 @@ func (mock *EthereumChainMock) AddRelayer(relayer common.Address) {
 }
 
 // WasBatchExecuted -
-func (mock *EthereumChainMock) WasBatchExecuted(_ context.Context, batchNonce *big.Int) (bool, error) {
+func (mock *EthereumChainMock) WasBatchExecuted(_ context.Context, batchNonce *big.Int, _ *big.Int) (bool, error) {
 	mock.mutState.RLock()
 	defer mock.mutState.RUnlock()
 
@@ -147,6 +147,14 @@ func (mock *EthereumChainMock) NonceAt(_ context.Context, account common.Address
 	return mock.nonces[account], nil
 }
 
+// PendingNonceAt -
+func (mock *EthereumChainMock) PendingNonceAt(_ context.Context, account common.Address) (uint64, error) {
+	mock.mutState.RLock()
+	defer mock.mutState.RUnlock()
+
+	return mock.nonces[account], nil
+}
+
 // AddBatch -
 func (mock *EthereumChainMock) AddBatch(batch contract.Batch) {
 	mock.mutState.Lock()
@@ -252,6 +260,28 @@ func (mock *EthereumChainMock) BalanceAt(ctx context.Context, account common.Add
 	return big.NewInt(0), nil
 }
 
+// SuggestGasPrice -
+func (mock *EthereumChainMock) SuggestGasPrice(_ context.Context) (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
+// SuggestGasTipCap -
+func (mock *EthereumChainMock) SuggestGasTipCap(_ context.Context) (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
+// TransactionReceipt -
+func (mock *EthereumChainMock) TransactionReceipt(_ context.Context, _ common.Hash) (*types.Receipt, error) {
+	return &types.Receipt{
+		Status: types.ReceiptStatusSuccessful,
+	}, nil
+}
+
+// SendTransaction -
+func (mock *EthereumChainMock) SendTransaction(_ context.Context, _ *types.Transaction) error {
+	return nil
+}
+
 // IsPaused -
 func (mock *EthereumChainMock) IsPaused(_ context.Context) (bool, error) {
 	return false, nil
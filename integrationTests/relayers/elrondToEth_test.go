@@ -3,7 +3,9 @@ package relayers
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"math/big"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -208,6 +211,104 @@ func TestRelayersShouldExecuteTransferFromElrondToEthIfTransactionsAppearInBatch
 	}
 }
 
+func TestRelayersShouldExecuteTransferFromElrondToEthDuringEthereumKeyRotation(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	numTransactions := 2
+	deposits, tokensAddresses, erc20Map := createTransactions(numTransactions)
+
+	tokens, availableBalances := availableTokensMapToSlices(erc20Map)
+	safeContractEthAddress := testsCommon.CreateRandomEthereumAddress()
+	erc20ContractsHolder := createMockErc20ContractsHolder(tokens, safeContractEthAddress, availableBalances)
+
+	numRelayers := 3
+	ethereumChainMock := mock.NewEthereumChainMock()
+	ethereumChainMock.SetQuorum(numRelayers)
+	expectedStatuses := []byte{clients.Executed, clients.Rejected}
+	ethereumChainMock.GetStatusesAfterExecutionHandler = func() []byte {
+		return expectedStatuses
+	}
+	elrondChainMock := mock.NewElrondChainMock()
+	for i := 0; i < len(deposits); i++ {
+		elrondChainMock.AddTokensPair(tokensAddresses[i], deposits[i].Ticker)
+	}
+	pendingBatch := mock.ElrondPendingBatch{
+		Nonce:          big.NewInt(1),
+		ElrondDeposits: deposits,
+	}
+
+	elrondChainMock.SetPendingBatch(&pendingBatch)
+	elrondChainMock.SetQuorum(numRelayers)
+
+	relayers := make([]bridgeComponents, 0, numRelayers)
+	defer func() {
+		for _, r := range relayers {
+			_ = r.Close()
+		}
+	}()
+
+	messengers := integrationTests.CreateLinkedMessengers(numRelayers)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*1200)
+	defer cancel()
+	elrondChainMock.ProcessFinishedHandler = func() {
+		log.Info("elrondChainMock.ProcessFinishedHandler called")
+		asyncCancelCall(cancel, time.Second*5)
+	}
+
+	// the contract already whitelists the new key of the last relayer, which still signs with its previous key
+	rotatingRelayerIndex := numRelayers - 1
+	previousRelayerAddress := loadEthereumAddress(t, fmt.Sprintf("testdata/ethereum%d.sk", rotatingRelayerIndex))
+	for i := 0; i < numRelayers; i++ {
+		argsBridgeComponents := createMockBridgeComponentsArgs(i, messengers[i], elrondChainMock, ethereumChainMock)
+		argsBridgeComponents.Configs.GeneralConfig.Eth.SafeContractAddress = safeContractEthAddress.Hex()
+		argsBridgeComponents.Configs.GeneralConfig.Eth.PreviousRelayerAddresses = []string{previousRelayerAddress.Hex()}
+		argsBridgeComponents.Erc20ContractsHolder = erc20ContractsHolder
+		relayer, err := factory.NewEthElrondBridgeComponents(argsBridgeComponents)
+		require.Nil(t, err)
+
+		elrondChainMock.AddRelayer(relayer.ElrondRelayerAddress())
+		if i == rotatingRelayerIndex {
+			ethereumChainMock.AddRelayer(testsCommon.CreateRandomEthereumAddress())
+		} else {
+			ethereumChainMock.AddRelayer(relayer.EthereumRelayerAddress())
+		}
+
+		go func() {
+			err = relayer.Start()
+			integrationTests.Log.LogIfError(err)
+			require.Nil(t, err)
+		}()
+
+		relayers = append(relayers, relayer)
+	}
+
+	<-ctx.Done()
+
+	// let all transactions propagate
+	time.Sleep(time.Second * 5)
+
+	assert.NotNil(t, elrondChainMock.PerformedActionID())
+
+	transfer := ethereumChainMock.GetLastProposedTransfer()
+	require.NotNil(t, transfer)
+	require.Equal(t, numTransactions, len(transfer.Amounts))
+	// the quorum can only be reached if the signature of the previous key was accepted and sent
+	assert.Equal(t, numRelayers, len(transfer.Signatures))
+}
+
+func loadEthereumAddress(tb testing.TB, keyFile string) common.Address {
+	data, err := ioutil.ReadFile(keyFile)
+	require.Nil(tb, err)
+
+	privateKey, err := crypto.HexToECDSA(strings.TrimSpace(string(data)))
+	require.Nil(tb, err)
+
+	return crypto.PubkeyToAddress(privateKey.PublicKey)
+}
+
 func createTransactions(n int) ([]mock.ElrondDeposit, []common.Address, map[common.Address]*big.Int) {
 	tokensAddresses := make([]common.Address, 0, n)
 	deposits := make([]mock.ElrondDeposit, 0, n)
@@ -235,7 +336,7 @@ func createTransaction(index int) (mock.ElrondDeposit, common.Address) {
 		From:   testsCommon.CreateRandomElrondAddress(),
 		To:     testsCommon.CreateRandomEthereumAddress(),
 		Ticker: fmt.Sprintf("tck-00000%d", index+1),
-		Amount: big.NewInt(int64(index)),
+		Amount: big.NewInt(int64(index + 1)),
 	}, tokenAddress
 }
 
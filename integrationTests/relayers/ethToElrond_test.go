@@ -172,6 +172,7 @@ func createMockBridgeComponentsArgs(
 		TimeForBootstrap:          time.Second * 5,
 		TimeBeforeRepeatJoin:      time.Second * 30,
 		MetricsHolder:             status.NewMetricsHolder(),
+		HealthReporter:            status.NewHealthReporter(),
 		AppStatusHandler:          &statusHandler.AppStatusHandlerStub{},
 		ElrondClientStatusHandler: &testsCommon.StatusHandlerStub{},
 	}
@@ -198,6 +199,8 @@ func createBridgeComponentsConfig(index int) config.Config {
 			MaxRetriesOnQuorumReached:          1,
 			IntervalToWaitForTransferInSeconds: 1,
 			MaxBlocksDelta:                     5,
+			MaxDepositsPerBatch:                100,
+			QuorumCheckMode:                    "Raise",
 		},
 		Elrond: config.ElrondConfig{
 			NetworkAddress:                  "mock",
@@ -222,6 +225,10 @@ func createBridgeComponentsConfig(index int) config.Config {
 			RoleProvider: config.RoleProviderConfig{
 				PollingIntervalInMillis: 1000,
 			},
+			SignaturesHolder: config.SignaturesHolderConfig{
+				MaxMessageHashes:     100,
+				MaxSignaturesPerHash: 10,
+			},
 		},
 	}
 }
@@ -16,3 +16,18 @@ var ErrNilLogger = errors.New("nil logger")
 
 // ErrNilStatusHandler signals that a nil status handler was provided
 var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrInvalidDuration signals that an invalid duration was provided
+var ErrInvalidDuration = errors.New("invalid duration")
+
+// ErrStepPanicked signals that the step execution panicked
+var ErrStepPanicked = errors.New("step execution panicked")
+
+// ErrStateMachineHalted signals that the state machine was halted by a fatal error
+var ErrStateMachineHalted = errors.New("state machine halted")
+
+// ErrStepExecutionTimeout signals that the step execution timed out
+var ErrStepExecutionTimeout = errors.New("step execution timeout")
+
+// ErrMaxConsecutiveErrorsReached signals that the maximum number of consecutive step errors was reached
+var ErrMaxConsecutiveErrorsReached = errors.New("maximum number of consecutive step errors reached")
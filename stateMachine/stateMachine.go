@@ -3,12 +3,18 @@ package stateMachine
 import (
 	"context"
 	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 )
 
+const currentStepPersistenceKey = "state machine current step"
+
 // ArgsStateMachine represents the state machine arguments
 type ArgsStateMachine struct {
 	StateMachineName     string
@@ -16,14 +22,40 @@ type ArgsStateMachine struct {
 	StartStateIdentifier core.StepIdentifier
 	Log                  logger.Logger
 	StatusHandler        core.StatusHandler
+	StepExecutionTimeout time.Duration
+	StepDurationRecorder core.StepDurationRecorder
+	StepDurations        map[core.StepIdentifier]time.Duration
+	Storer               core.Storer
+	OnFatalError         func(err error)
+	MaxConsecutiveErrors uint32
+}
+
+type stepResult struct {
+	step               core.Step
+	nextStepIdentifier core.StepIdentifier
+	err                error
+	duration           time.Duration
 }
 
 type stateMachine struct {
-	stateMachineName string
-	steps            core.MachineStates
-	currentStep      core.Step
-	log              logger.Logger
-	statusHandler    core.StatusHandler
+	stateMachineName     string
+	steps                core.MachineStates
+	mutCurrentStep       sync.RWMutex
+	currentStep          core.Step
+	nextStepReadyTime    time.Time
+	log                  logger.Logger
+	statusHandler        core.StatusHandler
+	stepExecutionTimeout time.Duration
+	blockedStepResult    chan stepResult
+	isPaused             *atomic.Flag
+	stepDurationRecorder core.StepDurationRecorder
+	stepDurations        map[core.StepIdentifier]time.Duration
+	storer               core.Storer
+	lastPersistedStep    core.StepIdentifier
+	onFatalError         func(err error)
+	isHalted             *atomic.Flag
+	maxConsecutiveErrors uint32
+	numConsecutiveErrors uint32
 }
 
 // NewStateMachine creates a state machine able to execute all provided steps
@@ -34,12 +66,20 @@ func NewStateMachine(args ArgsStateMachine) (*stateMachine, error) {
 	}
 
 	sm := &stateMachine{
-		stateMachineName: args.StateMachineName,
-		steps:            args.Steps,
-		log:              args.Log,
-		statusHandler:    args.StatusHandler,
+		stateMachineName:     args.StateMachineName,
+		steps:                args.Steps,
+		log:                  args.Log,
+		statusHandler:        args.StatusHandler,
+		stepExecutionTimeout: args.StepExecutionTimeout,
+		isPaused:             &atomic.Flag{},
+		stepDurationRecorder: args.StepDurationRecorder,
+		stepDurations:        args.StepDurations,
+		storer:               args.Storer,
+		onFatalError:         args.OnFatalError,
+		isHalted:             &atomic.Flag{},
+		maxConsecutiveErrors: args.MaxConsecutiveErrors,
 	}
-	sm.currentStep, err = sm.getNextStep(args.StartStateIdentifier)
+	sm.currentStep, err = sm.getNextStep(sm.loadStartStepIdentifier(args.StartStateIdentifier))
 	if err != nil {
 		return nil, err
 	}
@@ -47,6 +87,53 @@ func NewStateMachine(args ArgsStateMachine) (*stateMachine, error) {
 	return sm, nil
 }
 
+// loadStartStepIdentifier returns the step persisted before a restart, if any, otherwise the provided start step.
+// Resuming from a persisted step relies on the steps being idempotent and on them tolerating the loss of the
+// in-memory state: a step that can not find the data it needs should return to the start step
+func (sm *stateMachine) loadStartStepIdentifier(startStepIdentifier core.StepIdentifier) core.StepIdentifier {
+	if check.IfNil(sm.storer) {
+		return startStepIdentifier
+	}
+
+	buff, err := sm.storer.Get(sm.persistenceKey())
+	if err != nil || len(buff) == 0 {
+		return startStepIdentifier
+	}
+
+	persistedStepIdentifier := core.StepIdentifier(buff)
+	_, found := sm.steps[persistedStepIdentifier]
+	if !found {
+		sm.log.Warn(fmt.Sprintf("%s: unknown persisted step, starting from the start step", sm.stateMachineName),
+			"persisted step", persistedStepIdentifier, "start step", startStepIdentifier)
+		return startStepIdentifier
+	}
+
+	sm.log.Info(fmt.Sprintf("%s: resuming from the persisted step", sm.stateMachineName),
+		"step", persistedStepIdentifier)
+	sm.lastPersistedStep = persistedStepIdentifier
+
+	return persistedStepIdentifier
+}
+
+func (sm *stateMachine) persistCurrentStep(identifier core.StepIdentifier) {
+	if check.IfNil(sm.storer) || identifier == sm.lastPersistedStep {
+		return
+	}
+
+	err := sm.storer.Put(sm.persistenceKey(), []byte(identifier))
+	if err != nil {
+		sm.log.Warn(fmt.Sprintf("%s: error persisting the current step", sm.stateMachineName),
+			"step", identifier, "error", err)
+		return
+	}
+
+	sm.lastPersistedStep = identifier
+}
+
+func (sm *stateMachine) persistenceKey() []byte {
+	return []byte(fmt.Sprintf("%s: %s", sm.stateMachineName, currentStepPersistenceKey))
+}
+
 func checkArgs(args ArgsStateMachine) error {
 	if args.Steps == nil {
 		return ErrNilStepsMap
@@ -62,25 +149,236 @@ func checkArgs(args ArgsStateMachine) error {
 	if check.IfNil(args.StatusHandler) {
 		return ErrNilStatusHandler
 	}
+	if args.StepExecutionTimeout < 0 {
+		return fmt.Errorf("%w for StepExecutionTimeout: %v", ErrInvalidDuration, args.StepExecutionTimeout)
+	}
+	for identifier, duration := range args.StepDurations {
+		_, found := args.Steps[identifier]
+		if !found {
+			return fmt.Errorf("%w for step duration of identifier '%s'", ErrStepNotFound, identifier)
+		}
+		if duration < 0 {
+			return fmt.Errorf("%w for step duration of identifier '%s': %v", ErrInvalidDuration, identifier, duration)
+		}
+	}
 
 	return nil
 }
 
-// Execute will execute one step
+// Execute will execute one step. While the state machine is paused, no step is executed.
+// Steps with a duration override are executed only after their duration elapsed since the previous step ended, on the
+// first call that follows. Steps with a zero duration override are executed right away, in the same call
 func (sm *stateMachine) Execute(ctx context.Context) error {
-	return sm.executeStep(ctx)
+	if sm.isHalted.IsSet() {
+		return ErrStateMachineHalted
+	}
+	if sm.isPaused.IsSet() {
+		sm.log.Debug(fmt.Sprintf("%s: paused, skipping step execution", sm.stateMachineName),
+			"step", sm.CurrentStep())
+		return nil
+	}
+
+	// the number of chained steps is bounded so a step with a zero duration that returns itself will not block
+	// the machine inside a single call
+	for i := 0; i < len(sm.steps); i++ {
+		if !sm.isCurrentStepReady() {
+			return nil
+		}
+
+		err := sm.executeStep(ctx)
+		if err != nil {
+			return err
+		}
+		if ctx.Err() != nil || sm.isStepBlocked() || !sm.shouldExecuteImmediately() {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// shouldExecuteImmediately returns true if the current step has a zero duration override
+func (sm *stateMachine) shouldExecuteImmediately() bool {
+	duration, found := sm.stepDurations[sm.CurrentStep()]
+
+	return found && duration == 0
+}
+
+// Pause will stop the state machine from executing steps. The current step and all the in-memory state are kept
+func (sm *stateMachine) Pause() {
+	wasPaused := sm.isPaused.SetReturningPrevious()
+	if !wasPaused {
+		sm.log.Info(fmt.Sprintf("%s: paused", sm.stateMachineName), "step", sm.CurrentStep())
+	}
+}
+
+// Resume will let the state machine continue executing steps starting from the current step
+func (sm *stateMachine) Resume() {
+	if sm.isPaused.IsSet() {
+		sm.isPaused.Reset()
+		sm.log.Info(fmt.Sprintf("%s: resumed", sm.stateMachineName), "step", sm.CurrentStep())
+	}
+}
+
+// IsPaused returns true if the state machine is paused
+func (sm *stateMachine) IsPaused() bool {
+	return sm.isPaused.IsSet()
 }
 
 func (sm *stateMachine) executeStep(ctx context.Context) error {
+	if sm.isStepBlocked() {
+		return sm.checkBlockedStep()
+	}
+
+	step := sm.getCurrentStep()
 	sm.log.Debug(fmt.Sprintf("%s: executing step", sm.stateMachineName),
-		"step", sm.currentStep.Identifier())
-	sm.statusHandler.SetStringMetric(core.MetricCurrentStateMachineStep, string(sm.currentStep.Identifier()))
-	nextStepIdentifier := sm.currentStep.Execute(ctx)
+		"step", step.Identifier())
+	sm.statusHandler.SetStringMetric(core.MetricCurrentStateMachineStep, string(step.Identifier()))
+	if sm.stepExecutionTimeout == 0 {
+		return sm.applyStepResult(sm.runStep(ctx, step))
+	}
+
+	stepCtx, cancel := context.WithTimeout(ctx, sm.stepExecutionTimeout)
+	resultChan := make(chan stepResult, 1)
+	go func() {
+		defer cancel()
+		resultChan <- sm.runStep(stepCtx, step)
+	}()
+
+	select {
+	case result := <-resultChan:
+		return sm.applyStepResult(result)
+	case <-stepCtx.Done():
+	}
+
+	if ctx.Err() != nil {
+		// the parent context is done, the step is expected to return right away
+		return sm.applyStepResult(<-resultChan)
+	}
+
+	select {
+	case result := <-resultChan:
+		return sm.applyStepResult(result)
+	default:
+	}
+
+	// the step is still blocked: it is abandoned and its result will be accepted once it returns, no other step is
+	// executed meanwhile
+	sm.blockedStepResult = resultChan
+	sm.log.Warn(fmt.Sprintf("%s: step execution timed out, waiting for the step to return", sm.stateMachineName),
+		"step", step.Identifier(), "timeout", sm.stepExecutionTimeout)
+	sm.handleStepError(fmt.Errorf("%w for step %s", ErrStepExecutionTimeout, step.Identifier()))
+
+	return nil
+}
 
-	currentStep, err := sm.getNextStep(nextStepIdentifier)
-	sm.currentStep = currentStep
+func (sm *stateMachine) isStepBlocked() bool {
+	return sm.blockedStepResult != nil
+}
 
-	return err
+// checkBlockedStep accepts the result of a timed out step if it returned meanwhile. Each check that finds the step
+// still blocked counts as a new error
+func (sm *stateMachine) checkBlockedStep() error {
+	select {
+	case result := <-sm.blockedStepResult:
+		sm.blockedStepResult = nil
+		sm.log.Info(fmt.Sprintf("%s: timed out step returned", sm.stateMachineName),
+			"step", result.step.Identifier(), "next step", result.nextStepIdentifier)
+		return sm.applyStepResult(result)
+	default:
+		step := sm.getCurrentStep()
+		sm.log.Debug(fmt.Sprintf("%s: timed out step is still blocked", sm.stateMachineName),
+			"step", step.Identifier())
+		sm.handleStepError(fmt.Errorf("%w for step %s, the step is still blocked", ErrStepExecutionTimeout, step.Identifier()))
+		return nil
+	}
+}
+
+func (sm *stateMachine) runStep(ctx context.Context, step core.Step) stepResult {
+	startTime := time.Now()
+	nextStepIdentifier, err := sm.executeCurrentStep(ctx, step)
+
+	return stepResult{
+		step:               step,
+		nextStepIdentifier: nextStepIdentifier,
+		err:                err,
+		duration:           time.Since(startTime),
+	}
+}
+
+func (sm *stateMachine) applyStepResult(result stepResult) error {
+	sm.recordStepDuration(result.step.Identifier(), result.duration)
+	if result.err != nil {
+		sm.handleStepError(result.err)
+		return result.err
+	}
+	sm.numConsecutiveErrors = 0
+
+	nextStep, err := sm.getNextStep(result.nextStepIdentifier)
+	if err != nil {
+		sm.halt(fmt.Errorf("%w, returned by step %s", err, result.step.Identifier()))
+		return err
+	}
+
+	sm.setCurrentStep(nextStep, time.Now().Add(sm.stepDurations[result.nextStepIdentifier]))
+	sm.persistCurrentStep(result.nextStepIdentifier)
+
+	return nil
+}
+
+// handleStepError counts the failed step executions. The machine remains on the current step so it will be retried,
+// unless the maximum number of consecutive errors is reached and the machine is halted. A zero maximum disables the halting
+func (sm *stateMachine) handleStepError(err error) {
+	sm.numConsecutiveErrors++
+	if sm.maxConsecutiveErrors == 0 || sm.numConsecutiveErrors < sm.maxConsecutiveErrors {
+		return
+	}
+
+	sm.halt(fmt.Errorf("%w after %d consecutive errors, last error: %s",
+		ErrMaxConsecutiveErrorsReached, sm.numConsecutiveErrors, err.Error()))
+}
+
+// halt stops the state machine for good and notifies the fatal error handler, if any
+func (sm *stateMachine) halt(err error) {
+	wasHalted := sm.isHalted.SetReturningPrevious()
+	if wasHalted {
+		return
+	}
+
+	sm.log.Error(fmt.Sprintf("%s: halted on fatal error", sm.stateMachineName),
+		"step", sm.CurrentStep(), "error", err)
+	sm.statusHandler.SetStringMetric(core.MetricLastError, fmt.Sprintf("state machine halted: %s", err.Error()))
+	if sm.onFatalError != nil {
+		sm.onFatalError(err)
+	}
+}
+
+// IsHalted returns true if the state machine was halted by a fatal error
+func (sm *stateMachine) IsHalted() bool {
+	return sm.isHalted.IsSet()
+}
+
+// executeCurrentStep executes the current step recovering from any panic. On panic, the machine will remain on
+// the current step so it can be retried on the next tick
+func (sm *stateMachine) executeCurrentStep(ctx context.Context, step core.Step) (nextStepIdentifier core.StepIdentifier, err error) {
+	defer func() {
+		r := recover()
+		if r != nil {
+			sm.log.Error(fmt.Sprintf("%s: step execution panicked, will retry the step", sm.stateMachineName),
+				"step", step.Identifier(), "panic", r, "stack trace", string(debug.Stack()))
+			err = fmt.Errorf("%w for step %s: %v", ErrStepPanicked, step.Identifier(), r)
+		}
+	}()
+
+	return step.Execute(ctx), nil
+}
+
+func (sm *stateMachine) recordStepDuration(identifier core.StepIdentifier, duration time.Duration) {
+	if check.IfNil(sm.stepDurationRecorder) {
+		return
+	}
+
+	sm.stepDurationRecorder.RecordStepDuration(identifier, duration)
 }
 
 func (sm *stateMachine) getNextStep(identifier core.StepIdentifier) (core.Step, error) {
@@ -92,6 +390,37 @@ func (sm *stateMachine) getNextStep(identifier core.StepIdentifier) (core.Step,
 	return nextStep, nil
 }
 
+// CurrentStep returns the identifier of the step that will be executed on the next call of Execute
+func (sm *stateMachine) CurrentStep() core.StepIdentifier {
+	step := sm.getCurrentStep()
+	if check.IfNil(step) {
+		return ""
+	}
+
+	return step.Identifier()
+}
+
+func (sm *stateMachine) getCurrentStep() core.Step {
+	sm.mutCurrentStep.RLock()
+	defer sm.mutCurrentStep.RUnlock()
+
+	return sm.currentStep
+}
+
+func (sm *stateMachine) setCurrentStep(step core.Step, readyTime time.Time) {
+	sm.mutCurrentStep.Lock()
+	sm.currentStep = step
+	sm.nextStepReadyTime = readyTime
+	sm.mutCurrentStep.Unlock()
+}
+
+func (sm *stateMachine) isCurrentStepReady() bool {
+	sm.mutCurrentStep.RLock()
+	defer sm.mutCurrentStep.RUnlock()
+
+	return !time.Now().Before(sm.nextStepReadyTime)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sm *stateMachine) IsInterfaceNil() bool {
 	return sm == nil
@@ -3,7 +3,10 @@ package stateMachine_test
 import (
 	"context"
 	"errors"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-eth-bridge/core"
 	"github.com/ElrondNetwork/elrond-eth-bridge/stateMachine"
@@ -80,6 +83,36 @@ func TestNewStateMachine(t *testing.T) {
 		assert.Nil(t, sm)
 		assert.True(t, errors.Is(err, stateMachine.ErrNilStatusHandler))
 	})
+	t.Run("negative step execution timeout", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.StepExecutionTimeout = -time.Second
+		sm, err := stateMachine.NewStateMachine(args)
+
+		assert.Nil(t, sm)
+		assert.True(t, errors.Is(err, stateMachine.ErrInvalidDuration))
+	})
+	t.Run("step duration for unknown step", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.StepDurations = map[core.StepIdentifier]time.Duration{"not found": time.Second}
+		sm, err := stateMachine.NewStateMachine(args)
+
+		assert.Nil(t, sm)
+		assert.True(t, errors.Is(err, stateMachine.ErrStepNotFound))
+	})
+	t.Run("negative step duration", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.StepDurations = map[core.StepIdentifier]time.Duration{"mock": -time.Second}
+		sm, err := stateMachine.NewStateMachine(args)
+
+		assert.Nil(t, sm)
+		assert.True(t, errors.Is(err, stateMachine.ErrInvalidDuration))
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -134,14 +167,653 @@ func TestExecute(t *testing.T) {
 
 		err = sm.Execute(context.Background())
 		assert.Nil(t, err)
-		assert.Equal(t, providedIdentifier1, sm.GetCurrentStepIdentifier())
+		assert.Equal(t, providedIdentifier1, sm.CurrentStep())
+
+		err = sm.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, providedIdentifier2, sm.CurrentStep())
+
+		err = sm.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, providedIdentifier2, sm.CurrentStep())
+	})
+}
+
+func TestExecute_StepExecutionTimeout(t *testing.T) {
+	t.Parallel()
+
+	providedIdentifier0 := core.StepIdentifier("step0")
+	providedIdentifier1 := core.StepIdentifier("step1")
+	createArgs := func(executeHandler func(ctx context.Context) core.StepIdentifier) stateMachine.ArgsStateMachine {
+		args := createMockArgs()
+		args.Steps = map[core.StepIdentifier]core.Step{
+			providedIdentifier0: &testsCommon.StepMock{
+				ExecuteCalled: executeHandler,
+				IdentifierCalled: func() core.StepIdentifier {
+					return providedIdentifier0
+				},
+			},
+			providedIdentifier1: &testsCommon.StepMock{
+				IdentifierCalled: func() core.StepIdentifier {
+					return providedIdentifier1
+				},
+			},
+		}
+		args.StartStateIdentifier = providedIdentifier0
+
+		return args
+	}
+
+	t.Run("blocked step should remain on the current step and should not be executed again", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		unblock := make(chan struct{})
+		args := createArgs(func(ctx context.Context) core.StepIdentifier {
+			atomic.AddUint32(&numCalls, 1)
+			<-unblock
+			return providedIdentifier1
+		})
+		args.StepExecutionTimeout = time.Millisecond * 10
+		sm, _ := stateMachine.NewStateMachine(args)
+
+		err := sm.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, providedIdentifier0, sm.CurrentStep())
 
 		err = sm.Execute(context.Background())
 		assert.Nil(t, err)
-		assert.Equal(t, providedIdentifier2, sm.GetCurrentStepIdentifier())
+		assert.Equal(t, providedIdentifier0, sm.CurrentStep())
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+
+		close(unblock)
+		time.Sleep(time.Millisecond * 10)
 
 		err = sm.Execute(context.Background())
 		assert.Nil(t, err)
-		assert.Equal(t, providedIdentifier2, sm.GetCurrentStepIdentifier())
+		assert.Equal(t, providedIdentifier1, sm.CurrentStep())
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+	})
+	t.Run("step returning after the timeout should advance", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		args := createArgs(func(ctx context.Context) core.StepIdentifier {
+			atomic.AddUint32(&numCalls, 1)
+			<-ctx.Done()
+			return providedIdentifier1
+		})
+		args.StepExecutionTimeout = time.Millisecond * 10
+		sm, _ := stateMachine.NewStateMachine(args)
+
+		_ = sm.Execute(context.Background())
+		time.Sleep(time.Millisecond * 10)
+		_ = sm.Execute(context.Background())
+
+		assert.Equal(t, providedIdentifier1, sm.CurrentStep())
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+	})
+	t.Run("step finishing in time should advance", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs(func(ctx context.Context) core.StepIdentifier {
+			_, hasDeadline := ctx.Deadline()
+			assert.True(t, hasDeadline)
+			return providedIdentifier1
+		})
+		args.StepExecutionTimeout = time.Second
+		sm, _ := stateMachine.NewStateMachine(args)
+
+		err := sm.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, providedIdentifier1, sm.CurrentStep())
+	})
+	t.Run("zero timeout should not set a deadline", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs(func(ctx context.Context) core.StepIdentifier {
+			_, hasDeadline := ctx.Deadline()
+			assert.False(t, hasDeadline)
+			return providedIdentifier1
+		})
+		sm, _ := stateMachine.NewStateMachine(args)
+
+		err := sm.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, providedIdentifier1, sm.CurrentStep())
+	})
+	t.Run("parent context done should not be treated as a step timeout", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs(func(ctx context.Context) core.StepIdentifier {
+			<-ctx.Done()
+			return providedIdentifier1
+		})
+		args.StepExecutionTimeout = time.Second
+		sm, _ := stateMachine.NewStateMachine(args)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+		defer cancel()
+		err := sm.Execute(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, providedIdentifier1, sm.CurrentStep())
+	})
+}
+
+func TestExecute_StepPanics(t *testing.T) {
+	t.Parallel()
+
+	providedIdentifier0 := core.StepIdentifier("step0")
+	providedIdentifier1 := core.StepIdentifier("step1")
+	numCalls := 0
+	args := createMockArgs()
+	args.Steps = map[core.StepIdentifier]core.Step{
+		providedIdentifier0: &testsCommon.StepMock{
+			ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+				numCalls++
+				if numCalls < 3 {
+					panic("step panic")
+				}
+
+				return providedIdentifier1
+			},
+			IdentifierCalled: func() core.StepIdentifier {
+				return providedIdentifier0
+			},
+		},
+		providedIdentifier1: &testsCommon.StepMock{
+			IdentifierCalled: func() core.StepIdentifier {
+				return providedIdentifier1
+			},
+		},
+	}
+	args.StartStateIdentifier = providedIdentifier0
+	sm, _ := stateMachine.NewStateMachine(args)
+
+	for i := 0; i < 2; i++ {
+		assert.NotPanics(t, func() {
+			err := sm.Execute(context.Background())
+			assert.True(t, errors.Is(err, stateMachine.ErrStepPanicked))
+		})
+		assert.Equal(t, providedIdentifier0, sm.CurrentStep())
+	}
+
+	err := sm.Execute(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, providedIdentifier1, sm.CurrentStep())
+	assert.Equal(t, 3, numCalls)
+}
+
+func TestStateMachine_CurrentStepConcurrentAccess(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Steps["mock"] = &testsCommon.StepMock{
+		ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+			return "mock"
+		},
+		IdentifierCalled: func() core.StepIdentifier {
+			return "mock"
+		},
+	}
+	sm, _ := stateMachine.NewStateMachine(args)
+
+	// Execute is called from a single goroutine (the polling handler) while CurrentStep can be called from any goroutine
+	numCalls := 100
+	var wg sync.WaitGroup
+	wg.Add(numCalls + 1)
+	go func() {
+		defer wg.Done()
+
+		for i := 0; i < numCalls; i++ {
+			_ = sm.Execute(context.Background())
+		}
+	}()
+	for i := 0; i < numCalls; i++ {
+		go func() {
+			defer wg.Done()
+
+			assert.Equal(t, core.StepIdentifier("mock"), sm.CurrentStep())
+		}()
+	}
+	wg.Wait()
+}
+
+func TestStateMachine_PauseResume(t *testing.T) {
+	t.Parallel()
+
+	providedIdentifier0 := core.StepIdentifier("step0")
+	providedIdentifier1 := core.StepIdentifier("step1")
+	numCalls := 0
+	args := createMockArgs()
+	args.Steps = map[core.StepIdentifier]core.Step{
+		providedIdentifier0: &testsCommon.StepMock{
+			ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+				numCalls++
+				return providedIdentifier1
+			},
+			IdentifierCalled: func() core.StepIdentifier {
+				return providedIdentifier0
+			},
+		},
+		providedIdentifier1: &testsCommon.StepMock{
+			ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+				numCalls++
+				return providedIdentifier0
+			},
+			IdentifierCalled: func() core.StepIdentifier {
+				return providedIdentifier1
+			},
+		},
+	}
+	args.StartStateIdentifier = providedIdentifier0
+	sm, _ := stateMachine.NewStateMachine(args)
+	assert.False(t, sm.IsPaused())
+
+	sm.Pause()
+	sm.Pause()
+	assert.True(t, sm.IsPaused())
+	for i := 0; i < 5; i++ {
+		err := sm.Execute(context.Background())
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, 0, numCalls)
+	assert.Equal(t, providedIdentifier0, sm.CurrentStep())
+
+	sm.Resume()
+	assert.False(t, sm.IsPaused())
+	err := sm.Execute(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numCalls)
+	assert.Equal(t, providedIdentifier1, sm.CurrentStep())
+}
+
+func TestExecute_StepDurationRecorder(t *testing.T) {
+	t.Parallel()
+
+	providedIdentifier0 := core.StepIdentifier("step0")
+	providedIdentifier1 := core.StepIdentifier("step1")
+	args := createMockArgs()
+	args.Steps = map[core.StepIdentifier]core.Step{
+		providedIdentifier0: &testsCommon.StepMock{
+			ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+				time.Sleep(time.Millisecond * 10)
+				return providedIdentifier1
+			},
+			IdentifierCalled: func() core.StepIdentifier {
+				return providedIdentifier0
+			},
+		},
+		providedIdentifier1: &testsCommon.StepMock{
+			ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+				panic("step panic")
+			},
+			IdentifierCalled: func() core.StepIdentifier {
+				return providedIdentifier1
+			},
+		},
+	}
+	args.StartStateIdentifier = providedIdentifier0
+	recordedDurations := make(map[core.StepIdentifier]time.Duration)
+	args.StepDurationRecorder = &testsCommon.StepDurationRecorderStub{
+		RecordStepDurationCalled: func(identifier core.StepIdentifier, duration time.Duration) {
+			recordedDurations[identifier] = duration
+		},
+	}
+	sm, _ := stateMachine.NewStateMachine(args)
+
+	_ = sm.Execute(context.Background())
+	_ = sm.Execute(context.Background())
+
+	assert.Equal(t, 2, len(recordedDurations))
+	assert.True(t, recordedDurations[providedIdentifier0] >= time.Millisecond*10)
+	_, found := recordedDurations[providedIdentifier1]
+	assert.True(t, found)
+}
+
+func TestExecute_StepDurations(t *testing.T) {
+	t.Parallel()
+
+	providedIdentifier0 := core.StepIdentifier("step0")
+	providedIdentifier1 := core.StepIdentifier("step1")
+	providedIdentifier2 := core.StepIdentifier("step2")
+	createArgs := func(numCalls map[core.StepIdentifier]int) stateMachine.ArgsStateMachine {
+		createStep := func(identifier core.StepIdentifier, next core.StepIdentifier) core.Step {
+			return &testsCommon.StepMock{
+				ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+					numCalls[identifier]++
+					return next
+				},
+				IdentifierCalled: func() core.StepIdentifier {
+					return identifier
+				},
+			}
+		}
+
+		args := createMockArgs()
+		args.Steps = map[core.StepIdentifier]core.Step{
+			providedIdentifier0: createStep(providedIdentifier0, providedIdentifier1),
+			providedIdentifier1: createStep(providedIdentifier1, providedIdentifier2),
+			providedIdentifier2: createStep(providedIdentifier2, providedIdentifier2),
+		}
+		args.StartStateIdentifier = providedIdentifier0
+
+		return args
+	}
+
+	t.Run("no overrides should execute one step on each call", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := make(map[core.StepIdentifier]int)
+		sm, _ := stateMachine.NewStateMachine(createArgs(numCalls))
+
+		_ = sm.Execute(context.Background())
+		assert.Equal(t, providedIdentifier1, sm.CurrentStep())
+		_ = sm.Execute(context.Background())
+		assert.Equal(t, providedIdentifier2, sm.CurrentStep())
+		assert.Equal(t, map[core.StepIdentifier]int{providedIdentifier0: 1, providedIdentifier1: 1}, numCalls)
+	})
+	t.Run("zero duration should execute the step right away", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := make(map[core.StepIdentifier]int)
+		args := createArgs(numCalls)
+		args.StepDurations = map[core.StepIdentifier]time.Duration{providedIdentifier1: 0}
+		sm, _ := stateMachine.NewStateMachine(args)
+
+		_ = sm.Execute(context.Background())
+		assert.Equal(t, providedIdentifier2, sm.CurrentStep())
+		assert.Equal(t, map[core.StepIdentifier]int{providedIdentifier0: 1, providedIdentifier1: 1}, numCalls)
+	})
+	t.Run("zero duration on a step returning itself should not block", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := make(map[core.StepIdentifier]int)
+		args := createArgs(numCalls)
+		args.StepDurations = map[core.StepIdentifier]time.Duration{providedIdentifier2: 0}
+		args.StartStateIdentifier = providedIdentifier2
+		sm, _ := stateMachine.NewStateMachine(args)
+
+		_ = sm.Execute(context.Background())
+		assert.Equal(t, providedIdentifier2, sm.CurrentStep())
+		assert.Equal(t, len(args.Steps), numCalls[providedIdentifier2])
+	})
+	t.Run("longer duration should skip calls until elapsed", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := make(map[core.StepIdentifier]int)
+		args := createArgs(numCalls)
+		stepDuration := time.Millisecond * 200
+		args.StepDurations = map[core.StepIdentifier]time.Duration{providedIdentifier1: stepDuration}
+		sm, _ := stateMachine.NewStateMachine(args)
+
+		_ = sm.Execute(context.Background())
+		assert.Equal(t, providedIdentifier1, sm.CurrentStep())
+
+		_ = sm.Execute(context.Background())
+		_ = sm.Execute(context.Background())
+		assert.Equal(t, providedIdentifier1, sm.CurrentStep())
+		assert.Equal(t, 0, numCalls[providedIdentifier1])
+
+		time.Sleep(stepDuration)
+		_ = sm.Execute(context.Background())
+		assert.Equal(t, providedIdentifier2, sm.CurrentStep())
+		assert.Equal(t, 1, numCalls[providedIdentifier1])
+	})
+}
+
+func TestStateMachine_PersistedStep(t *testing.T) {
+	t.Parallel()
+
+	providedIdentifier0 := core.StepIdentifier("step0")
+	providedIdentifier1 := core.StepIdentifier("step1")
+	providedIdentifier2 := core.StepIdentifier("step2")
+	createArgs := func(storer core.Storer, numCalls map[core.StepIdentifier]int) stateMachine.ArgsStateMachine {
+		createStep := func(identifier core.StepIdentifier, next core.StepIdentifier) core.Step {
+			return &testsCommon.StepMock{
+				ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+					numCalls[identifier]++
+					return next
+				},
+				IdentifierCalled: func() core.StepIdentifier {
+					return identifier
+				},
+			}
+		}
+
+		args := createMockArgs()
+		args.StateMachineName = "test"
+		args.Steps = map[core.StepIdentifier]core.Step{
+			providedIdentifier0: createStep(providedIdentifier0, providedIdentifier1),
+			providedIdentifier1: createStep(providedIdentifier1, providedIdentifier2),
+			providedIdentifier2: createStep(providedIdentifier2, providedIdentifier0),
+		}
+		args.StartStateIdentifier = providedIdentifier0
+		args.Storer = storer
+
+		return args
+	}
+
+	t.Run("restarted machine should continue from the persisted step", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testsCommon.NewStorerMock()
+		numCalls := make(map[core.StepIdentifier]int)
+		sm, _ := stateMachine.NewStateMachine(createArgs(storer, numCalls))
+		_ = sm.Execute(context.Background())
+		_ = sm.Execute(context.Background())
+		assert.Equal(t, providedIdentifier2, sm.CurrentStep())
+
+		numCalls = make(map[core.StepIdentifier]int)
+		restartedSm, err := stateMachine.NewStateMachine(createArgs(storer, numCalls))
+		assert.Nil(t, err)
+		assert.Equal(t, providedIdentifier2, restartedSm.CurrentStep())
+
+		_ = restartedSm.Execute(context.Background())
+		assert.Equal(t, providedIdentifier0, restartedSm.CurrentStep())
+		assert.Equal(t, map[core.StepIdentifier]int{providedIdentifier2: 1}, numCalls)
+	})
+	t.Run("unknown persisted step should start from the start step", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testsCommon.NewStorerMock()
+		_ = storer.Put([]byte("test: state machine current step"), []byte("not found"))
+		sm, err := stateMachine.NewStateMachine(createArgs(storer, make(map[core.StepIdentifier]int)))
+		assert.Nil(t, err)
+		assert.Equal(t, providedIdentifier0, sm.CurrentStep())
+	})
+	t.Run("nil storer should start from the start step", func(t *testing.T) {
+		t.Parallel()
+
+		sm, err := stateMachine.NewStateMachine(createArgs(nil, make(map[core.StepIdentifier]int)))
+		assert.Nil(t, err)
+		assert.Equal(t, providedIdentifier0, sm.CurrentStep())
+	})
+}
+
+func TestStateMachine_OnFatalError(t *testing.T) {
+	t.Parallel()
+
+	providedIdentifier0 := core.StepIdentifier("step0")
+	createArgs := func(numCalls *int) stateMachine.ArgsStateMachine {
+		args := createMockArgs()
+		args.Steps = map[core.StepIdentifier]core.Step{
+			providedIdentifier0: &testsCommon.StepMock{
+				ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+					*numCalls++
+					return "not found"
+				},
+				IdentifierCalled: func() core.StepIdentifier {
+					return providedIdentifier0
+				},
+			},
+		}
+		args.StartStateIdentifier = providedIdentifier0
+
+		return args
+	}
+
+	t.Run("unknown next step should halt and call the fatal error handler once", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		args := createArgs(&numCalls)
+		var fatalErrors []error
+		args.OnFatalError = func(err error) {
+			fatalErrors = append(fatalErrors, err)
+		}
+		statusHandler := testsCommon.NewStatusHandlerMock("mock")
+		args.StatusHandler = statusHandler
+		sm, _ := stateMachine.NewStateMachine(args)
+		assert.False(t, sm.IsHalted())
+
+		err := sm.Execute(context.Background())
+		assert.True(t, errors.Is(err, stateMachine.ErrStepNotFound))
+		assert.True(t, sm.IsHalted())
+		assert.Equal(t, providedIdentifier0, sm.CurrentStep())
+		assert.NotEmpty(t, statusHandler.GetStringMetric(core.MetricLastError))
+
+		for i := 0; i < 3; i++ {
+			err = sm.Execute(context.Background())
+			assert.Equal(t, stateMachine.ErrStateMachineHalted, err)
+		}
+		assert.Equal(t, 1, numCalls)
+		assert.Equal(t, 1, len(fatalErrors))
+		assert.True(t, errors.Is(fatalErrors[0], stateMachine.ErrStepNotFound))
+	})
+	t.Run("nil fatal error handler should not panic", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		sm, _ := stateMachine.NewStateMachine(createArgs(&numCalls))
+
+		assert.NotPanics(t, func() {
+			err := sm.Execute(context.Background())
+			assert.True(t, errors.Is(err, stateMachine.ErrStepNotFound))
+		})
+		assert.True(t, sm.IsHalted())
+	})
+}
+
+func TestStateMachine_MaxConsecutiveErrors(t *testing.T) {
+	t.Parallel()
+
+	providedIdentifier0 := core.StepIdentifier("step0")
+	providedIdentifier1 := core.StepIdentifier("step1")
+	createArgs := func(shouldPanic *bool) stateMachine.ArgsStateMachine {
+		args := createMockArgs()
+		args.Steps = map[core.StepIdentifier]core.Step{
+			providedIdentifier0: &testsCommon.StepMock{
+				ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+					if *shouldPanic {
+						panic("step panic")
+					}
+					return providedIdentifier1
+				},
+				IdentifierCalled: func() core.StepIdentifier {
+					return providedIdentifier0
+				},
+			},
+			providedIdentifier1: &testsCommon.StepMock{
+				ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+					return providedIdentifier0
+				},
+				IdentifierCalled: func() core.StepIdentifier {
+					return providedIdentifier1
+				},
+			},
+		}
+		args.StartStateIdentifier = providedIdentifier0
+		args.MaxConsecutiveErrors = 3
+
+		return args
+	}
+
+	t.Run("should halt after the maximum consecutive errors", func(t *testing.T) {
+		t.Parallel()
+
+		shouldPanic := true
+		args := createArgs(&shouldPanic)
+		var fatalError error
+		args.OnFatalError = func(err error) {
+			fatalError = err
+		}
+		sm, _ := stateMachine.NewStateMachine(args)
+
+		for i := 0; i < 2; i++ {
+			err := sm.Execute(context.Background())
+			assert.True(t, errors.Is(err, stateMachine.ErrStepPanicked))
+			assert.False(t, sm.IsHalted())
+			assert.Equal(t, providedIdentifier0, sm.CurrentStep())
+		}
+
+		err := sm.Execute(context.Background())
+		assert.True(t, errors.Is(err, stateMachine.ErrStepPanicked))
+		assert.True(t, sm.IsHalted())
+		assert.True(t, errors.Is(fatalError, stateMachine.ErrMaxConsecutiveErrorsReached))
+		assert.Equal(t, stateMachine.ErrStateMachineHalted, sm.Execute(context.Background()))
+	})
+	t.Run("successful step should reset the counter", func(t *testing.T) {
+		t.Parallel()
+
+		shouldPanic := true
+		sm, _ := stateMachine.NewStateMachine(createArgs(&shouldPanic))
+
+		for i := 0; i < 3; i++ {
+			shouldPanic = true
+			_ = sm.Execute(context.Background())
+			_ = sm.Execute(context.Background())
+			assert.False(t, sm.IsHalted())
+
+			shouldPanic = false
+			err := sm.Execute(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, providedIdentifier1, sm.CurrentStep())
+			err = sm.Execute(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, providedIdentifier0, sm.CurrentStep())
+		}
+		assert.False(t, sm.IsHalted())
+	})
+	t.Run("timeouts should count as errors", func(t *testing.T) {
+		t.Parallel()
+
+		unblock := make(chan struct{})
+		defer close(unblock)
+		args := createMockArgs()
+		args.Steps = map[core.StepIdentifier]core.Step{
+			providedIdentifier0: &testsCommon.StepMock{
+				ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+					<-unblock
+					return providedIdentifier0
+				},
+				IdentifierCalled: func() core.StepIdentifier {
+					return providedIdentifier0
+				},
+			},
+		}
+		args.StartStateIdentifier = providedIdentifier0
+		args.StepExecutionTimeout = time.Millisecond
+		args.MaxConsecutiveErrors = 2
+		sm, _ := stateMachine.NewStateMachine(args)
+
+		_ = sm.Execute(context.Background())
+		assert.False(t, sm.IsHalted())
+		_ = sm.Execute(context.Background())
+		assert.True(t, sm.IsHalted())
+	})
+	t.Run("zero maximum should never halt", func(t *testing.T) {
+		t.Parallel()
+
+		shouldPanic := true
+		args := createArgs(&shouldPanic)
+		args.MaxConsecutiveErrors = 0
+		sm, _ := stateMachine.NewStateMachine(args)
+
+		for i := 0; i < 10; i++ {
+			err := sm.Execute(context.Background())
+			assert.True(t, errors.Is(err, stateMachine.ErrStepPanicked))
+		}
+		assert.False(t, sm.IsHalted())
 	})
 }
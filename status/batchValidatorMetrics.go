@@ -0,0 +1,107 @@
+package status
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+// BatchValidatorMetricsSnapshot holds the aggregated values recorded by the batch validator metrics component
+type BatchValidatorMetricsSnapshot struct {
+	NumRequestsSucceeded    uint64
+	NumRequestsFailed       uint64
+	NumValidBatches         uint64
+	NumInvalidBatches       uint64
+	NumDurationObservations uint64
+	LastRequestDuration     time.Duration
+	AverageRequestDuration  time.Duration
+	MaxRequestDuration      time.Duration
+}
+
+type batchValidatorMetrics struct {
+	mut           sync.RWMutex
+	snapshot      BatchValidatorMetricsSnapshot
+	totalDuration time.Duration
+	statusHandler core.StatusHandler
+}
+
+// NewBatchValidatorMetrics creates an in-memory batch validator requests registry that also publishes the counters
+// and the aggregated latencies on the provided status handler
+func NewBatchValidatorMetrics(statusHandler core.StatusHandler) (*batchValidatorMetrics, error) {
+	if check.IfNil(statusHandler) {
+		return nil, ErrNilStatusHandler
+	}
+
+	return &batchValidatorMetrics{
+		statusHandler: statusHandler,
+	}, nil
+}
+
+// ObserveRequestDuration records the duration of a batch validator request, retries included
+func (bvm *batchValidatorMetrics) ObserveRequestDuration(duration time.Duration) {
+	bvm.mut.Lock()
+	bvm.snapshot.NumDurationObservations++
+	bvm.totalDuration += duration
+	bvm.snapshot.LastRequestDuration = duration
+	bvm.snapshot.AverageRequestDuration = bvm.totalDuration / time.Duration(bvm.snapshot.NumDurationObservations)
+	if duration > bvm.snapshot.MaxRequestDuration {
+		bvm.snapshot.MaxRequestDuration = duration
+	}
+	snapshot := bvm.snapshot
+	bvm.mut.Unlock()
+
+	bvm.statusHandler.SetIntMetric(core.MetricLastBatchValidatorRequestDurationInMillis, int(snapshot.LastRequestDuration.Milliseconds()))
+	bvm.statusHandler.SetIntMetric(core.MetricAverageBatchValidatorRequestDurationInMillis, int(snapshot.AverageRequestDuration.Milliseconds()))
+	bvm.statusHandler.SetIntMetric(core.MetricMaxBatchValidatorRequestDurationInMillis, int(snapshot.MaxRequestDuration.Milliseconds()))
+}
+
+// IncRequestsSucceeded increments the number of batch validator requests that got a response
+func (bvm *batchValidatorMetrics) IncRequestsSucceeded() {
+	bvm.mut.Lock()
+	bvm.snapshot.NumRequestsSucceeded++
+	bvm.mut.Unlock()
+
+	bvm.statusHandler.AddIntMetric(core.MetricNumBatchValidatorRequestsSucceeded, 1)
+}
+
+// IncRequestsFailed increments the number of batch validator requests that failed after all retries
+func (bvm *batchValidatorMetrics) IncRequestsFailed() {
+	bvm.mut.Lock()
+	bvm.snapshot.NumRequestsFailed++
+	bvm.mut.Unlock()
+
+	bvm.statusHandler.AddIntMetric(core.MetricNumBatchValidatorRequestsFailed, 1)
+}
+
+// IncBatchesValid increments the number of batches found valid by the batch validator
+func (bvm *batchValidatorMetrics) IncBatchesValid() {
+	bvm.mut.Lock()
+	bvm.snapshot.NumValidBatches++
+	bvm.mut.Unlock()
+
+	bvm.statusHandler.AddIntMetric(core.MetricNumBatchValidatorValidBatches, 1)
+}
+
+// IncBatchesInvalid increments the number of batches rejected by the batch validator
+func (bvm *batchValidatorMetrics) IncBatchesInvalid() {
+	bvm.mut.Lock()
+	bvm.snapshot.NumInvalidBatches++
+	bvm.mut.Unlock()
+
+	bvm.statusHandler.AddIntMetric(core.MetricNumBatchValidatorInvalidBatches, 1)
+}
+
+// GetSnapshot returns the aggregated values recorded so far
+func (bvm *batchValidatorMetrics) GetSnapshot() BatchValidatorMetricsSnapshot {
+	bvm.mut.RLock()
+	defer bvm.mut.RUnlock()
+
+	return bvm.snapshot
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bvm *batchValidatorMetrics) IsInterfaceNil() bool {
+	return bvm == nil
+}
@@ -0,0 +1,119 @@
+package status
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBatchValidatorMetrics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil status handler should error", func(t *testing.T) {
+		bvm, err := NewBatchValidatorMetrics(nil)
+		assert.Equal(t, ErrNilStatusHandler, err)
+		assert.True(t, check.IfNil(bvm))
+	})
+	t.Run("should work", func(t *testing.T) {
+		bvm, err := NewBatchValidatorMetrics(testsCommon.NewStatusHandlerMock("test"))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(bvm))
+		assert.Equal(t, BatchValidatorMetricsSnapshot{}, bvm.GetSnapshot())
+	})
+}
+
+func TestBatchValidatorMetrics_Counters(t *testing.T) {
+	t.Parallel()
+
+	statusHandler := testsCommon.NewStatusHandlerMock("test")
+	bvm, err := NewBatchValidatorMetrics(statusHandler)
+	require.Nil(t, err)
+
+	bvm.IncRequestsSucceeded()
+	bvm.IncRequestsSucceeded()
+	bvm.IncRequestsSucceeded()
+	bvm.IncRequestsFailed()
+	bvm.IncBatchesValid()
+	bvm.IncBatchesValid()
+	bvm.IncBatchesInvalid()
+
+	snapshot := bvm.GetSnapshot()
+	assert.Equal(t, uint64(3), snapshot.NumRequestsSucceeded)
+	assert.Equal(t, uint64(1), snapshot.NumRequestsFailed)
+	assert.Equal(t, uint64(2), snapshot.NumValidBatches)
+	assert.Equal(t, uint64(1), snapshot.NumInvalidBatches)
+
+	assert.Equal(t, 3, statusHandler.GetIntMetric(core.MetricNumBatchValidatorRequestsSucceeded))
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumBatchValidatorRequestsFailed))
+	assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricNumBatchValidatorValidBatches))
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumBatchValidatorInvalidBatches))
+}
+
+func TestBatchValidatorMetrics_ObserveRequestDuration(t *testing.T) {
+	t.Parallel()
+
+	statusHandler := testsCommon.NewStatusHandlerMock("test")
+	bvm, err := NewBatchValidatorMetrics(statusHandler)
+	require.Nil(t, err)
+
+	bvm.ObserveRequestDuration(time.Millisecond * 100)
+	bvm.ObserveRequestDuration(time.Millisecond * 600)
+	bvm.ObserveRequestDuration(time.Millisecond * 200)
+
+	expectedSnapshot := BatchValidatorMetricsSnapshot{
+		NumDurationObservations: 3,
+		LastRequestDuration:     time.Millisecond * 200,
+		AverageRequestDuration:  time.Millisecond * 300,
+		MaxRequestDuration:      time.Millisecond * 600,
+	}
+	assert.Equal(t, expectedSnapshot, bvm.GetSnapshot())
+
+	assert.Equal(t, 200, statusHandler.GetIntMetric(core.MetricLastBatchValidatorRequestDurationInMillis))
+	assert.Equal(t, 300, statusHandler.GetIntMetric(core.MetricAverageBatchValidatorRequestDurationInMillis))
+	assert.Equal(t, 600, statusHandler.GetIntMetric(core.MetricMaxBatchValidatorRequestDurationInMillis))
+}
+
+func TestBatchValidatorMetrics_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, "should not panic")
+		}
+	}()
+
+	bvm, _ := NewBatchValidatorMetrics(testsCommon.NewStatusHandlerMock("test"))
+
+	numCalls := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			switch idx % 6 {
+			case 0:
+				bvm.ObserveRequestDuration(time.Duration(idx))
+			case 1:
+				bvm.IncRequestsSucceeded()
+			case 2:
+				bvm.IncRequestsFailed()
+			case 3:
+				bvm.IncBatchesValid()
+			case 4:
+				bvm.IncBatchesInvalid()
+			case 5:
+				_ = bvm.GetSnapshot()
+			}
+
+			wg.Done()
+		}(i)
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,31 @@
+package disabled
+
+import "time"
+
+// DisabledBatchValidatorMetrics implementation in case no batch validator metrics are recorded
+type DisabledBatchValidatorMetrics struct{}
+
+// ObserveRequestDuration does nothing
+func (dbvm *DisabledBatchValidatorMetrics) ObserveRequestDuration(_ time.Duration) {
+}
+
+// IncRequestsSucceeded does nothing
+func (dbvm *DisabledBatchValidatorMetrics) IncRequestsSucceeded() {
+}
+
+// IncRequestsFailed does nothing
+func (dbvm *DisabledBatchValidatorMetrics) IncRequestsFailed() {
+}
+
+// IncBatchesValid does nothing
+func (dbvm *DisabledBatchValidatorMetrics) IncBatchesValid() {
+}
+
+// IncBatchesInvalid does nothing
+func (dbvm *DisabledBatchValidatorMetrics) IncBatchesInvalid() {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dbvm *DisabledBatchValidatorMetrics) IsInterfaceNil() bool {
+	return dbvm == nil
+}
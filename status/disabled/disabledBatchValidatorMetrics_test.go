@@ -0,0 +1,29 @@
+package disabled
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledBatchValidatorMetrics(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, "should not panic")
+		}
+	}()
+
+	dbvm := &DisabledBatchValidatorMetrics{}
+	assert.False(t, check.IfNil(dbvm))
+
+	dbvm.ObserveRequestDuration(time.Second)
+	dbvm.IncRequestsSucceeded()
+	dbvm.IncRequestsFailed()
+	dbvm.IncBatchesValid()
+	dbvm.IncBatchesInvalid()
+}
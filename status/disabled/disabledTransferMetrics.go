@@ -0,0 +1,35 @@
+package disabled
+
+import "time"
+
+// DisabledTransferMetrics implementation in case no transfer metrics are recorded
+type DisabledTransferMetrics struct{}
+
+// IncTransfersProposed does nothing
+func (dtm *DisabledTransferMetrics) IncTransfersProposed() {
+}
+
+// IncTransfersSigned does nothing
+func (dtm *DisabledTransferMetrics) IncTransfersSigned() {
+}
+
+// IncTransfersSent does nothing
+func (dtm *DisabledTransferMetrics) IncTransfersSent() {
+}
+
+// IncTransfersFailed does nothing
+func (dtm *DisabledTransferMetrics) IncTransfersFailed() {
+}
+
+// ObserveExecutionGasPrice does nothing
+func (dtm *DisabledTransferMetrics) ObserveExecutionGasPrice(_ float64) {
+}
+
+// ObserveBatchExecutionDuration does nothing
+func (dtm *DisabledTransferMetrics) ObserveBatchExecutionDuration(_ time.Duration) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dtm *DisabledTransferMetrics) IsInterfaceNil() bool {
+	return dtm == nil
+}
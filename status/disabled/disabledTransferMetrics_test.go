@@ -0,0 +1,30 @@
+package disabled
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledTransferMetrics(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, "should not panic")
+		}
+	}()
+
+	dtm := &DisabledTransferMetrics{}
+	assert.False(t, check.IfNil(dtm))
+
+	dtm.IncTransfersProposed()
+	dtm.IncTransfersSigned()
+	dtm.IncTransfersSent()
+	dtm.IncTransfersFailed()
+	dtm.ObserveExecutionGasPrice(100)
+	dtm.ObserveBatchExecutionDuration(time.Second)
+}
@@ -13,3 +13,27 @@ var ErrMissingStatusHandler = errors.New("missing status handler")
 
 // ErrNilStorer signals that a nil storer was provided
 var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilStatusHandler signals that a nil status handler was provided
+var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrNilAvailabilityChecker signals that a nil availability checker was provided
+var ErrNilAvailabilityChecker = errors.New("nil availability checker")
+
+// ErrAvailabilityCheckerExists signals that an availability checker with the same name was already registered
+var ErrAvailabilityCheckerExists = errors.New("availability checker exists with the same name")
+
+// ErrUnhealthy signals that at least one of the registered availability checkers failed
+var ErrUnhealthy = errors.New("unhealthy")
+
+// ErrNilMetricsHolder signals that a nil metrics holder was provided
+var ErrNilMetricsHolder = errors.New("nil metrics holder")
+
+// ErrNilHealthReporter signals that a nil health reporter was provided
+var ErrNilHealthReporter = errors.New("nil health reporter")
+
+// ErrNilGasHandler signals that a nil gas handler was provided
+var ErrNilGasHandler = errors.New("nil gas handler")
+
+// ErrNilBalanceProvider signals that a nil balance provider was provided
+var ErrNilBalanceProvider = errors.New("nil balance provider")
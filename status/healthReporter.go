@@ -0,0 +1,80 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+const healthyValue = "available"
+
+type healthReporter struct {
+	mut      sync.RWMutex
+	checkers map[string]core.AvailabilityChecker
+}
+
+// NewHealthReporter returns a new instance of the component able to aggregate the availability checks
+func NewHealthReporter() *healthReporter {
+	return &healthReporter{
+		checkers: make(map[string]core.AvailabilityChecker),
+	}
+}
+
+// AddChecker adds the new availability checker, if it does not exist
+func (hr *healthReporter) AddChecker(name string, checker core.AvailabilityChecker) error {
+	if len(name) == 0 {
+		return ErrEmptyName
+	}
+	if check.IfNil(checker) {
+		return fmt.Errorf("%w for %s", ErrNilAvailabilityChecker, name)
+	}
+
+	hr.mut.Lock()
+	defer hr.mut.Unlock()
+
+	_, exists := hr.checkers[name]
+	if exists {
+		return fmt.Errorf("%w for %s", ErrAvailabilityCheckerExists, name)
+	}
+
+	hr.checkers[name] = checker
+
+	return nil
+}
+
+// CheckHealth runs all the registered availability checks and returns the outcome of each one. It errors if at least
+// one of the checks failed
+func (hr *healthReporter) CheckHealth(ctx context.Context) (core.GeneralMetrics, error) {
+	hr.mut.RLock()
+	defer hr.mut.RUnlock()
+
+	results := make(core.GeneralMetrics)
+	unhealthy := make([]string, 0)
+	for name, checker := range hr.checkers {
+		err := checker.CheckClientAvailability(ctx)
+		if err != nil {
+			results[name] = err.Error()
+			unhealthy = append(unhealthy, name)
+			continue
+		}
+
+		results[name] = healthyValue
+	}
+
+	if len(unhealthy) > 0 {
+		sort.Strings(unhealthy)
+		return results, fmt.Errorf("%w: %s", ErrUnhealthy, strings.Join(unhealthy, ", "))
+	}
+
+	return results, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hr *healthReporter) IsInterfaceNil() bool {
+	return hr == nil
+}
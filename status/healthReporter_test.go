@@ -0,0 +1,102 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHealthReporter(t *testing.T) {
+	t.Parallel()
+
+	hr := NewHealthReporter()
+	assert.False(t, check.IfNil(hr))
+}
+
+func TestHealthReporter_AddChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty name should error", func(t *testing.T) {
+		t.Parallel()
+
+		hr := NewHealthReporter()
+		err := hr.AddChecker("", &testsCommon.AvailabilityCheckerStub{})
+		assert.Equal(t, ErrEmptyName, err)
+	})
+	t.Run("nil checker should error", func(t *testing.T) {
+		t.Parallel()
+
+		hr := NewHealthReporter()
+		err := hr.AddChecker("checker", nil)
+		assert.True(t, errors.Is(err, ErrNilAvailabilityChecker))
+	})
+	t.Run("duplicated name should error", func(t *testing.T) {
+		t.Parallel()
+
+		hr := NewHealthReporter()
+		err := hr.AddChecker("checker", &testsCommon.AvailabilityCheckerStub{})
+		assert.Nil(t, err)
+
+		err = hr.AddChecker("checker", &testsCommon.AvailabilityCheckerStub{})
+		assert.True(t, errors.Is(err, ErrAvailabilityCheckerExists))
+		assert.Equal(t, 1, len(hr.checkers))
+	})
+}
+
+func TestHealthReporter_CheckHealth(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no checkers should be healthy", func(t *testing.T) {
+		t.Parallel()
+
+		hr := NewHealthReporter()
+		results, err := hr.CheckHealth(context.Background())
+		assert.Nil(t, err)
+		assert.Empty(t, results)
+	})
+	t.Run("all checkers available should be healthy", func(t *testing.T) {
+		t.Parallel()
+
+		hr := NewHealthReporter()
+		_ = hr.AddChecker("checker1", &testsCommon.AvailabilityCheckerStub{})
+		_ = hr.AddChecker("checker2", &testsCommon.AvailabilityCheckerStub{})
+
+		results, err := hr.CheckHealth(context.Background())
+		assert.Nil(t, err)
+		expectedResults := core.GeneralMetrics{
+			"checker1": healthyValue,
+			"checker2": healthyValue,
+		}
+		assert.Equal(t, expectedResults, results)
+	})
+	t.Run("failing checkers should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		failingChecker := &testsCommon.AvailabilityCheckerStub{
+			CheckClientAvailabilityCalled: func(ctx context.Context) error {
+				return expectedErr
+			},
+		}
+		hr := NewHealthReporter()
+		_ = hr.AddChecker("checker1", &testsCommon.AvailabilityCheckerStub{})
+		_ = hr.AddChecker("checker3", failingChecker)
+		_ = hr.AddChecker("checker2", failingChecker)
+
+		results, err := hr.CheckHealth(context.Background())
+		assert.True(t, errors.Is(err, ErrUnhealthy))
+		assert.True(t, strings.HasSuffix(err.Error(), "checker2, checker3"))
+		expectedResults := core.GeneralMetrics{
+			"checker1": healthyValue,
+			"checker2": expectedErr.Error(),
+			"checker3": expectedErr.Error(),
+		}
+		assert.Equal(t, expectedResults, results)
+	})
+}
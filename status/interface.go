@@ -0,0 +1,19 @@
+package status
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// GasHandler defines the component able to fetch the current gas price
+type GasHandler interface {
+	GetCurrentGasPrice() (*big.Int, error)
+	IsInterfaceNil() bool
+}
+
+// BalanceProvider defines the component able to fetch the balance of an Ethereum account
+type BalanceProvider interface {
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
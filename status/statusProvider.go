@@ -0,0 +1,133 @@
+package status
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ArgsStatusProvider is the DTO used in the status provider constructor
+type ArgsStatusProvider struct {
+	MetricsHolder          core.MetricsHolder
+	StateMachineNames      []string
+	HealthReporter         core.HealthReporter
+	GasHandler             GasHandler
+	BalanceProvider        BalanceProvider
+	EthereumRelayerAddress common.Address
+	ElrondRelayerAddress   string
+}
+
+type statusProvider struct {
+	metricsHolder          core.MetricsHolder
+	stateMachineNames      []string
+	healthReporter         core.HealthReporter
+	gasHandler             GasHandler
+	balanceProvider        BalanceProvider
+	ethereumRelayerAddress common.Address
+	elrondRelayerAddress   string
+}
+
+// NewStatusProvider creates the component able to aggregate the relayer status from the state machines metrics,
+// the clients availability checks and the Ethereum gas price and relayer balance
+func NewStatusProvider(args ArgsStatusProvider) (*statusProvider, error) {
+	err := checkArgsStatusProvider(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &statusProvider{
+		metricsHolder:          args.MetricsHolder,
+		stateMachineNames:      args.StateMachineNames,
+		healthReporter:         args.HealthReporter,
+		gasHandler:             args.GasHandler,
+		balanceProvider:        args.BalanceProvider,
+		ethereumRelayerAddress: args.EthereumRelayerAddress,
+		elrondRelayerAddress:   args.ElrondRelayerAddress,
+	}, nil
+}
+
+func checkArgsStatusProvider(args ArgsStatusProvider) error {
+	if check.IfNil(args.MetricsHolder) {
+		return ErrNilMetricsHolder
+	}
+	if check.IfNil(args.HealthReporter) {
+		return ErrNilHealthReporter
+	}
+	if check.IfNil(args.GasHandler) {
+		return ErrNilGasHandler
+	}
+	if args.BalanceProvider == nil {
+		return ErrNilBalanceProvider
+	}
+	for _, name := range args.StateMachineNames {
+		if len(name) == 0 {
+			return ErrEmptyName
+		}
+	}
+
+	return nil
+}
+
+// GetStatus returns the current relayer status. The parts that could not be fetched are left empty and the
+// encountered errors are listed in the snapshot
+func (sp *statusProvider) GetStatus(ctx context.Context) *core.RelayerStatus {
+	relayerStatus := &core.RelayerStatus{
+		StateMachines:          make(map[string]core.StateMachineStatus),
+		ElrondRelayerAddress:   sp.elrondRelayerAddress,
+		EthereumRelayerAddress: sp.ethereumRelayerAddress.String(),
+		Errors:                 make([]string, 0),
+	}
+
+	for _, name := range sp.stateMachineNames {
+		metrics, err := sp.metricsHolder.GetAllMetrics(name)
+		if err != nil {
+			addError(relayerStatus, "state machine %s: %v", name, err)
+			continue
+		}
+
+		relayerStatus.StateMachines[name] = createStateMachineStatus(metrics)
+	}
+
+	availability, err := sp.healthReporter.CheckHealth(ctx)
+	if err != nil {
+		addError(relayerStatus, "clients availability: %v", err)
+	}
+	relayerStatus.ClientsAvailability = availability
+
+	gasPrice, err := sp.gasHandler.GetCurrentGasPrice()
+	if err != nil {
+		addError(relayerStatus, "gas price: %v", err)
+	} else {
+		relayerStatus.GasPrice = gasPrice.String()
+	}
+
+	balance, err := sp.balanceProvider.BalanceAt(ctx, sp.ethereumRelayerAddress, nil)
+	if err != nil {
+		addError(relayerStatus, "ethereum balance: %v", err)
+	} else {
+		relayerStatus.EthereumBalance = balance.String()
+	}
+
+	return relayerStatus
+}
+
+func addError(relayerStatus *core.RelayerStatus, format string, args ...interface{}) {
+	relayerStatus.Errors = append(relayerStatus.Errors, fmt.Sprintf(format, args...))
+}
+
+func createStateMachineStatus(metrics core.GeneralMetrics) core.StateMachineStatus {
+	smStatus := core.StateMachineStatus{}
+	smStatus.CurrentStep, _ = metrics[core.MetricCurrentStateMachineStep].(string)
+	smStatus.NumBatches, _ = metrics[core.MetricNumBatches].(int)
+	smStatus.LastError, _ = metrics[core.MetricLastError].(string)
+
+	return smStatus
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *statusProvider) IsInterfaceNil() bool {
+	return sp == nil
+}
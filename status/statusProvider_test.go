@@ -0,0 +1,171 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	bridgeTests "github.com/ElrondNetwork/elrond-eth-bridge/testsCommon/bridge"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testStateMachineName = "EthToElrond"
+
+func createMockArgsStatusProvider() ArgsStatusProvider {
+	metricsHolder := NewMetricsHolder()
+	statusHandler := testsCommon.NewStatusHandlerMock(testStateMachineName)
+	statusHandler.SetStringMetric(core.MetricCurrentStateMachineStep, "getting the pending batch")
+	statusHandler.SetIntMetric(core.MetricNumBatches, 37)
+	statusHandler.SetStringMetric(core.MetricLastError, "last error")
+	_ = metricsHolder.AddStatusHandler(statusHandler)
+
+	return ArgsStatusProvider{
+		MetricsHolder:     metricsHolder,
+		StateMachineNames: []string{testStateMachineName},
+		HealthReporter:    NewHealthReporter(),
+		GasHandler: &testsCommon.GasHandlerStub{
+			GetCurrentGasPriceCalled: func() (*big.Int, error) {
+				return big.NewInt(1200), nil
+			},
+		},
+		BalanceProvider: &bridgeTests.EthereumClientWrapperStub{
+			BalanceAtCalled: func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+				return big.NewInt(5000), nil
+			},
+		},
+		EthereumRelayerAddress: common.HexToAddress("0x132A150926691F08a693721503a38affeD18d524"),
+		ElrondRelayerAddress:   "erd1qqqqqqqqqqqqqpgqzyuaqg3dl7rqlkudrsnm5ek0j3a97qevd8sszj0glf",
+	}
+}
+
+func TestNewStatusProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil metrics holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStatusProvider()
+		args.MetricsHolder = nil
+
+		sp, err := NewStatusProvider(args)
+		assert.True(t, check.IfNil(sp))
+		assert.Equal(t, ErrNilMetricsHolder, err)
+	})
+	t.Run("nil health reporter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStatusProvider()
+		args.HealthReporter = nil
+
+		sp, err := NewStatusProvider(args)
+		assert.True(t, check.IfNil(sp))
+		assert.Equal(t, ErrNilHealthReporter, err)
+	})
+	t.Run("nil gas handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStatusProvider()
+		args.GasHandler = nil
+
+		sp, err := NewStatusProvider(args)
+		assert.True(t, check.IfNil(sp))
+		assert.Equal(t, ErrNilGasHandler, err)
+	})
+	t.Run("nil balance provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStatusProvider()
+		args.BalanceProvider = nil
+
+		sp, err := NewStatusProvider(args)
+		assert.True(t, check.IfNil(sp))
+		assert.Equal(t, ErrNilBalanceProvider, err)
+	})
+	t.Run("empty state machine name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStatusProvider()
+		args.StateMachineNames = append(args.StateMachineNames, "")
+
+		sp, err := NewStatusProvider(args)
+		assert.True(t, check.IfNil(sp))
+		assert.Equal(t, ErrEmptyName, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := NewStatusProvider(createMockArgsStatusProvider())
+		assert.False(t, check.IfNil(sp))
+		assert.Nil(t, err)
+	})
+}
+
+func TestStatusProvider_GetStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all components working should return the full snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStatusProvider()
+		_ = args.HealthReporter.AddChecker("checker", &testsCommon.AvailabilityCheckerStub{})
+		sp, _ := NewStatusProvider(args)
+
+		expectedStatus := &core.RelayerStatus{
+			StateMachines: map[string]core.StateMachineStatus{
+				testStateMachineName: {
+					CurrentStep: "getting the pending batch",
+					NumBatches:  37,
+					LastError:   "last error",
+				},
+			},
+			ElrondRelayerAddress:   args.ElrondRelayerAddress,
+			EthereumRelayerAddress: args.EthereumRelayerAddress.String(),
+			EthereumBalance:        "5000",
+			GasPrice:               "1200",
+			ClientsAvailability:    core.GeneralMetrics{"checker": healthyValue},
+			Errors:                 make([]string, 0),
+		}
+		assert.Equal(t, expectedStatus, sp.GetStatus(context.Background()))
+	})
+	t.Run("failing components should be reported in the snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsStatusProvider()
+		args.StateMachineNames = []string{"missing"}
+		_ = args.HealthReporter.AddChecker("checker", &testsCommon.AvailabilityCheckerStub{
+			CheckClientAvailabilityCalled: func(ctx context.Context) error {
+				return expectedErr
+			},
+		})
+		args.GasHandler = &testsCommon.GasHandlerStub{
+			GetCurrentGasPriceCalled: func() (*big.Int, error) {
+				return nil, expectedErr
+			},
+		}
+		args.BalanceProvider = &bridgeTests.EthereumClientWrapperStub{
+			BalanceAtCalled: func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+				return nil, expectedErr
+			},
+		}
+		sp, _ := NewStatusProvider(args)
+
+		relayerStatus := sp.GetStatus(context.Background())
+		assert.Empty(t, relayerStatus.StateMachines)
+		assert.Empty(t, relayerStatus.GasPrice)
+		assert.Empty(t, relayerStatus.EthereumBalance)
+		assert.Equal(t, core.GeneralMetrics{"checker": expectedErr.Error()}, relayerStatus.ClientsAvailability)
+		require.Equal(t, 4, len(relayerStatus.Errors))
+		assert.True(t, strings.Contains(relayerStatus.Errors[0], ErrMissingStatusHandler.Error()))
+		assert.True(t, strings.Contains(relayerStatus.Errors[1], ErrUnhealthy.Error()))
+		assert.True(t, strings.HasPrefix(relayerStatus.Errors[2], "gas price"))
+		assert.True(t, strings.HasPrefix(relayerStatus.Errors[3], "ethereum balance"))
+	})
+}
@@ -0,0 +1,93 @@
+package status
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+// StepDurationStats holds the aggregated execution durations of a state machine step
+type StepDurationStats struct {
+	NumExecutions uint64
+	Average       time.Duration
+	Max           time.Duration
+}
+
+type stepDurations struct {
+	numExecutions uint64
+	total         time.Duration
+	max           time.Duration
+}
+
+type stepDurationMetrics struct {
+	mut           sync.RWMutex
+	durations     map[core.StepIdentifier]*stepDurations
+	statusHandler core.StatusHandler
+}
+
+// NewStepDurationMetrics creates an in-memory step duration recorder that also publishes the average and the maximum
+// duration of each step on the provided status handler
+func NewStepDurationMetrics(statusHandler core.StatusHandler) (*stepDurationMetrics, error) {
+	if check.IfNil(statusHandler) {
+		return nil, ErrNilStatusHandler
+	}
+
+	return &stepDurationMetrics{
+		durations:     make(map[core.StepIdentifier]*stepDurations),
+		statusHandler: statusHandler,
+	}, nil
+}
+
+// RecordStepDuration records the execution duration of the provided step
+func (sdm *stepDurationMetrics) RecordStepDuration(identifier core.StepIdentifier, duration time.Duration) {
+	sdm.mut.Lock()
+	durations, found := sdm.durations[identifier]
+	if !found {
+		durations = &stepDurations{}
+		sdm.durations[identifier] = durations
+	}
+
+	durations.numExecutions++
+	durations.total += duration
+	if duration > durations.max {
+		durations.max = duration
+	}
+	stats := durations.toStats()
+	sdm.mut.Unlock()
+
+	sdm.statusHandler.SetIntMetric(createStepMetricName(identifier, core.MetricStepAverageDurationInMillis), int(stats.Average.Milliseconds()))
+	sdm.statusHandler.SetIntMetric(createStepMetricName(identifier, core.MetricStepMaxDurationInMillis), int(stats.Max.Milliseconds()))
+}
+
+// GetStepDurationStats returns the aggregated durations for all the recorded steps
+func (sdm *stepDurationMetrics) GetStepDurationStats() map[core.StepIdentifier]StepDurationStats {
+	sdm.mut.RLock()
+	defer sdm.mut.RUnlock()
+
+	allStats := make(map[core.StepIdentifier]StepDurationStats, len(sdm.durations))
+	for identifier, durations := range sdm.durations {
+		allStats[identifier] = durations.toStats()
+	}
+
+	return allStats
+}
+
+func (durations *stepDurations) toStats() StepDurationStats {
+	return StepDurationStats{
+		NumExecutions: durations.numExecutions,
+		Average:       durations.total / time.Duration(durations.numExecutions),
+		Max:           durations.max,
+	}
+}
+
+func createStepMetricName(identifier core.StepIdentifier, metric string) string {
+	return fmt.Sprintf("step %s %s", identifier, metric)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sdm *stepDurationMetrics) IsInterfaceNil() bool {
+	return sdm == nil
+}
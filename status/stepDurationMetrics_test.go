@@ -0,0 +1,87 @@
+package status
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStepDurationMetrics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil status handler should error", func(t *testing.T) {
+		sdm, err := NewStepDurationMetrics(nil)
+		assert.Equal(t, ErrNilStatusHandler, err)
+		assert.True(t, check.IfNil(sdm))
+	})
+	t.Run("should work", func(t *testing.T) {
+		sdm, err := NewStepDurationMetrics(testsCommon.NewStatusHandlerMock("test"))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(sdm))
+		assert.Empty(t, sdm.GetStepDurationStats())
+	})
+}
+
+func TestStepDurationMetrics_RecordStepDuration(t *testing.T) {
+	t.Parallel()
+
+	statusHandler := testsCommon.NewStatusHandlerMock("test")
+	sdm, err := NewStepDurationMetrics(statusHandler)
+	require.Nil(t, err)
+
+	step1 := core.StepIdentifier("step1")
+	step2 := core.StepIdentifier("step2")
+	sdm.RecordStepDuration(step1, time.Millisecond*100)
+	sdm.RecordStepDuration(step1, time.Millisecond*400)
+	sdm.RecordStepDuration(step1, time.Millisecond*100)
+	sdm.RecordStepDuration(step2, time.Millisecond*5)
+
+	expectedStats := map[core.StepIdentifier]StepDurationStats{
+		step1: {
+			NumExecutions: 3,
+			Average:       time.Millisecond * 200,
+			Max:           time.Millisecond * 400,
+		},
+		step2: {
+			NumExecutions: 1,
+			Average:       time.Millisecond * 5,
+			Max:           time.Millisecond * 5,
+		},
+	}
+	assert.Equal(t, expectedStats, sdm.GetStepDurationStats())
+
+	assert.Equal(t, 200, statusHandler.GetIntMetric("step step1 "+core.MetricStepAverageDurationInMillis))
+	assert.Equal(t, 400, statusHandler.GetIntMetric("step step1 "+core.MetricStepMaxDurationInMillis))
+	assert.Equal(t, 5, statusHandler.GetIntMetric("step step2 "+core.MetricStepAverageDurationInMillis))
+	assert.Equal(t, 5, statusHandler.GetIntMetric("step step2 "+core.MetricStepMaxDurationInMillis))
+}
+
+func TestStepDurationMetrics_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	sdm, _ := NewStepDurationMetrics(testsCommon.NewStatusHandlerMock("test"))
+
+	numCalls := 100
+	var wg sync.WaitGroup
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			if idx%2 == 0 {
+				sdm.RecordStepDuration("step", time.Duration(idx))
+				return
+			}
+			_ = sdm.GetStepDurationStats()
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, uint64(numCalls/2), sdm.GetStepDurationStats()["step"].NumExecutions)
+}
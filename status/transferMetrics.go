@@ -0,0 +1,122 @@
+package status
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+// TransferMetricsSnapshot holds the aggregated values recorded by the transfer metrics component
+type TransferMetricsSnapshot struct {
+	NumProposed               uint64
+	NumSigned                 uint64
+	NumSent                   uint64
+	NumFailed                 uint64
+	NumGasPriceObservations   uint64
+	LastExecutionGasPrice     float64
+	AverageExecutionGasPrice  float64
+	NumDurationObservations   uint64
+	AverageBatchExecutionTime time.Duration
+	MaxBatchExecutionTime     time.Duration
+}
+
+type transferMetrics struct {
+	mut           sync.RWMutex
+	snapshot      TransferMetricsSnapshot
+	totalGasPrice float64
+	totalDuration time.Duration
+	statusHandler core.StatusHandler
+}
+
+// NewTransferMetrics creates an in-memory transfers lifecycle registry that also publishes the counters and the
+// aggregated observations on the provided status handler
+func NewTransferMetrics(statusHandler core.StatusHandler) (*transferMetrics, error) {
+	if check.IfNil(statusHandler) {
+		return nil, ErrNilStatusHandler
+	}
+
+	return &transferMetrics{
+		statusHandler: statusHandler,
+	}, nil
+}
+
+// IncTransfersProposed increments the number of proposed transfers
+func (tm *transferMetrics) IncTransfersProposed() {
+	tm.mut.Lock()
+	tm.snapshot.NumProposed++
+	tm.mut.Unlock()
+
+	tm.statusHandler.AddIntMetric(core.MetricNumTransfersProposed, 1)
+}
+
+// IncTransfersSigned increments the number of signed transfers
+func (tm *transferMetrics) IncTransfersSigned() {
+	tm.mut.Lock()
+	tm.snapshot.NumSigned++
+	tm.mut.Unlock()
+
+	tm.statusHandler.AddIntMetric(core.MetricNumTransfersSigned, 1)
+}
+
+// IncTransfersSent increments the number of sent transfer transactions. A sent transaction is not yet confirmed as executed
+func (tm *transferMetrics) IncTransfersSent() {
+	tm.mut.Lock()
+	tm.snapshot.NumSent++
+	tm.mut.Unlock()
+
+	tm.statusHandler.AddIntMetric(core.MetricNumTransfersSent, 1)
+}
+
+// IncTransfersFailed increments the number of failed transfer executions
+func (tm *transferMetrics) IncTransfersFailed() {
+	tm.mut.Lock()
+	tm.snapshot.NumFailed++
+	tm.mut.Unlock()
+
+	tm.statusHandler.AddIntMetric(core.MetricNumTransfersFailed, 1)
+}
+
+// ObserveExecutionGasPrice records the gas price used by a transfer execution
+func (tm *transferMetrics) ObserveExecutionGasPrice(gasPrice float64) {
+	tm.mut.Lock()
+	tm.snapshot.NumGasPriceObservations++
+	tm.totalGasPrice += gasPrice
+	tm.snapshot.LastExecutionGasPrice = gasPrice
+	tm.snapshot.AverageExecutionGasPrice = tm.totalGasPrice / float64(tm.snapshot.NumGasPriceObservations)
+	snapshot := tm.snapshot
+	tm.mut.Unlock()
+
+	tm.statusHandler.SetIntMetric(core.MetricLastExecutionGasPrice, int(snapshot.LastExecutionGasPrice))
+	tm.statusHandler.SetIntMetric(core.MetricAverageExecutionGasPrice, int(snapshot.AverageExecutionGasPrice))
+}
+
+// ObserveBatchExecutionDuration records the time passed between the batch detection and its execution
+func (tm *transferMetrics) ObserveBatchExecutionDuration(duration time.Duration) {
+	tm.mut.Lock()
+	tm.snapshot.NumDurationObservations++
+	tm.totalDuration += duration
+	tm.snapshot.AverageBatchExecutionTime = tm.totalDuration / time.Duration(tm.snapshot.NumDurationObservations)
+	if duration > tm.snapshot.MaxBatchExecutionTime {
+		tm.snapshot.MaxBatchExecutionTime = duration
+	}
+	snapshot := tm.snapshot
+	tm.mut.Unlock()
+
+	tm.statusHandler.SetIntMetric(core.MetricAverageBatchExecutionDurationInMillis, int(snapshot.AverageBatchExecutionTime.Milliseconds()))
+	tm.statusHandler.SetIntMetric(core.MetricMaxBatchExecutionDurationInMillis, int(snapshot.MaxBatchExecutionTime.Milliseconds()))
+}
+
+// GetSnapshot returns the aggregated values recorded so far
+func (tm *transferMetrics) GetSnapshot() TransferMetricsSnapshot {
+	tm.mut.RLock()
+	defer tm.mut.RUnlock()
+
+	return tm.snapshot
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tm *transferMetrics) IsInterfaceNil() bool {
+	return tm == nil
+}
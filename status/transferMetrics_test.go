@@ -0,0 +1,127 @@
+package status
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+	"github.com/ElrondNetwork/elrond-eth-bridge/testsCommon"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTransferMetrics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil status handler should error", func(t *testing.T) {
+		tm, err := NewTransferMetrics(nil)
+		assert.Equal(t, ErrNilStatusHandler, err)
+		assert.True(t, check.IfNil(tm))
+	})
+	t.Run("should work", func(t *testing.T) {
+		tm, err := NewTransferMetrics(testsCommon.NewStatusHandlerMock("test"))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(tm))
+		assert.Equal(t, TransferMetricsSnapshot{}, tm.GetSnapshot())
+	})
+}
+
+func TestTransferMetrics_Counters(t *testing.T) {
+	t.Parallel()
+
+	statusHandler := testsCommon.NewStatusHandlerMock("test")
+	tm, err := NewTransferMetrics(statusHandler)
+	require.Nil(t, err)
+
+	tm.IncTransfersProposed()
+	tm.IncTransfersProposed()
+	tm.IncTransfersSigned()
+	tm.IncTransfersSigned()
+	tm.IncTransfersSigned()
+	tm.IncTransfersSent()
+	tm.IncTransfersFailed()
+
+	snapshot := tm.GetSnapshot()
+	assert.Equal(t, uint64(2), snapshot.NumProposed)
+	assert.Equal(t, uint64(3), snapshot.NumSigned)
+	assert.Equal(t, uint64(1), snapshot.NumSent)
+	assert.Equal(t, uint64(1), snapshot.NumFailed)
+
+	assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricNumTransfersProposed))
+	assert.Equal(t, 3, statusHandler.GetIntMetric(core.MetricNumTransfersSigned))
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumTransfersSent))
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumTransfersFailed))
+}
+
+func TestTransferMetrics_Observations(t *testing.T) {
+	t.Parallel()
+
+	statusHandler := testsCommon.NewStatusHandlerMock("test")
+	tm, err := NewTransferMetrics(statusHandler)
+	require.Nil(t, err)
+
+	tm.ObserveExecutionGasPrice(100)
+	tm.ObserveExecutionGasPrice(300)
+	tm.ObserveExecutionGasPrice(200)
+
+	tm.ObserveBatchExecutionDuration(time.Millisecond * 100)
+	tm.ObserveBatchExecutionDuration(time.Millisecond * 500)
+
+	expectedSnapshot := TransferMetricsSnapshot{
+		NumGasPriceObservations:   3,
+		LastExecutionGasPrice:     200,
+		AverageExecutionGasPrice:  200,
+		NumDurationObservations:   2,
+		AverageBatchExecutionTime: time.Millisecond * 300,
+		MaxBatchExecutionTime:     time.Millisecond * 500,
+	}
+	assert.Equal(t, expectedSnapshot, tm.GetSnapshot())
+
+	assert.Equal(t, 200, statusHandler.GetIntMetric(core.MetricLastExecutionGasPrice))
+	assert.Equal(t, 200, statusHandler.GetIntMetric(core.MetricAverageExecutionGasPrice))
+	assert.Equal(t, 300, statusHandler.GetIntMetric(core.MetricAverageBatchExecutionDurationInMillis))
+	assert.Equal(t, 500, statusHandler.GetIntMetric(core.MetricMaxBatchExecutionDurationInMillis))
+}
+
+func TestTransferMetrics_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, "should not panic")
+		}
+	}()
+
+	tm, _ := NewTransferMetrics(testsCommon.NewStatusHandlerMock("test"))
+
+	numCalls := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			switch idx % 7 {
+			case 0:
+				tm.IncTransfersProposed()
+			case 1:
+				tm.IncTransfersSigned()
+			case 2:
+				tm.IncTransfersSent()
+			case 3:
+				tm.IncTransfersFailed()
+			case 4:
+				tm.ObserveExecutionGasPrice(float64(idx))
+			case 5:
+				tm.ObserveBatchExecutionDuration(time.Duration(idx))
+			case 6:
+				_ = tm.GetSnapshot()
+			}
+
+			wg.Done()
+		}(i)
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,22 @@
+package testsCommon
+
+import "context"
+
+// AvailabilityCheckerStub -
+type AvailabilityCheckerStub struct {
+	CheckClientAvailabilityCalled func(ctx context.Context) error
+}
+
+// CheckClientAvailability -
+func (stub *AvailabilityCheckerStub) CheckClientAvailability(ctx context.Context) error {
+	if stub.CheckClientAvailabilityCalled != nil {
+		return stub.CheckClientAvailabilityCalled(ctx)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *AvailabilityCheckerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,52 @@
+package testsCommon
+
+import "time"
+
+// BatchValidatorMetricsStub -
+type BatchValidatorMetricsStub struct {
+	ObserveRequestDurationCalled func(duration time.Duration)
+	IncRequestsSucceededCalled   func()
+	IncRequestsFailedCalled      func()
+	IncBatchesValidCalled        func()
+	IncBatchesInvalidCalled      func()
+}
+
+// ObserveRequestDuration -
+func (stub *BatchValidatorMetricsStub) ObserveRequestDuration(duration time.Duration) {
+	if stub.ObserveRequestDurationCalled != nil {
+		stub.ObserveRequestDurationCalled(duration)
+	}
+}
+
+// IncRequestsSucceeded -
+func (stub *BatchValidatorMetricsStub) IncRequestsSucceeded() {
+	if stub.IncRequestsSucceededCalled != nil {
+		stub.IncRequestsSucceededCalled()
+	}
+}
+
+// IncRequestsFailed -
+func (stub *BatchValidatorMetricsStub) IncRequestsFailed() {
+	if stub.IncRequestsFailedCalled != nil {
+		stub.IncRequestsFailedCalled()
+	}
+}
+
+// IncBatchesValid -
+func (stub *BatchValidatorMetricsStub) IncBatchesValid() {
+	if stub.IncBatchesValidCalled != nil {
+		stub.IncBatchesValidCalled()
+	}
+}
+
+// IncBatchesInvalid -
+func (stub *BatchValidatorMetricsStub) IncBatchesInvalid() {
+	if stub.IncBatchesInvalidCalled != nil {
+		stub.IncBatchesInvalidCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (stub *BatchValidatorMetricsStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -19,6 +19,7 @@ type BridgeExecutorStub struct {
 
 	PrintInfoCalled                                        func(logLevel logger.LogLevel, message string, extras ...interface{})
 	MyTurnAsLeaderCalled                                   func() bool
+	PrintNotMyTurnAsLeaderCalled                           func()
 	GetBatchFromElrondCalled                               func(ctx context.Context) (*clients.TransferBatch, error)
 	StoreBatchFromElrondCalled                             func(batch *clients.TransferBatch) error
 	GetStoredBatchCalled                                   func() *clients.TransferBatch
@@ -43,10 +44,10 @@ type BridgeExecutorStub struct {
 	ResetRetriesCountOnElrondCalled                        func()
 	GetAndStoreBatchFromEthereumCalled                     func(ctx context.Context, nonce uint64) error
 	WasTransferPerformedOnEthereumCalled                   func(ctx context.Context) (bool, error)
-	SignTransferOnEthereumCalled                           func() error
+	SignTransferOnEthereumCalled                           func(ctx context.Context) error
 	PerformTransferOnEthereumCalled                        func(ctx context.Context) error
 	ProcessQuorumReachedOnEthereumCalled                   func(ctx context.Context) (bool, error)
-	WaitForTransferConfirmationCalled                      func(ctx context.Context)
+	WaitForTransferConfirmationCalled                      func(ctx context.Context) error
 	WaitAndReturnFinalBatchStatusesCalled                  func(ctx context.Context) []byte
 	GetBatchStatusesFromEthereumCalled                     func(ctx context.Context) ([]byte, error)
 	ProcessMaxQuorumRetriesOnEthereumCalled                func() bool
@@ -82,6 +83,14 @@ func (stub *BridgeExecutorStub) MyTurnAsLeader() bool {
 	return false
 }
 
+// PrintNotMyTurnAsLeader -
+func (stub *BridgeExecutorStub) PrintNotMyTurnAsLeader() {
+	stub.incrementFunctionCounter()
+	if stub.PrintNotMyTurnAsLeaderCalled != nil {
+		stub.PrintNotMyTurnAsLeaderCalled()
+	}
+}
+
 // GetBatchFromElrond -
 func (stub *BridgeExecutorStub) GetBatchFromElrond(ctx context.Context) (*clients.TransferBatch, error) {
 	stub.incrementFunctionCounter()
@@ -296,10 +305,10 @@ func (stub *BridgeExecutorStub) WasTransferPerformedOnEthereum(ctx context.Conte
 }
 
 // SignTransferOnEthereum -
-func (stub *BridgeExecutorStub) SignTransferOnEthereum() error {
+func (stub *BridgeExecutorStub) SignTransferOnEthereum(ctx context.Context) error {
 	stub.incrementFunctionCounter()
 	if stub.SignTransferOnEthereumCalled != nil {
-		return stub.SignTransferOnEthereumCalled()
+		return stub.SignTransferOnEthereumCalled(ctx)
 	}
 	return notImplemented
 }
@@ -323,11 +332,12 @@ func (stub *BridgeExecutorStub) ProcessQuorumReachedOnEthereum(ctx context.Conte
 }
 
 // WaitForTransferConfirmation -
-func (stub *BridgeExecutorStub) WaitForTransferConfirmation(ctx context.Context) {
+func (stub *BridgeExecutorStub) WaitForTransferConfirmation(ctx context.Context) error {
 	stub.incrementFunctionCounter()
 	if stub.WaitForTransferConfirmationCalled != nil {
-		stub.WaitForTransferConfirmationCalled(ctx)
+		return stub.WaitForTransferConfirmationCalled(ctx)
 	}
+	return nil
 }
 
 // WaitAndReturnFinalBatchStatuses -
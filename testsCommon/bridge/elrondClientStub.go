@@ -21,14 +21,18 @@ type ElrondClientStub struct {
 	GetTransactionsStatusesCalled                  func(ctx context.Context, batchID uint64) ([]byte, error)
 	GetActionIDForSetStatusOnPendingTransferCalled func(ctx context.Context, batch *clients.TransferBatch) (uint64, error)
 	GetLastExecutedEthBatchIDCalled                func(ctx context.Context) (uint64, error)
+	GetExecutedBatchIDsCalled                      func(ctx context.Context, fromID uint64, toID uint64) ([]uint64, error)
 	GetLastExecutedEthTxIDCalled                   func(ctx context.Context) (uint64, error)
 	GetCurrentNonceCalled                          func(ctx context.Context) (uint64, error)
+	GetQuorumCalled                                func(ctx context.Context) (int, error)
+	GetRelayersCalled                              func(ctx context.Context) ([][]byte, error)
 	ProposeSetStatusCalled                         func(ctx context.Context, batch *clients.TransferBatch) (string, error)
 	ResolveNewDepositsCalled                       func(ctx context.Context, batch *clients.TransferBatch) error
 	ProposeTransferCalled                          func(ctx context.Context, batch *clients.TransferBatch) (string, error)
 	SignCalled                                     func(ctx context.Context, actionID uint64) (string, error)
 	WasSignedCalled                                func(ctx context.Context, actionID uint64) (bool, error)
 	PerformActionCalled                            func(ctx context.Context, actionID uint64, batch *clients.TransferBatch) (string, error)
+	EstimateActionGasCalled                        func(ctx context.Context, actionType clients.ElrondActionType, batch *clients.TransferBatch) (uint64, error)
 	CheckClientAvailabilityCalled                  func(ctx context.Context) error
 	CloseCalled                                    func() error
 }
@@ -123,6 +127,15 @@ func (stub *ElrondClientStub) GetLastExecutedEthBatchID(ctx context.Context) (ui
 	return 0, nil
 }
 
+// GetExecutedBatchIDs -
+func (stub *ElrondClientStub) GetExecutedBatchIDs(ctx context.Context, fromID uint64, toID uint64) ([]uint64, error) {
+	if stub.GetExecutedBatchIDsCalled != nil {
+		return stub.GetExecutedBatchIDsCalled(ctx, fromID, toID)
+	}
+
+	return make([]uint64, 0), nil
+}
+
 // GetLastExecutedEthTxID -
 func (stub *ElrondClientStub) GetLastExecutedEthTxID(ctx context.Context) (uint64, error) {
 	if stub.GetLastExecutedEthTxIDCalled != nil {
@@ -132,6 +145,24 @@ func (stub *ElrondClientStub) GetLastExecutedEthTxID(ctx context.Context) (uint6
 	return 0, nil
 }
 
+// GetQuorum -
+func (stub *ElrondClientStub) GetQuorum(ctx context.Context) (int, error) {
+	if stub.GetQuorumCalled != nil {
+		return stub.GetQuorumCalled(ctx)
+	}
+
+	return 0, nil
+}
+
+// GetRelayers -
+func (stub *ElrondClientStub) GetRelayers(ctx context.Context) ([][]byte, error) {
+	if stub.GetRelayersCalled != nil {
+		return stub.GetRelayersCalled(ctx)
+	}
+
+	return make([][]byte, 0), nil
+}
+
 // GetCurrentNonce -
 func (stub *ElrondClientStub) GetCurrentNonce(ctx context.Context) (uint64, error) {
 	if stub.GetCurrentNonceCalled != nil {
@@ -186,6 +217,15 @@ func (stub *ElrondClientStub) PerformAction(ctx context.Context, actionID uint64
 	return "", nil
 }
 
+// EstimateActionGas -
+func (stub *ElrondClientStub) EstimateActionGas(ctx context.Context, actionType clients.ElrondActionType, batch *clients.TransferBatch) (uint64, error) {
+	if stub.EstimateActionGasCalled != nil {
+		return stub.EstimateActionGasCalled(ctx, actionType, batch)
+	}
+
+	return 0, errNotImplemented
+}
+
 // CheckClientAvailability -
 func (stub *ElrondClientStub) CheckClientAvailability(ctx context.Context) error {
 	if stub.CheckClientAvailabilityCalled != nil {
@@ -11,14 +11,20 @@ import (
 // EthereumClientStub -
 type EthereumClientStub struct {
 	GetBatchCalled                         func(ctx context.Context, nonce uint64) (*clients.TransferBatch, error)
+	VerifyBatchStillValidCalled            func(ctx context.Context, batch *clients.TransferBatch) (bool, error)
 	WasExecutedCalled                      func(ctx context.Context, batchID uint64) (bool, error)
-	GenerateMessageHashCalled              func(batch *clients.TransferBatch) (common.Hash, error)
-	BroadcastSignatureForMessageHashCalled func(msgHash common.Hash)
+	GenerateMessageHashCalled              func(ctx context.Context, batch *clients.TransferBatch) (common.Hash, error)
+	BroadcastSignatureForMessageHashCalled func(msgHash common.Hash) error
 	ExecuteTransferCalled                  func(ctx context.Context, msgHash common.Hash, batch *clients.TransferBatch, quorum int) (string, error)
 	CheckClientAvailabilityCalled          func(ctx context.Context) error
 	GetTransactionsStatusesCalled          func(ctx context.Context, batchId uint64) ([]byte, error)
 	GetQuorumSizeCalled                    func(ctx context.Context) (*big.Int, error)
 	IsQuorumReachedCalled                  func(ctx context.Context, msgHash common.Hash) (bool, error)
+	IsPausedCalled                         func(ctx context.Context) (bool, error)
+	ResetNonceCalled                       func(ctx context.Context) error
+	ResetNonceToMinedCalled                func(ctx context.Context) error
+	IsTransactionRevertedCalled            func(ctx context.Context, txHash string) (bool, error)
+	WasTransactionMinedCalled              func(ctx context.Context, txHash string) (bool, error)
 }
 
 // GetBatch -
@@ -30,6 +36,15 @@ func (stub *EthereumClientStub) GetBatch(ctx context.Context, nonce uint64) (*cl
 	return nil, errNotImplemented
 }
 
+// VerifyBatchStillValid -
+func (stub *EthereumClientStub) VerifyBatchStillValid(ctx context.Context, batch *clients.TransferBatch) (bool, error) {
+	if stub.VerifyBatchStillValidCalled != nil {
+		return stub.VerifyBatchStillValidCalled(ctx, batch)
+	}
+
+	return true, nil
+}
+
 // WasExecuted -
 func (stub *EthereumClientStub) WasExecuted(ctx context.Context, batchID uint64) (bool, error) {
 	if stub.WasExecutedCalled != nil {
@@ -40,19 +55,21 @@ func (stub *EthereumClientStub) WasExecuted(ctx context.Context, batchID uint64)
 }
 
 // GenerateMessageHash -
-func (stub *EthereumClientStub) GenerateMessageHash(batch *clients.TransferBatch) (common.Hash, error) {
+func (stub *EthereumClientStub) GenerateMessageHash(ctx context.Context, batch *clients.TransferBatch) (common.Hash, error) {
 	if stub.GenerateMessageHashCalled != nil {
-		return stub.GenerateMessageHashCalled(batch)
+		return stub.GenerateMessageHashCalled(ctx, batch)
 	}
 
 	return common.Hash{}, errNotImplemented
 }
 
 // BroadcastSignatureForMessageHash -
-func (stub *EthereumClientStub) BroadcastSignatureForMessageHash(msgHash common.Hash) {
+func (stub *EthereumClientStub) BroadcastSignatureForMessageHash(msgHash common.Hash) error {
 	if stub.BroadcastSignatureForMessageHashCalled != nil {
-		stub.BroadcastSignatureForMessageHashCalled(msgHash)
+		return stub.BroadcastSignatureForMessageHashCalled(msgHash)
 	}
+
+	return errNotImplemented
 }
 
 // ExecuteTransfer -
@@ -100,6 +117,51 @@ func (stub *EthereumClientStub) IsQuorumReached(ctx context.Context, msgHash com
 	return false, errNotImplemented
 }
 
+// IsPaused -
+func (stub *EthereumClientStub) IsPaused(ctx context.Context) (bool, error) {
+	if stub.IsPausedCalled != nil {
+		return stub.IsPausedCalled(ctx)
+	}
+
+	return false, errNotImplemented
+}
+
+// IsTransactionReverted -
+func (stub *EthereumClientStub) IsTransactionReverted(ctx context.Context, txHash string) (bool, error) {
+	if stub.IsTransactionRevertedCalled != nil {
+		return stub.IsTransactionRevertedCalled(ctx, txHash)
+	}
+
+	return false, errNotImplemented
+}
+
+// WasTransactionMined -
+func (stub *EthereumClientStub) WasTransactionMined(ctx context.Context, txHash string) (bool, error) {
+	if stub.WasTransactionMinedCalled != nil {
+		return stub.WasTransactionMinedCalled(ctx, txHash)
+	}
+
+	return false, errNotImplemented
+}
+
+// ResetNonce -
+func (stub *EthereumClientStub) ResetNonce(ctx context.Context) error {
+	if stub.ResetNonceCalled != nil {
+		return stub.ResetNonceCalled(ctx)
+	}
+
+	return errNotImplemented
+}
+
+// ResetNonceToMined -
+func (stub *EthereumClientStub) ResetNonceToMined(ctx context.Context) error {
+	if stub.ResetNonceToMinedCalled != nil {
+		return stub.ResetNonceToMinedCalled(ctx)
+	}
+
+	return errNotImplemented
+}
+
 // IsInterfaceNil -
 func (stub *EthereumClientStub) IsInterfaceNil() bool {
 	return stub == nil
@@ -15,18 +15,22 @@ import (
 // EthereumClientWrapperStub -
 type EthereumClientWrapperStub struct {
 	core.StatusHandler
-	GetBatchCalled         func(ctx context.Context, batchNonce *big.Int) (contract.Batch, error)
-	GetBatchDepositsCalled func(ctx context.Context, batchNonce *big.Int) ([]contract.Deposit, error)
+	GetBatchCalled         func(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) (contract.Batch, error)
+	GetBatchDepositsCalled func(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) ([]contract.Deposit, error)
 	GetRelayersCalled      func(ctx context.Context) ([]common.Address, error)
-	WasBatchExecutedCalled func(ctx context.Context, batchNonce *big.Int) (bool, error)
+	WasBatchExecutedCalled func(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) (bool, error)
 	ChainIDCalled          func(ctx context.Context) (*big.Int, error)
 	BlockNumberCalled      func(ctx context.Context) (uint64, error)
 	NonceAtCalled          func(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	PendingNonceAtCalled   func(ctx context.Context, account common.Address) (uint64, error)
 	ExecuteTransferCalled  func(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address,
 		amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, signatures [][]byte) (*types.Transaction, error)
 	QuorumCalled                    func(ctx context.Context) (*big.Int, error)
 	GetStatusesAfterExecutionCalled func(ctx context.Context, batchID *big.Int) ([]byte, error)
 	BalanceAtCalled                 func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	SuggestGasPriceCalled           func(ctx context.Context) (*big.Int, error)
+	SuggestGasTipCapCalled          func(ctx context.Context) (*big.Int, error)
+	TransactionReceiptCalled        func(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
 
 	SetIntMetricCalled    func(metric string, value int)
 	AddIntMetricCalled    func(metric string, delta int)
@@ -34,6 +38,7 @@ type EthereumClientWrapperStub struct {
 	GetAllMetricsCalled   func() core.GeneralMetrics
 	NameCalled            func() string
 	IsPausedCalled        func(ctx context.Context) (bool, error)
+	SendTransactionCalled func(ctx context.Context, tx *types.Transaction) error
 }
 
 // SetIntMetric -
@@ -91,18 +96,18 @@ func (stub *EthereumClientWrapperStub) Name() string {
 }
 
 // GetBatch -
-func (stub *EthereumClientWrapperStub) GetBatch(ctx context.Context, batchNonce *big.Int) (contract.Batch, error) {
+func (stub *EthereumClientWrapperStub) GetBatch(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) (contract.Batch, error) {
 	if stub.GetBatchCalled != nil {
-		return stub.GetBatchCalled(ctx, batchNonce)
+		return stub.GetBatchCalled(ctx, batchNonce, blockNumber)
 	}
 
 	return contract.Batch{}, nil
 }
 
 // GetBatchDeposits -
-func (stub *EthereumClientWrapperStub) GetBatchDeposits(ctx context.Context, batchNonce *big.Int) ([]contract.Deposit, error) {
+func (stub *EthereumClientWrapperStub) GetBatchDeposits(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) ([]contract.Deposit, error) {
 	if stub.GetBatchCalled != nil {
-		return stub.GetBatchDepositsCalled(ctx, batchNonce)
+		return stub.GetBatchDepositsCalled(ctx, batchNonce, blockNumber)
 	}
 
 	return make([]contract.Deposit, 0), nil
@@ -118,9 +123,9 @@ func (stub *EthereumClientWrapperStub) GetRelayers(ctx context.Context) ([]commo
 }
 
 // WasBatchExecuted -
-func (stub *EthereumClientWrapperStub) WasBatchExecuted(ctx context.Context, batchNonce *big.Int) (bool, error) {
+func (stub *EthereumClientWrapperStub) WasBatchExecuted(ctx context.Context, batchNonce *big.Int, blockNumber *big.Int) (bool, error) {
 	if stub.WasBatchExecutedCalled != nil {
-		return stub.WasBatchExecutedCalled(ctx, batchNonce)
+		return stub.WasBatchExecutedCalled(ctx, batchNonce, blockNumber)
 	}
 
 	return true, nil
@@ -153,6 +158,15 @@ func (stub *EthereumClientWrapperStub) NonceAt(ctx context.Context, account comm
 	return 0, nil
 }
 
+// PendingNonceAt -
+func (stub *EthereumClientWrapperStub) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	if stub.PendingNonceAtCalled != nil {
+		return stub.PendingNonceAtCalled(ctx, account)
+	}
+
+	return 0, nil
+}
+
 // ExecuteTransfer -
 func (stub *EthereumClientWrapperStub) ExecuteTransfer(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, signatures [][]byte) (*types.Transaction, error) {
 	if stub.ExecuteTransferCalled != nil {
@@ -189,6 +203,44 @@ func (stub *EthereumClientWrapperStub) BalanceAt(ctx context.Context, account co
 	return big.NewInt(0), nil
 }
 
+// SuggestGasPrice -
+func (stub *EthereumClientWrapperStub) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	if stub.SuggestGasPriceCalled != nil {
+		return stub.SuggestGasPriceCalled(ctx)
+	}
+
+	return big.NewInt(0), nil
+}
+
+// SuggestGasTipCap -
+func (stub *EthereumClientWrapperStub) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	if stub.SuggestGasTipCapCalled != nil {
+		return stub.SuggestGasTipCapCalled(ctx)
+	}
+
+	return big.NewInt(0), nil
+}
+
+// TransactionReceipt -
+func (stub *EthereumClientWrapperStub) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if stub.TransactionReceiptCalled != nil {
+		return stub.TransactionReceiptCalled(ctx, txHash)
+	}
+
+	return &types.Receipt{
+		Status: types.ReceiptStatusSuccessful,
+	}, nil
+}
+
+// SendTransaction -
+func (stub *EthereumClientWrapperStub) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if stub.SendTransactionCalled != nil {
+		return stub.SendTransactionCalled(ctx, tx)
+	}
+
+	return nil
+}
+
 // IsPaused -
 func (stub *EthereumClientWrapperStub) IsPaused(ctx context.Context) (bool, error) {
 	if stub.IsPausedCalled != nil {
@@ -4,7 +4,8 @@ import "context"
 
 // TokensMapperStub -
 type TokensMapperStub struct {
-	ConvertTokenCalled func(ctx context.Context, sourceBytes []byte) ([]byte, error)
+	ConvertTokenCalled        func(ctx context.Context, sourceBytes []byte) ([]byte, error)
+	ConvertTokenReverseCalled func(ctx context.Context, convertedBytes []byte) ([]byte, error)
 }
 
 // ConvertToken -
@@ -16,6 +17,15 @@ func (stub *TokensMapperStub) ConvertToken(ctx context.Context, sourceBytes []by
 	return make([]byte, 0), nil
 }
 
+// ConvertTokenReverse -
+func (stub *TokensMapperStub) ConvertTokenReverse(ctx context.Context, convertedBytes []byte) ([]byte, error) {
+	if stub.ConvertTokenReverseCalled != nil {
+		return stub.ConvertTokenReverseCalled(ctx, convertedBytes)
+	}
+
+	return make([]byte, 0), nil
+}
+
 // IsInterfaceNil -
 func (stub *TokensMapperStub) IsInterfaceNil() bool {
 	return stub == nil
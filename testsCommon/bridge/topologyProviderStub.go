@@ -1,8 +1,11 @@
 package bridge
 
+import "time"
+
 // TopologyProviderStub -
 type TopologyProviderStub struct {
-	MyTurnAsLeaderCalled func() bool
+	MyTurnAsLeaderCalled  func() bool
+	TimeUntilMyTurnCalled func() time.Duration
 }
 
 // MyTurnAsLeader -
@@ -14,6 +17,15 @@ func (stub *TopologyProviderStub) MyTurnAsLeader() bool {
 	return false
 }
 
+// TimeUntilMyTurn -
+func (stub *TopologyProviderStub) TimeUntilMyTurn() time.Duration {
+	if stub.TimeUntilMyTurnCalled != nil {
+		return stub.TimeUntilMyTurnCalled()
+	}
+
+	return 0
+}
+
 // IsInterfaceNil -
 func (stub *TopologyProviderStub) IsInterfaceNil() bool {
 	return stub == nil
@@ -9,6 +9,7 @@ import (
 // TxHandlerStub -
 type TxHandlerStub struct {
 	SendTransactionReturnHashCalled func(ctx context.Context, builder builders.TxDataBuilder, gasLimit uint64) (string, error)
+	EstimateTransactionGasCalled    func(ctx context.Context, builder builders.TxDataBuilder) (uint64, error)
 	CloseCalled                     func() error
 }
 
@@ -21,6 +22,15 @@ func (stub *TxHandlerStub) SendTransactionReturnHash(ctx context.Context, builde
 	return "", nil
 }
 
+// EstimateTransactionGas -
+func (stub *TxHandlerStub) EstimateTransactionGas(ctx context.Context, builder builders.TxDataBuilder) (uint64, error) {
+	if stub.EstimateTransactionGasCalled != nil {
+		return stub.EstimateTransactionGasCalled(ctx, builder)
+	}
+
+	return 0, nil
+}
+
 // Close -
 func (stub *TxHandlerStub) Close() error {
 	if stub.CloseCalled != nil {
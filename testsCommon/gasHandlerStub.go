@@ -1,10 +1,15 @@
 package testsCommon
 
-import "math/big"
+import (
+	"context"
+	"math/big"
+)
 
 // GasHandlerStub -
 type GasHandlerStub struct {
-	GetCurrentGasPriceCalled func() (*big.Int, error)
+	GetCurrentGasPriceCalled            func() (*big.Int, error)
+	GetCurrentGasPriceWithContextCalled func(ctx context.Context) (*big.Int, error)
+	GetCurrentDynamicFeesCalled         func() (*big.Int, *big.Int, error)
 }
 
 // GetCurrentGasPrice -
@@ -16,6 +21,24 @@ func (ghs *GasHandlerStub) GetCurrentGasPrice() (*big.Int, error) {
 	return big.NewInt(0), nil
 }
 
+// GetCurrentGasPriceWithContext -
+func (ghs *GasHandlerStub) GetCurrentGasPriceWithContext(ctx context.Context) (*big.Int, error) {
+	if ghs.GetCurrentGasPriceWithContextCalled != nil {
+		return ghs.GetCurrentGasPriceWithContextCalled(ctx)
+	}
+
+	return big.NewInt(0), nil
+}
+
+// GetCurrentDynamicFees -
+func (ghs *GasHandlerStub) GetCurrentDynamicFees() (*big.Int, *big.Int, error) {
+	if ghs.GetCurrentDynamicFeesCalled != nil {
+		return ghs.GetCurrentDynamicFeesCalled()
+	}
+
+	return big.NewInt(0), big.NewInt(0), nil
+}
+
 // IsInterfaceNil -
 func (ghs *GasHandlerStub) IsInterfaceNil() bool {
 	return ghs == nil
@@ -5,14 +5,20 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
 // BlockchainClientStub -
 type BlockchainClientStub struct {
-	BlockNumberCalled func(ctx context.Context) (uint64, error)
-	NonceAtCalled     func(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
-	ChainIDCalled     func(ctx context.Context) (*big.Int, error)
-	BalanceAtCalled   func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	BlockNumberCalled        func(ctx context.Context) (uint64, error)
+	NonceAtCalled            func(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	PendingNonceAtCalled     func(ctx context.Context, account common.Address) (uint64, error)
+	ChainIDCalled            func(ctx context.Context) (*big.Int, error)
+	BalanceAtCalled          func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	SuggestGasPriceCalled    func(ctx context.Context) (*big.Int, error)
+	SuggestGasTipCapCalled   func(ctx context.Context) (*big.Int, error)
+	TransactionReceiptCalled func(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	SendTransactionCalled    func(ctx context.Context, tx *types.Transaction) error
 }
 
 // BlockNumber -
@@ -33,6 +39,15 @@ func (bcs *BlockchainClientStub) NonceAt(ctx context.Context, account common.Add
 	return 0, nil
 }
 
+// PendingNonceAt -
+func (bcs *BlockchainClientStub) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	if bcs.PendingNonceAtCalled != nil {
+		return bcs.PendingNonceAtCalled(ctx, account)
+	}
+
+	return 0, nil
+}
+
 // ChainID -
 func (bcs *BlockchainClientStub) ChainID(ctx context.Context) (*big.Int, error) {
 	if bcs.ChainIDCalled != nil {
@@ -51,7 +66,45 @@ func (bcs *BlockchainClientStub) BalanceAt(ctx context.Context, account common.A
 	return big.NewInt(0), nil
 }
 
+// SuggestGasPrice -
+func (bcs *BlockchainClientStub) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	if bcs.SuggestGasPriceCalled != nil {
+		return bcs.SuggestGasPriceCalled(ctx)
+	}
+
+	return big.NewInt(0), nil
+}
+
+// SuggestGasTipCap -
+func (bcs *BlockchainClientStub) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	if bcs.SuggestGasTipCapCalled != nil {
+		return bcs.SuggestGasTipCapCalled(ctx)
+	}
+
+	return big.NewInt(0), nil
+}
+
+// TransactionReceipt -
+func (bcs *BlockchainClientStub) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if bcs.TransactionReceiptCalled != nil {
+		return bcs.TransactionReceiptCalled(ctx, txHash)
+	}
+
+	return &types.Receipt{
+		Status: types.ReceiptStatusSuccessful,
+	}, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (bcs *BlockchainClientStub) IsInterfaceNil() bool {
 	return bcs == nil
 }
+
+// SendTransaction -
+func (bcs *BlockchainClientStub) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if bcs.SendTransactionCalled != nil {
+		return bcs.SendTransactionCalled(ctx, tx)
+	}
+
+	return nil
+}
@@ -17,6 +17,8 @@ type ElrondProxyStub struct {
 	GetAccountCalled        func(ctx context.Context, address core.AddressHandler) (*data.Account, error)
 	GetNetworkStatusCalled  func(ctx context.Context, shardID uint32) (*data.NetworkStatus, error)
 	GetShardOfAddressCalled func(ctx context.Context, bech32Address string) (uint32, error)
+
+	RequestTransactionCostCalled func(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error)
 }
 
 // GetNetworkConfig -
@@ -82,6 +84,15 @@ func (eps *ElrondProxyStub) GetShardOfAddress(ctx context.Context, bech32Address
 	return 0, fmt.Errorf("not implemented")
 }
 
+// RequestTransactionCost -
+func (eps *ElrondProxyStub) RequestTransactionCost(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
+	if eps.RequestTransactionCostCalled != nil {
+		return eps.RequestTransactionCostCalled(ctx, tx)
+	}
+
+	return &data.TxCostResponseData{}, nil
+}
+
 // IsInterfaceNil -
 func (eps *ElrondProxyStub) IsInterfaceNil() bool {
 	return eps == nil
@@ -2,8 +2,9 @@ package testsCommon
 
 // SignaturesHolderStub -
 type SignaturesHolderStub struct {
-	SignaturesCalled            func(messageHash []byte) [][]byte
-	ClearStoredSignaturesCalled func()
+	SignaturesCalled             func(messageHash []byte) [][]byte
+	ClearSignaturesForHashCalled func(messageHash []byte)
+	ClearStoredSignaturesCalled  func()
 }
 
 // Signatures -
@@ -15,6 +16,13 @@ func (stub *SignaturesHolderStub) Signatures(messageHash []byte) [][]byte {
 	return make([][]byte, 0)
 }
 
+// ClearSignaturesForHash -
+func (stub *SignaturesHolderStub) ClearSignaturesForHash(messageHash []byte) {
+	if stub.ClearSignaturesForHashCalled != nil {
+		stub.ClearSignaturesForHashCalled(messageHash)
+	}
+}
+
 // ClearStoredSignatures -
 func (stub *SignaturesHolderStub) ClearStoredSignatures() {
 	if stub.ClearStoredSignaturesCalled != nil {
@@ -0,0 +1,24 @@
+package testsCommon
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-eth-bridge/core"
+)
+
+// StepDurationRecorderStub -
+type StepDurationRecorderStub struct {
+	RecordStepDurationCalled func(identifier core.StepIdentifier, duration time.Duration)
+}
+
+// RecordStepDuration -
+func (stub *StepDurationRecorderStub) RecordStepDuration(identifier core.StepIdentifier, duration time.Duration) {
+	if stub.RecordStepDurationCalled != nil {
+		stub.RecordStepDurationCalled(identifier, duration)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *StepDurationRecorderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
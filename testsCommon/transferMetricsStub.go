@@ -0,0 +1,60 @@
+package testsCommon
+
+import "time"
+
+// TransferMetricsStub -
+type TransferMetricsStub struct {
+	IncTransfersProposedCalled          func()
+	IncTransfersSignedCalled            func()
+	IncTransfersSentCalled              func()
+	IncTransfersFailedCalled            func()
+	ObserveExecutionGasPriceCalled      func(gasPrice float64)
+	ObserveBatchExecutionDurationCalled func(duration time.Duration)
+}
+
+// IncTransfersProposed -
+func (stub *TransferMetricsStub) IncTransfersProposed() {
+	if stub.IncTransfersProposedCalled != nil {
+		stub.IncTransfersProposedCalled()
+	}
+}
+
+// IncTransfersSigned -
+func (stub *TransferMetricsStub) IncTransfersSigned() {
+	if stub.IncTransfersSignedCalled != nil {
+		stub.IncTransfersSignedCalled()
+	}
+}
+
+// IncTransfersSent -
+func (stub *TransferMetricsStub) IncTransfersSent() {
+	if stub.IncTransfersSentCalled != nil {
+		stub.IncTransfersSentCalled()
+	}
+}
+
+// IncTransfersFailed -
+func (stub *TransferMetricsStub) IncTransfersFailed() {
+	if stub.IncTransfersFailedCalled != nil {
+		stub.IncTransfersFailedCalled()
+	}
+}
+
+// ObserveExecutionGasPrice -
+func (stub *TransferMetricsStub) ObserveExecutionGasPrice(gasPrice float64) {
+	if stub.ObserveExecutionGasPriceCalled != nil {
+		stub.ObserveExecutionGasPriceCalled(gasPrice)
+	}
+}
+
+// ObserveBatchExecutionDuration -
+func (stub *TransferMetricsStub) ObserveBatchExecutionDuration(duration time.Duration) {
+	if stub.ObserveBatchExecutionDurationCalled != nil {
+		stub.ObserveBatchExecutionDurationCalled(duration)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *TransferMetricsStub) IsInterfaceNil() bool {
+	return stub == nil
+}